@@ -50,20 +50,22 @@ storage:
       metabase:
         perm: 0644  # permissions for metabase files(directories: +x for current user and group)
 
-      blobstor:
-        perm: 0644  # permissions for blobstor files(directories: +x for current user and group)
-        depth: 2  # max depth of object tree storage in FS
-        small_object_size: 102400  # 100KiB, size threshold for "small" objects which are stored in key-value DB, not in FS, bytes
-        compress: true  # turn on/off Zstandard compression (level 3) of stored objects
-        compression_exclude_content_types:
-          - audio/*
-          - video/*
+      small_object_size: 102400  # 100KiB, size threshold for "small" objects which are stored in key-value DB, not in FS, bytes
+      compress: true  # turn on/off Zstandard compression (level 3) of stored objects
+      compression_exclude_content_types:
+        - audio/*
+        - video/*
 
-        blobovnicza:
+      blobstor:
+        - type: blobovnicza
+          perm: 0644  # permissions for blobovnicza files(directories: +x for current user and group)
           size: 1073741824  # approximate size limit of single blobovnicza instance, total size will be: size*width^(depth+1), bytes
           depth: 1  # max depth of object tree storage in key-value DB
           width: 4   # max width of object tree storage in key-value DB
           opened_cache_capacity: 50  # maximum number of opened database files
+        - type: fstree
+          perm: 0644  # permissions for blobstor files(directories: +x for current user and group)
+          depth: 2  # max depth of object tree storage in FS
 
       gc:
         remover_batch_size: 200  # number of objects to be removed by the garbage collector
@@ -75,7 +77,10 @@ storage:
         path: {{ .MetabasePath }}  # path to the metabase
 
       blobstor:
-        path: {{ .BlobstorPath }}  # path to the blobstor
+        - type: blobovnicza
+          path: {{ .BlobstorPath }}/blobovnicza  # path to the blobovnicza tree
+        - type: fstree
+          path: {{ .BlobstorPath }}  # path to the blobstor
 {{end}}`
 
 const (
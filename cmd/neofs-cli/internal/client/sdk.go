@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/tls"
 	"errors"
 	"fmt"
 
@@ -39,6 +40,12 @@ func getSDKClientByFlag(key *ecdsa.PrivateKey, endpointFlag string) (*client.Cli
 
 // GetSDKClient returns default neofs-sdk-go client.
 func GetSDKClient(key *ecdsa.PrivateKey, addr network.Address) (*client.Client, error) {
+	return GetSDKClientWithTLS(key, addr, nil)
+}
+
+// GetSDKClientWithTLS returns neofs-sdk-go client which uses tlsCfg
+// for TLS endpoints. Nil tlsCfg means default TLS configuration.
+func GetSDKClientWithTLS(key *ecdsa.PrivateKey, addr network.Address, tlsCfg *tls.Config) (*client.Client, error) {
 	var (
 		c       client.Client
 		prmInit client.PrmInit
@@ -48,6 +55,7 @@ func GetSDKClient(key *ecdsa.PrivateKey, addr network.Address) (*client.Client,
 	prmInit.SetDefaultPrivateKey(*key)
 	prmInit.ResolveNeoFSFailures()
 	prmDial.SetServerURI(addr.URIAddr())
+	prmDial.SetTLSConfig(tlsCfg)
 
 	c.Init(prmInit)
 
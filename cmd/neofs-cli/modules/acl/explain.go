@@ -0,0 +1,322 @@
+package acl
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	v2acl "github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	objectacl "github.com/nspcc-dev/neofs-node/pkg/services/object/acl"
+	aclv2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/v2"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+const (
+	explainContainerFlag = "container"
+	explainEACLFlag      = "eacl"
+	explainBearerFlag    = "bearer"
+	explainOpFlag        = "op"
+	explainRoleFlag      = "role"
+	explainSenderKeyFlag = "sender-key"
+	explainEpochFlag     = "epoch"
+	explainOIDFlag       = "oid"
+	explainHeaderFlag    = "header"
+	explainOwnerFlag     = "owner"
+)
+
+var explainCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "Explain access check of the object operation",
+	Long: `Explain access check of the object operation.
+
+Request is checked locally against the basic ACL and the eACL table of the
+container and the optional bearer token in the same way storage nodes do.
+All the steps of the check are printed: sender classification, basic ACL,
+bearer token checks and the evaluation of each eACL record up to the first
+matching one. No network access is performed.
+
+Operation is an object service verb: 'get', 'head', 'put', 'search', 'delete', 'getrange', or 'getrangehash'.
+
+Role is 'user' for container owner, 'system' for Storage nodes in container,
+'ir' for Inner Ring nodes and 'others' for all other request senders. If the
+sender key is specified and belongs to the container owner, 'user' role is used.
+
+Header is <typ>:<key>=<value> where typ is 'obj' for object header or 'req'
+for request header, the same as in eACL filters. Container and object ID
+object headers are added automatically.
+
+Sticky bit of the basic ACL is checked for 'put' operation only if the
+owner of the put object is specified.
+`,
+	Example: `neofs-cli acl explain --container container.bin --eacl table.json --op get --role others
+neofs-cli acl explain --container container.bin --bearer bearer.json --epoch 100 --sender-key 031a6c6fbbdf02ca351745fa86b9ba5a9452d785ac4f7fc2b7548ca2a46c4fcf4a --op put --header obj:Type=cat`,
+	Run: explainAccess,
+}
+
+func init() {
+	ff := explainCmd.Flags()
+
+	ff.String(explainContainerFlag, "", "path to the binary or JSON encoded container")
+	ff.String(explainEACLFlag, "", "path to the binary or JSON encoded eACL table of the container")
+	ff.String(explainBearerFlag, "", "path to the binary or JSON encoded bearer token")
+	ff.String(explainOpFlag, "", "object operation: get, head, put, search, delete, getrange or getrangehash")
+	ff.String(explainRoleFlag, "others", "request sender role: user, system, ir or others")
+	ff.String(explainSenderKeyFlag, "", "hex-encoded public key of the request sender")
+	ff.Uint64(explainEpochFlag, 0, "current epoch, required to check bearer token lifetime")
+	ff.String(explainOIDFlag, "", "object ID")
+	ff.StringArray(explainHeaderFlag, nil, "request or object header in <typ>:<key>=<value> form")
+	ff.String(explainOwnerFlag, "", "owner of the put object, required to check the sticky bit")
+
+	_ = explainCmd.MarkFlagRequired(explainContainerFlag)
+	_ = explainCmd.MarkFlagRequired(explainOpFlag)
+	_ = cobra.MarkFlagFilename(ff, explainContainerFlag)
+	_ = cobra.MarkFlagFilename(ff, explainEACLFlag)
+	_ = cobra.MarkFlagFilename(ff, explainBearerFlag)
+}
+
+func explainAccess(cmd *cobra.Command, _ []string) {
+	var (
+		info  aclv2.RequestInfo
+		trace aclv2.Trace
+		idCnr cid.ID
+	)
+
+	cnr := readContainer(cmd, &idCnr)
+
+	info.SetTrace(&trace)
+	info.SetContainerID(idCnr)
+	info.SetContainerOwner(cnr.Owner())
+	info.SetBasicACL(cnr.BasicACL())
+
+	opArg, _ := cmd.Flags().GetString(explainOpFlag)
+	op, err := parseOperation(opArg)
+	common.ExitOnErr(cmd, "", err)
+
+	info.SetOperation(op)
+
+	var obj *oid.ID
+
+	if oidArg, _ := cmd.Flags().GetString(explainOIDFlag); oidArg != "" {
+		obj = new(oid.ID)
+
+		err = obj.DecodeString(oidArg)
+		common.ExitOnErr(cmd, "invalid object ID: %w", err)
+
+		info.SetObjectID(obj)
+	}
+
+	role, err := classifySender(cmd, &info)
+	common.ExitOnErr(cmd, "", err)
+
+	info.SetRequestRole(role)
+
+	var prm objectacl.ExplainPrm
+
+	if tok := common.ReadBearerToken(cmd, explainBearerFlag); tok != nil {
+		if !cmd.Flags().Changed(explainEpochFlag) {
+			common.ExitOnErr(cmd, "", fmt.Errorf("--%s flag is required to check bearer token", explainEpochFlag))
+		}
+
+		info.SetBearer(tok)
+	}
+
+	if ownerArg, _ := cmd.Flags().GetString(explainOwnerFlag); ownerArg != "" {
+		var owner user.ID
+
+		err = owner.DecodeString(ownerArg)
+		common.ExitOnErr(cmd, "invalid object owner: %w", err)
+
+		prm.SetObjectOwner(owner)
+	}
+
+	epoch, _ := cmd.Flags().GetUint64(explainEpochFlag)
+	prm.SetCurrentEpoch(epoch)
+
+	if eaclPath, _ := cmd.Flags().GetString(explainEACLFlag); eaclPath != "" {
+		prm.SetEACL(common.ReadEACL(cmd, eaclPath))
+	}
+
+	hdrArgs, _ := cmd.Flags().GetStringArray(explainHeaderFlag)
+	hdrs, err := parseHeaders(hdrArgs, idCnr, obj)
+	common.ExitOnErr(cmd, "", err)
+
+	prm.SetHeaderSource(hdrs)
+	prm.SetRequestInfo(info)
+
+	err = objectacl.Explain(prm)
+
+	for i, step := range trace.Steps() {
+		cmd.Printf("%d. %s\n", i+1, step)
+	}
+
+	if err != nil {
+		cmd.Printf("Result: access denied: %v\n", err)
+		os.Exit(1)
+	}
+
+	cmd.Println("Result: access allowed")
+}
+
+func readContainer(cmd *cobra.Command, id *cid.ID) container.Container {
+	path, _ := cmd.Flags().GetString(explainContainerFlag)
+
+	data, err := os.ReadFile(path)
+	common.ExitOnErr(cmd, "can't read container file: %w", err)
+
+	var cnr container.Container
+
+	if err = cnr.Unmarshal(data); err == nil {
+		container.CalculateIDFromBinary(id, data)
+		common.PrintVerbose("Using binary encoded container")
+
+		return cnr
+	}
+
+	err = cnr.UnmarshalJSON(data)
+	common.ExitOnErr(cmd, "can't decode container: %w", err)
+
+	container.CalculateID(id, cnr)
+	common.PrintVerbose("Using JSON encoded container")
+
+	return cnr
+}
+
+func parseOperation(s string) (acl.Op, error) {
+	switch strings.ToLower(s) {
+	case "get":
+		return acl.OpObjectGet, nil
+	case "head":
+		return acl.OpObjectHead, nil
+	case "put":
+		return acl.OpObjectPut, nil
+	case "search":
+		return acl.OpObjectSearch, nil
+	case "delete":
+		return acl.OpObjectDelete, nil
+	case "getrange":
+		return acl.OpObjectRange, nil
+	case "getrangehash":
+		return acl.OpObjectHash, nil
+	default:
+		return 0, fmt.Errorf("invalid operation: %s", s)
+	}
+}
+
+// classifySender sets the sender key to the request info and returns the
+// sender role. Classification steps are written to the request trace.
+func classifySender(cmd *cobra.Command, info *aclv2.RequestInfo) (acl.Role, error) {
+	trace := info.Trace()
+
+	if keyArg, _ := cmd.Flags().GetString(explainSenderKeyFlag); keyArg != "" {
+		bKey, err := hex.DecodeString(keyArg)
+		if err != nil {
+			return 0, fmt.Errorf("invalid sender key: %w", err)
+		}
+
+		pub, err := keys.NewPublicKeyFromBytes(bKey, elliptic.P256())
+		if err != nil {
+			return 0, fmt.Errorf("invalid sender key: %w", err)
+		}
+
+		info.SetSenderKey(pub.Bytes())
+
+		var sender user.ID
+		user.IDFromKey(&sender, (ecdsa.PublicKey)(*pub))
+
+		trace.Addf("sender %s is the signer of the request body", sender)
+
+		if sender.Equals(info.ContainerOwner()) {
+			trace.Addf("sender is the container owner, role %s", acl.RoleOwner)
+			return acl.RoleOwner, nil
+		}
+	}
+
+	roleArg, _ := cmd.Flags().GetString(explainRoleFlag)
+
+	var role acl.Role
+
+	switch strings.ToLower(roleArg) {
+	case "user":
+		role = acl.RoleOwner
+	case "system":
+		role = acl.RoleContainer
+	case "ir":
+		role = acl.RoleInnerRing
+	case "others":
+		role = acl.RoleOthers
+	default:
+		return 0, fmt.Errorf("invalid role: %s", roleArg)
+	}
+
+	trace.Addf("sender role %s is set explicitly", role)
+
+	return role, nil
+}
+
+// headerSource is a static eacl.TypedHeaderSource.
+type headerSource map[eacl.FilterHeaderType][]eacl.Header
+
+func (h headerSource) HeadersOfType(typ eacl.FilterHeaderType) ([]eacl.Header, bool) {
+	return h[typ], true
+}
+
+type header struct {
+	k, v string
+}
+
+func (h header) Key() string {
+	return h.k
+}
+
+func (h header) Value() string {
+	return h.v
+}
+
+func parseHeaders(args []string, idCnr cid.ID, obj *oid.ID) (headerSource, error) {
+	res := headerSource{
+		eacl.HeaderFromObject: {header{k: v2acl.FilterObjectContainerID, v: idCnr.EncodeToString()}},
+	}
+
+	if obj != nil {
+		res[eacl.HeaderFromObject] = append(res[eacl.HeaderFromObject],
+			header{k: v2acl.FilterObjectID, v: obj.EncodeToString()})
+	}
+
+	for i := range args {
+		ss := strings.SplitN(args[i], ":", 2)
+		if len(ss) != 2 {
+			return nil, fmt.Errorf("invalid header: %s", args[i])
+		}
+
+		var typ eacl.FilterHeaderType
+
+		switch strings.ToLower(ss[0]) {
+		case "obj":
+			typ = eacl.HeaderFromObject
+		case "req":
+			typ = eacl.HeaderFromRequest
+		default:
+			return nil, fmt.Errorf("invalid header type: %s", ss[0])
+		}
+
+		kv := strings.SplitN(ss[1], "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid header key-value pair: %s", ss[1])
+		}
+
+		res[typ] = append(res[typ], header{k: kv[0], v: kv[1]})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,82 @@
+package control
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	compactTreeIDFlag     = "tree-id"
+	compactTreeHeightFlag = "height"
+)
+
+var compactTreeCmd = &cobra.Command{
+	Use:   "compact-tree",
+	Short: "Compact log of the tree",
+	Long: `Compact log of the tree in an object tree service.
+Operations below the height are replaced with the snapshot of the tree state.
+The height must not be above the one synchronized with all the container
+nodes (see synchronize-tree command), the synchronized height is used by default.`,
+	Run: compactTree,
+}
+
+func initControlCompactTreeCmd() {
+	commonflags.InitWithoutRPC(compactTreeCmd)
+
+	flags := compactTreeCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.String("cid", "", "Container ID")
+	flags.String(compactTreeIDFlag, "", "Tree ID")
+	flags.Uint64(compactTreeHeightFlag, 0, "Height below which the log is compacted, zero means the synchronized height")
+}
+
+func compactTree(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	var cnr cid.ID
+	cidStr, _ := cmd.Flags().GetString("cid")
+	common.ExitOnErr(cmd, "can't decode container ID: %w", cnr.DecodeString(cidStr))
+
+	treeID, _ := cmd.Flags().GetString(compactTreeIDFlag)
+	if treeID == "" {
+		common.ExitOnErr(cmd, "", errors.New("tree ID must not be empty"))
+	}
+
+	height, _ := cmd.Flags().GetUint64(compactTreeHeightFlag)
+
+	rawCID := make([]byte, sha256.Size)
+	cnr.Encode(rawCID)
+
+	req := &control.CompactTreeRequest{
+		Body: &control.CompactTreeRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+			Height:      height,
+		},
+	}
+
+	err := controlSvc.SignMessage(pk, req)
+	common.ExitOnErr(cmd, "could not sign request: %w", err)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.CompactTreeResponse
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.CompactTree(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Tree log has been compacted successfully.")
+}
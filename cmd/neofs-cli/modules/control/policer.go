@@ -0,0 +1,17 @@
+package control
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var policerCmd = &cobra.Command{
+	Use:   "policer",
+	Short: "Operations with storage node's object policer",
+	Long:  "Operations with storage node's object policer",
+}
+
+func initControlPolicerCmd() {
+	policerCmd.AddCommand(policerStatusCmd)
+
+	initControlPolicerStatusCmd()
+}
@@ -0,0 +1,69 @@
+package control
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var policerStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show policer progress",
+	Long: `Show the progress of the current policer pass over the local objects.
+Objects of the containers which nodes have changed are checked out of turn.`,
+	Run: policerStatus,
+}
+
+func policerStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := new(control.PolicerStatusRequest)
+	req.SetBody(new(control.PolicerStatusRequest_Body))
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.PolicerStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.PolicerStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	eta := "unknown"
+	if s := body.GetEtaSeconds(); s > 0 {
+		eta = (time.Duration(s) * time.Second).String()
+	}
+
+	cmd.Printf("Pass: %d\n"+
+		"Started at: %s\n"+
+		"Objects checked: %d\n"+
+		"Replicas created: %d\n"+
+		"Prioritized containers: %d\n"+
+		"ETA: %s\n",
+		body.GetPass(),
+		time.Unix(body.GetPassStartedAt(), 0).Format(time.RFC3339),
+		body.GetObjectsChecked(),
+		body.GetReplicasCreated(),
+		body.GetPrioritizedContainers(),
+		eta,
+	)
+}
+
+func initControlPolicerStatusCmd() {
+	commonflags.InitWithoutRPC(policerStatusCmd)
+
+	flags := policerStatusCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+}
@@ -23,9 +23,14 @@ const (
 	controlRPC        = "endpoint"
 	controlRPCDefault = ""
 	controlRPCUsage   = "remote node control address (as 'multiaddr' or '<host>:<port>')"
+
+	controlServerKey      = "server-key"
+	controlServerKeyUsage = "hex-encoded public key of the node, TLS certificate of the control endpoint must be bound to it or trusted by the system CAs"
 )
 
 func init() {
+	Cmd.PersistentFlags().String(controlServerKey, "", controlServerKeyUsage)
+
 	Cmd.AddCommand(
 		healthCheckCmd,
 		setNetmapStatusCmd,
@@ -33,6 +38,8 @@ func init() {
 		snapshotCmd,
 		shardsCmd,
 		synchronizeTreeCmd,
+		compactTreeCmd,
+		policerCmd,
 	)
 
 	initControlHealthCheckCmd()
@@ -41,4 +48,6 @@ func init() {
 	initControlSnapshotCmd()
 	initControlShardsCmd()
 	initControlSynchronizeTreeCmd()
+	initControlCompactTreeCmd()
+	initControlPolicerCmd()
 }
@@ -15,9 +15,19 @@ func initControlShardsCmd() {
 	shardsCmd.AddCommand(setShardModeCmd)
 	shardsCmd.AddCommand(dumpShardCmd)
 	shardsCmd.AddCommand(restoreShardCmd)
+	shardsCmd.AddCommand(evacuateShardCmd)
+	shardsCmd.AddCommand(addShardCmd)
+	shardsCmd.AddCommand(detachShardsCmd)
+	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(cacheInfoCmd)
 
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
 	initControlDumpShardCmd()
 	initControlRestoreShardCmd()
+	initControlEvacuateShardCmd()
+	initControlAddShardCmd()
+	initControlDetachShardsCmd()
+	initControlFlushCacheCmd()
+	initControlCacheInfoCmd()
 }
@@ -0,0 +1,65 @@
+package control
+
+import (
+	"os"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const addShardConfigFlag = "config"
+
+var addShardCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Attach a new shard",
+	Long: `Open a new shard and attach it to the storage engine.
+Shard configuration is read from a YAML or JSON file with the same structure
+as a shard section of the storage node configuration. Values from the
+"storage.shard.default" section of the node configuration are not applied.`,
+	Run: addShard,
+}
+
+func addShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	p, _ := cmd.Flags().GetString(addShardConfigFlag)
+	data, err := os.ReadFile(p)
+	common.ExitOnErr(cmd, "can't read shard configuration: %w", err)
+
+	body := new(control.AddShardRequest_Body)
+	body.SetConfig(data)
+
+	req := new(control.AddShardRequest)
+	req.SetBody(body)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.AddShardResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.AddShard(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Printf("Shard %s has been attached successfully.\n", base58.Encode(resp.GetBody().GetShard_ID()))
+}
+
+func initControlAddShardCmd() {
+	commonflags.InitWithoutRPC(addShardCmd)
+
+	flags := addShardCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.String(addShardConfigFlag, "", "Path to the shard configuration file")
+
+	_ = addShardCmd.MarkFlagRequired(addShardConfigFlag)
+	_ = addShardCmd.MarkFlagRequired(controlRPC)
+}
@@ -0,0 +1,103 @@
+package control
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var cacheInfoCmd = &cobra.Command{
+	Use:   "cache-info",
+	Short: "Show write-cache statistics",
+	Long:  "Show write-cache statistics of the shards. All the shards with write-cache are used if no ID is provided.",
+	Run:   cacheInfo,
+}
+
+func cacheInfo(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	body := new(control.GetCacheInfoRequest_Body)
+	body.SetShardIDList(getShardIDList(cmd))
+
+	req := new(control.GetCacheInfoRequest)
+	req.SetBody(body)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetCacheInfoResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetCacheInfo(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	isJSON, _ := cmd.Flags().GetBool(commonflags.JSON)
+	if isJSON {
+		prettyPrintCacheInfoJSON(cmd, resp.GetBody().GetCaches())
+	} else {
+		prettyPrintCacheInfo(cmd, resp.GetBody().GetCaches())
+	}
+}
+
+func prettyPrintCacheInfoJSON(cmd *cobra.Command, ii []*control.WriteCacheInfo) {
+	out := make([]map[string]interface{}, 0, len(ii))
+	for _, i := range ii {
+		out = append(out, map[string]interface{}{
+			"shard_id":    base58.Encode(i.GetShard_ID()),
+			"mem_objects": i.GetMemObjects(),
+			"mem_size":    i.GetMemSize(),
+			"db_objects":  i.GetDbObjects(),
+			"fs_objects":  i.GetFsObjects(),
+			"not_flushed": i.GetNotFlushed(),
+			"disk_size":   i.GetDiskSize(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode write-cache info to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintCacheInfo(cmd *cobra.Command, ii []*control.WriteCacheInfo) {
+	for _, i := range ii {
+		cmd.Printf("Shard %s:\n"+
+			"Objects in memory: %d (%d bytes)\n"+
+			"Objects in database: %d\n"+
+			"Objects in file system: %d\n"+
+			"Objects not flushed: %d\n"+
+			"Disk usage: %d bytes\n",
+			base58.Encode(i.GetShard_ID()),
+			i.GetMemObjects(), i.GetMemSize(),
+			i.GetDbObjects(),
+			i.GetFsObjects(),
+			i.GetNotFlushed(),
+			i.GetDiskSize(),
+		)
+	}
+}
+
+func initControlCacheInfoCmd() {
+	commonflags.InitWithoutRPC(cacheInfoCmd)
+
+	flags := cacheInfoCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(commonflags.JSON, false, "Print write-cache info as a JSON array")
+
+	_ = cacheInfoCmd.MarkFlagRequired(controlRPC)
+}
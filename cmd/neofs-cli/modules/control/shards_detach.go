@@ -0,0 +1,54 @@
+package control
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var detachShardsCmd = &cobra.Command{
+	Use:   "detach",
+	Short: "Detach shards",
+	Long:  "Close shards and detach them from the storage engine. Shard data is left intact.",
+	Run:   detachShards,
+}
+
+func detachShards(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	body := new(control.DetachShardsRequest_Body)
+	body.SetShardIDList(getShardIDList(cmd))
+
+	req := new(control.DetachShardsRequest)
+	req.SetBody(body)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.DetachShardsResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.DetachShards(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shards have been detached successfully.")
+}
+
+func initControlDetachShardsCmd() {
+	commonflags.InitWithoutRPC(detachShardsCmd)
+
+	flags := detachShardsCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+
+	_ = detachShardsCmd.MarkFlagRequired(shardIDFlag)
+	_ = detachShardsCmd.MarkFlagRequired(controlRPC)
+}
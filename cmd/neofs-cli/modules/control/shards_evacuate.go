@@ -0,0 +1,80 @@
+package control
+
+import (
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const evacuateIgnoreErrorsFlag = "no-errors"
+
+var evacuateShardCmd = &cobra.Command{
+	Use:   "evacuate",
+	Short: "Evacuate objects from shard",
+	Long:  "Evacuate objects from shard to other shards",
+	Run:   evacuateShard,
+}
+
+func evacuateShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	body := new(control.EvacuateShardRequest_Body)
+	body.SetShardIDList(getShardIDList(cmd))
+
+	ignore, _ := cmd.Flags().GetBool(evacuateIgnoreErrorsFlag)
+	body.SetIgnoreErrors(ignore)
+
+	req := new(control.EvacuateShardRequest)
+	req.SetBody(body)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.EvacuateShardResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.EvacuateShard(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Printf("Objects moved: %d\n", resp.GetBody().GetCount())
+	if failed := resp.GetBody().GetFailed(); failed != 0 {
+		cmd.Printf("Objects skipped: %d\n", failed)
+	}
+
+	cmd.Println("Shard has successfully been evacuated.")
+}
+
+func getShardIDList(cmd *cobra.Command) [][]byte {
+	sidList, _ := cmd.Flags().GetStringSlice(shardIDFlag)
+
+	res := make([][]byte, 0, len(sidList))
+	for i := range sidList {
+		raw, err := base58.Decode(sidList[i])
+		common.ExitOnErr(cmd, "incorrect shard ID encoding: %w", err)
+
+		res = append(res, raw)
+	}
+
+	return res
+}
+
+func initControlEvacuateShardCmd() {
+	commonflags.InitWithoutRPC(evacuateShardCmd)
+
+	flags := evacuateShardCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(evacuateIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+
+	_ = evacuateShardCmd.MarkFlagRequired(shardIDFlag)
+	_ = evacuateShardCmd.MarkFlagRequired(controlRPC)
+}
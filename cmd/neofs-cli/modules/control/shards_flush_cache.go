@@ -0,0 +1,76 @@
+package control
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flushCacheAllFlag     = "all"
+	flushCacheDisableFlag = "disable"
+)
+
+var flushCacheCmd = &cobra.Command{
+	Use:   "flush-cache",
+	Short: "Flush objects from the write-cache to the main storage",
+	Long: "Synchronously flush objects from the write-cache to the blobstor and the metabase. " +
+		"Shards must be in read-write mode.",
+	Run: flushCache,
+}
+
+func flushCache(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	all, _ := cmd.Flags().GetBool(flushCacheAllFlag)
+	ids := getShardIDList(cmd)
+	if all == (len(ids) != 0) {
+		common.ExitOnErr(cmd, "", errors.New("either shard IDs or --all flag must be provided"))
+	}
+
+	disable, _ := cmd.Flags().GetBool(flushCacheDisableFlag)
+
+	body := new(control.FlushCacheRequest_Body)
+	body.SetShardIDList(ids)
+	body.SetDisable(disable)
+
+	req := new(control.FlushCacheRequest)
+	req.SetBody(body)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.FlushCacheResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.FlushCache(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	if disable {
+		cmd.Println("Write-cache has been flushed and switched off successfully.")
+	} else {
+		cmd.Println("Write-cache has been flushed successfully.")
+	}
+}
+
+func initControlFlushCacheCmd() {
+	commonflags.InitWithoutRPC(flushCacheCmd)
+
+	flags := flushCacheCmd.Flags()
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(flushCacheAllFlag, false, "Flush write-cache of all the shards")
+	flags.Bool(flushCacheDisableFlag, false, "Switch write-cache off after the flush until the node restart")
+
+	_ = flushCacheCmd.MarkFlagRequired(controlRPC)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/mr-tron/base58"
 	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
@@ -65,9 +66,16 @@ func prettyPrintShardsJSON(cmd *cobra.Command, ii []*control.ShardInfo) {
 			"shard_id":    base58.Encode(i.Shard_ID),
 			"mode":        shardModeToString(i.GetMode()),
 			"metabase":    i.GetMetabasePath(),
-			"blobstor":    i.GetBlobstorPath(),
+			"blobstor":    i.GetBlobstor(),
 			"writecache":  i.GetWritecachePath(),
 			"error_count": i.GetErrorCount(),
+			"object_counters": map[string]uint64{
+				"phy":        i.GetObjectCounters().GetPhy(),
+				"logic":      i.GetObjectCounters().GetLogic(),
+				"tombstones": i.GetObjectCounters().GetTombstones(),
+				"locks":      i.GetObjectCounters().GetLocks(),
+				"garbage":    i.GetObjectCounters().GetGarbage(),
+			},
 		})
 	}
 
@@ -89,18 +97,31 @@ func prettyPrintShards(cmd *cobra.Command, ii []*control.ShardInfo) {
 			return fmt.Sprintf("%s: %s\n", name, path)
 		}
 
+		var sb strings.Builder
+		sb.WriteString("Blobstor:\n")
+		for j, info := range i.GetBlobstor() {
+			sb.WriteString(fmt.Sprintf("\tPath %d: %s\n\tType %d: %s\n",
+				j, info.GetPath(), j, info.GetType()))
+		}
+
 		cmd.Printf("Shard %s:\nMode: %s\n"+
 			pathPrinter("Metabase", i.GetMetabasePath())+
-			pathPrinter("Blobstor", i.GetBlobstorPath())+
+			sb.String()+
 			pathPrinter("Write-cache", i.GetWritecachePath())+
 			pathPrinter("Pilorama", i.GetPiloramaPath())+
-			fmt.Sprintf("Error count: %d\n", i.GetErrorCount()),
+			fmt.Sprintf("Error count: %d\n", i.GetErrorCount())+
+			objectCountersPrinter(i.GetObjectCounters()),
 			base58.Encode(i.Shard_ID),
 			shardModeToString(i.GetMode()),
 		)
 	}
 }
 
+func objectCountersPrinter(c *control.ObjectCounters) string {
+	return fmt.Sprintf("Objects:\n\tPhysical: %d\n\tLogical: %d\n\tTombstones: %d\n\tLocks: %d\n\tGarbage: %d\n",
+		c.GetPhy(), c.GetLogic(), c.GetTombstones(), c.GetLocks(), c.GetGarbage())
+}
+
 func shardModeToString(m control.ShardMode) string {
 	switch m {
 	case control.ShardMode_READ_WRITE:
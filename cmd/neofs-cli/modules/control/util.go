@@ -2,15 +2,19 @@ package control
 
 import (
 	"crypto/ecdsa"
+	"crypto/tls"
 	"errors"
 
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 func signRequest(cmd *cobra.Command, pk *ecdsa.PrivateKey, req controlSvc.SignedMessage) {
@@ -45,6 +49,39 @@ func verifyResponse(cmd *cobra.Command,
 	}
 }
 
+// getClient returns client of the control service. For TLS endpoints
+// the certificate bound to pk is presented to the server, server
+// certificate bound to the node key is accepted if the key is specified.
 func getClient(cmd *cobra.Command, pk *ecdsa.PrivateKey) *client.Client {
-	return internalclient.GetSDKClientByFlag(cmd, pk, controlRPC)
+	var addr network.Address
+
+	err := addr.FromString(viper.GetString(controlRPC))
+	common.ExitOnErr(cmd, "invalid control endpoint: %w", err)
+
+	var tlsCfg *tls.Config
+
+	if addr.IsTLSEnabled() {
+		id := network.NewTLSIdentity(&keys.PrivateKey{PrivateKey: *pk})
+		common.ExitOnErr(cmd, "can't generate client certificate: %w", id.Load("", ""))
+
+		serverKey, _ := cmd.Flags().GetString(controlServerKey)
+		if serverKey != "" {
+			pub, err := keys.NewPublicKeyFromString(serverKey)
+			common.ExitOnErr(cmd, "invalid node key: %w", err)
+
+			tlsCfg = id.ClientConfig(pub.Bytes(), addr)
+		} else {
+			tlsCfg = &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+					return id.Certificate(), nil
+				},
+			}
+		}
+	}
+
+	cli, err := internalclient.GetSDKClientWithTLS(pk, addr, tlsCfg)
+	common.ExitOnErr(cmd, "can't create API client: %w", err)
+
+	return cli
 }
@@ -24,6 +24,7 @@ import (
 const (
 	noProgressFlag   = "no-progress"
 	notificationFlag = "notify"
+	resumeFlag       = "resume"
 )
 
 var putExpiredOn uint64
@@ -55,6 +56,9 @@ func initObjectPutCmd() {
 	flags.Bool(noProgressFlag, false, "Do not show progress bar")
 
 	flags.String(notificationFlag, "", "Object notification in the form of *epoch*:*topic*; '-' topic means using default")
+	flags.String(resumeFlag, "", "Upload state file: payload is split and all the objects are signed and put by the client, "+
+		"interrupted upload is continued from the file if it exists; session token, if any, must be issued for the client key")
+	_ = objectPutCmd.MarkFlagFilename(resumeFlag)
 }
 
 func putObject(cmd *cobra.Command, _ []string) {
@@ -108,6 +112,11 @@ func putObject(cmd *cobra.Command, _ []string) {
 		obj.SetNotification(*notificationInfo)
 	}
 
+	if statePath, _ := cmd.Flags().GetString(resumeFlag); statePath != "" {
+		putObjectResumable(cmd, pk, cnr, obj, f, statePath)
+		return
+	}
+
 	var prm internalclient.PutObjectPrm
 	sessionCli.Prepare(cmd, cnr, nil, pk, &prm)
 	Prepare(cmd, &prm)
@@ -0,0 +1,336 @@
+package object
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/cheggaaa/pb"
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+// putState is a state of the resumable object upload stored in a file.
+type putState struct {
+	Header           json.RawMessage `json:"header"`
+	FileSize         int64           `json:"file_size"`
+	SplitID          string          `json:"split_id"`
+	Children         []string        `json:"children"`
+	Written          uint64          `json:"written"`
+	PrefixHash       []byte          `json:"prefix_hash"`
+	PayloadHashState []byte          `json:"payload_hash_state"`
+	HomomorphicHash  []byte          `json:"homomorphic_hash,omitempty"`
+}
+
+// putObjectResumable splits the payload on the client side and puts every
+// child object with a separate request. The split-chain state is saved to the
+// file after each stored child, so the interrupted upload can be continued
+// from it by the next call, the state file is removed after the upload.
+//
+// Linking and parent objects are formed and signed by the client too, so
+// the upload is finalized without any node-side support. If the session
+// token is specified, it must be issued for the client key: all the objects
+// are owned by the token issuer and carry the token.
+func putObjectResumable(cmd *cobra.Command, pk *ecdsa.PrivateKey, cnr cid.ID, hdr *object.Object, f *os.File, statePath string) {
+	fi, err := f.Stat()
+	common.ExitOnErr(cmd, "can't get file size: %w", err)
+
+	cli := internalclient.GetSDKClientByFlag(cmd, pk, commonflags.RPC)
+
+	var netInfoPrm internalclient.NetworkInfoPrm
+	netInfoPrm.SetClient(cli)
+
+	ni, err := internalclient.NetworkInfo(netInfoPrm)
+	common.ExitOnErr(cmd, "can't fetch network info: %w", err)
+
+	var owner user.ID
+
+	tok := readResumeSession(cmd, pk, cnr, ni.NetworkInfo().CurrentEpoch())
+	if tok != nil {
+		owner = tok.Issuer()
+	} else {
+		user.IDFromKey(&owner, pk.PublicKey)
+	}
+
+	hdr.SetOwnerID(&owner)
+
+	splitState, hdr, err := readPutState(statePath, hdr, f, fi.Size())
+	common.ExitOnErr(cmd, "can't read upload state: %w", err)
+
+	if hdrOwner := hdr.OwnerID(); hdrOwner == nil || !hdrOwner.Equals(owner) {
+		common.ExitOnErr(cmd, "", fmt.Errorf("owner of the started upload %s differs from %s, "+
+			"use the same session token issuer as for the started upload", hdrOwner, owner))
+	}
+
+	var getCnrPrm internalclient.GetContainerPrm
+	getCnrPrm.SetClient(cli)
+	getCnrPrm.SetContainer(cnr)
+
+	resGetCnr, err := internalclient.GetContainer(getCnrPrm)
+	common.ExitOnErr(cmd, "get container RPC call: %w", err)
+
+	var prm internalclient.PutObjectPrm
+	prm.SetClient(cli)
+	Prepare(cmd, &prm)
+
+	if tok != nil {
+		prm.SetSessionToken(tok)
+	}
+
+	hdrJSON, err := hdr.MarshalJSON()
+	common.ExitOnErr(cmd, "can't encode object header: %w", err)
+
+	target, err := transformer.NewResumablePayloadSizeLimiter(
+		ni.NetworkInfo().MaxObjectSize(),
+		container.IsHomomorphicHashingDisabled(resGetCnr.Container()),
+		func() transformer.ObjectTarget {
+			return transformer.NewFormatTarget(&transformer.FormatterParams{
+				Key:          pk,
+				NextTarget:   &remotePutTarget{prm: prm},
+				SessionToken: tok,
+				NetworkState: epochState(ni.NetworkInfo().CurrentEpoch()),
+			})
+		},
+		splitState,
+		func(s transformer.SplitState) error {
+			return writePutState(statePath, hdrJSON, fi.Size(), s)
+		},
+	)
+	common.ExitOnErr(cmd, "can't continue the upload: %w", err)
+
+	var written int64
+	if splitState != nil {
+		written = int64(splitState.Written)
+
+		_, err = f.Seek(written, io.SeekStart)
+		common.ExitOnErr(cmd, "can't skip uploaded payload: %w", err)
+
+		cmd.Printf("Continuing the upload from %d bytes\n", written)
+	}
+
+	var rdr io.Reader = f
+
+	noProgress, _ := cmd.Flags().GetBool(noProgressFlag)
+	if !noProgress {
+		p := pb.New64(fi.Size())
+		p.Output = cmd.OutOrStdout()
+		p.Set64(written)
+		p.Start()
+
+		defer p.Finish()
+
+		rdr = p.NewProxyReader(f)
+	}
+
+	err = target.WriteHeader(hdr)
+	common.ExitOnErr(cmd, "can't write object header: %w", err)
+
+	_, err = io.Copy(target, rdr)
+	common.ExitOnErr(cmd, "can't upload object payload, rerun the command to continue: %w", err)
+
+	ids, err := target.Close()
+	common.ExitOnErr(cmd, "can't finish the upload, rerun the command to continue: %w", err)
+
+	if err := os.Remove(statePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		cmd.PrintErrf("Failed to remove upload state file: %v\n", err)
+	}
+
+	id := ids.SelfID()
+	if parID := ids.ParentID(); parID != nil {
+		id = *parID
+	}
+
+	cmd.Printf("[%s] Object successfully stored\n", f.Name())
+	cmd.Printf("  ID: %s\n  CID: %s\n", id, cnr)
+}
+
+// readResumeSession reads the session token specified by the flag, nil is
+// returned if the flag is not set. Objects are signed by the client, so the
+// token must be issued for the client key.
+func readResumeSession(cmd *cobra.Command, pk *ecdsa.PrivateKey, cnr cid.ID, epoch uint64) *session.Object {
+	path, _ := cmd.Flags().GetString(commonflags.SessionToken)
+	if path == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	common.ExitOnErr(cmd, "can't read session token: %w", err)
+
+	tok := new(session.Object)
+
+	if err := tok.Unmarshal(data); err != nil {
+		err = tok.UnmarshalJSON(data)
+		common.ExitOnErr(cmd, "can't unmarshal session token: %w", err)
+	}
+
+	pub := neofsecdsa.PublicKey(pk.PublicKey)
+
+	switch {
+	case !tok.VerifySignature():
+		err = errors.New("invalid session token signature")
+	case tok.ExpiredAt(epoch):
+		err = fmt.Errorf("session token is expired at epoch %d", epoch)
+	case !tok.AssertVerb(session.VerbObjectPut):
+		err = errors.New("session token is not issued for PUT operation")
+	case !tok.AssertContainer(cnr):
+		err = fmt.Errorf("session token is not issued for container %s", cnr)
+	case !tok.AssertAuthKey(&pub):
+		err = errors.New("session token is not issued for the client key, objects are signed by the client in resumable upload")
+	}
+
+	common.ExitOnErr(cmd, "", err)
+
+	return tok
+}
+
+// readPutState reads the upload state from the file. If the file does not
+// exist, the upload is started from the beginning with the provided header.
+// The uploaded prefix of the payload file must be the same as on the start.
+func readPutState(path string, hdr *object.Object, f io.ReaderAt, fileSize int64) (*transformer.SplitState, *object.Object, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, hdr, nil
+		}
+		return nil, nil, err
+	}
+
+	var st putState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, nil, fmt.Errorf("invalid state file: %w", err)
+	}
+
+	if st.FileSize != fileSize {
+		return nil, nil, fmt.Errorf("file size %d differs from the one of the started upload %d", fileSize, st.FileSize)
+	}
+
+	if st.Written > uint64(fileSize) {
+		return nil, nil, fmt.Errorf("uploaded payload size %d exceeds file size %d", st.Written, fileSize)
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, io.NewSectionReader(f, 0, int64(st.Written))); err != nil {
+		return nil, nil, fmt.Errorf("can't hash uploaded payload: %w", err)
+	}
+
+	if !bytes.Equal(h.Sum(nil), st.PrefixHash) {
+		return nil, nil, errors.New("uploaded part of the file differs from the one of the started upload")
+	}
+
+	// header of the started upload is used, so the parent object is the same
+	hdr = object.New()
+	if err := hdr.UnmarshalJSON(st.Header); err != nil {
+		return nil, nil, fmt.Errorf("invalid object header: %w", err)
+	}
+
+	res := &transformer.SplitState{
+		SplitID:          object.NewSplitID(),
+		Children:         make([]oid.ID, len(st.Children)),
+		Written:          st.Written,
+		PayloadHashState: st.PayloadHashState,
+		HomomorphicHash:  st.HomomorphicHash,
+	}
+
+	if err := res.SplitID.Parse(st.SplitID); err != nil {
+		return nil, nil, fmt.Errorf("invalid split ID: %w", err)
+	}
+
+	for i := range st.Children {
+		if err := res.Children[i].DecodeString(st.Children[i]); err != nil {
+			return nil, nil, fmt.Errorf("invalid child ID #%d: %w", i, err)
+		}
+	}
+
+	return res, hdr, nil
+}
+
+// writePutState atomically replaces the upload state file.
+func writePutState(path string, hdr json.RawMessage, fileSize int64, s transformer.SplitState) error {
+	// the written payload is the file prefix, so its checksum
+	// is the checksum of the uploaded prefix
+	h := sha256.New()
+	if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(s.PayloadHashState); err != nil {
+		return fmt.Errorf("invalid payload hash state: %w", err)
+	}
+
+	st := putState{
+		Header:           hdr,
+		FileSize:         fileSize,
+		SplitID:          s.SplitID.String(),
+		Children:         make([]string, len(s.Children)),
+		Written:          s.Written,
+		PrefixHash:       h.Sum(nil),
+		PayloadHashState: s.PayloadHashState,
+		HomomorphicHash:  s.HomomorphicHash,
+	}
+
+	for i := range s.Children {
+		st.Children[i] = s.Children[i].EncodeToString()
+	}
+
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+type epochState uint64
+
+func (s epochState) CurrentEpoch() uint64 {
+	return uint64(s)
+}
+
+// remotePutTarget puts the object with a separate request on close.
+type remotePutTarget struct {
+	prm internalclient.PutObjectPrm
+
+	hdr *object.Object
+
+	payload []byte
+}
+
+func (t *remotePutTarget) WriteHeader(hdr *object.Object) error {
+	t.hdr = hdr
+	return nil
+}
+
+func (t *remotePutTarget) Write(p []byte) (int, error) {
+	t.payload = append(t.payload, p...)
+	return len(p), nil
+}
+
+func (t *remotePutTarget) Close() (*transformer.AccessIdentifiers, error) {
+	t.prm.SetHeader(t.hdr)
+	t.prm.SetPayloadReader(bytes.NewReader(t.payload))
+
+	res, err := internalclient.PutObject(t.prm)
+	if err != nil {
+		return nil, fmt.Errorf("can't put object: %w", err)
+	}
+
+	return new(transformer.AccessIdentifiers).WithSelfID(res.ID()), nil
+}
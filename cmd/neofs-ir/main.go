@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"reflect"
+	"sort"
 	"syscall"
 
 	"github.com/nspcc-dev/neofs-node/misc"
@@ -47,7 +49,7 @@ func main() {
 	cfg, err := newConfig(*configFile)
 	exitErr(err)
 
-	var logPrm logger.Prm
+	logPrm := new(logger.Prm)
 
 	err = logPrm.SetLevelString(
 		cfg.GetString("logger.level"),
@@ -57,9 +59,14 @@ func main() {
 	log, err := logger.NewLogger(logPrm)
 	exitErr(err)
 
-	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
+	// SIGHUP received during the startup is handled after the application is started
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
 	intErr := make(chan error) // internal inner ring errors
 
 	httpServers := initHTTPServers(cfg, log)
@@ -82,10 +89,17 @@ func main() {
 	log.Info("application started",
 		zap.String("version", misc.Version))
 
-	select {
-	case <-ctx.Done():
-	case err := <-intErr:
-		log.Info("internal error", zap.String("msg", err.Error()))
+loop:
+	for {
+		select {
+		case <-sighup:
+			reloadConfig(cfg, *configFile, logPrm, log)
+		case <-ctx.Done():
+			break loop
+		case err := <-intErr:
+			log.Info("internal error", zap.String("msg", err.Error()))
+			break loop
+		}
 	}
 
 	innerRing.Stop()
@@ -107,6 +121,59 @@ func main() {
 	log.Info("application stopped")
 }
 
+// reloadConfig re-reads the configuration file and applies the new
+// logging level. Inner Ring node doesn't support runtime reconfiguration
+// of the other values, their changes are logged as skipped.
+func reloadConfig(cfg *viper.Viper, path string, logPrm *logger.Prm, log *logger.Logger) {
+	log.Info("SIGHUP has been received, rereading configuration...")
+
+	if path == "" {
+		log.Info("application is started without the configuration file, nothing to reload")
+		return
+	}
+
+	oldValues := cfg.AllSettings()
+
+	err := cfg.ReadInConfig()
+	if err != nil {
+		log.Error("configuration reading failure",
+			zap.String("error", err.Error()))
+		return
+	}
+
+	newValues := cfg.AllSettings()
+	for section := range newValues {
+		if _, ok := oldValues[section]; !ok {
+			oldValues[section] = nil
+		}
+	}
+
+	var skipped []string
+
+	for section, value := range oldValues {
+		if section != "logger" && !reflect.DeepEqual(value, newValues[section]) {
+			skipped = append(skipped, section)
+		}
+	}
+
+	if len(skipped) != 0 {
+		sort.Strings(skipped)
+
+		log.Warn("configuration changes require restart, skipped",
+			zap.Strings("sections", skipped))
+	}
+
+	err = logPrm.SetLevelString(cfg.GetString("logger.level"))
+	if err != nil {
+		log.Error("invalid logging level, skipped",
+			zap.String("error", err.Error()))
+	} else {
+		logPrm.Reload()
+	}
+
+	log.Info("configuration has been reloaded")
+}
+
 func initHTTPServers(cfg *viper.Viper, log *logger.Logger) []*httputil.Server {
 	items := []struct {
 		cfgPrefix string
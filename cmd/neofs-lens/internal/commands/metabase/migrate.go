@@ -0,0 +1,88 @@
+package metabase
+
+import (
+	"os"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPath      = "path"
+	flagDryRun    = "dry-run"
+	flagBatchSize = "batch-size"
+)
+
+var (
+	vPath      string
+	vDryRun    bool
+	vBatchSize int
+)
+
+var migrateCommand = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade the metabase to the current version",
+	Long: `Upgrade the metabase to the version supported by this release in place.
+The storage node must be stopped. The progress is saved after every batch,
+so an interrupted migration is continued on the next run.`,
+	Run: migrateFunc,
+}
+
+func init() {
+	migrateCommand.Flags().StringVar(&vPath, flagPath, "", "Path to the metabase")
+	_ = migrateCommand.MarkFlagFilename(flagPath)
+	_ = migrateCommand.MarkFlagRequired(flagPath)
+
+	migrateCommand.Flags().BoolVar(&vDryRun, flagDryRun, false,
+		"Only print the pending migrations")
+	migrateCommand.Flags().IntVar(&vBatchSize, flagBatchSize, 0,
+		"Number of objects processed in a single transaction (default 1000)")
+}
+
+// epochState is a stub, migrations do not depend on the current epoch.
+type epochState struct{}
+
+func (epochState) CurrentEpoch() uint64 {
+	return 0
+}
+
+func migrateFunc(cmd *cobra.Command, _ []string) {
+	// metabase is created on open if missing
+	_, err := os.Stat(vPath)
+	common.ExitOnErr(cmd, common.Errf("invalid metabase path: %w", err))
+
+	db := meta.New(meta.WithPath(vPath), meta.WithEpochState(epochState{}))
+
+	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open(vDryRun)))
+	defer db.Close()
+
+	var prm meta.MigratePrm
+	prm.SetDryRun(vDryRun)
+	prm.SetBatchSize(vBatchSize)
+
+	res, err := db.Migrate(prm)
+	common.ExitOnErr(cmd, common.Errf("could not migrate metabase: %w", err))
+
+	if len(res.Migrations()) == 0 {
+		cmd.Printf("Metabase is up to date, version: %d\n", res.FromVersion())
+		return
+	}
+
+	for _, m := range res.Migrations() {
+		cmd.Printf("%d -> %d: %s", m.From, m.From+1, m.Description)
+		if m.Resumed {
+			cmd.Print(" (resumed)")
+		}
+		if !vDryRun {
+			cmd.Printf(", processed: %d", m.Processed)
+		}
+		cmd.Println()
+	}
+
+	if vDryRun {
+		cmd.Printf("Metabase can be migrated from version %d to %d\n", res.FromVersion(), res.ToVersion())
+	} else {
+		cmd.Printf("Metabase is migrated from version %d to %d\n", res.FromVersion(), res.ToVersion())
+	}
+}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
+	"os"
 	"path/filepath"
 	"sync"
 	"time"
@@ -15,6 +18,9 @@ import (
 	contractsconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/contracts"
 	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	blobovniczaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/blobovnicza"
+	fstreeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/fstree"
+	storageconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/storage"
 	loggerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/logger"
 	metricsconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/metrics"
 	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
@@ -22,6 +28,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
@@ -35,10 +44,14 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-node/pkg/network/cache"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/ratelimit"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone"
 	tsourse "github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone/source"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	trustcontroller "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/controller"
 	truststorage "github.com/nspcc-dev/neofs-node/pkg/services/reputation/local/storage"
 	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
@@ -46,7 +59,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
 	"github.com/panjf2000/ants/v2"
@@ -73,8 +88,12 @@ type cfg struct {
 
 	internalErr chan error // channel for internal application errors at runtime
 
+	sighup chan os.Signal // channel for configuration reload requests
+
 	log *zap.Logger
 
+	logPrm *logger.Prm // logger parameters, used to change logging level at runtime
+
 	wg *sync.WaitGroup
 
 	key *keys.PrivateKey
@@ -115,6 +134,10 @@ type cfg struct {
 
 	treeService *tree.Service
 
+	replicator *replicator.Replicator
+
+	policer *policer.Policer
+
 	healthStatus *atomic.Int32
 
 	closers []func()
@@ -123,6 +146,10 @@ type cfg struct {
 
 	clientCache *cache.ClientCache
 
+	// TLS certificate bound to the node key, nil if
+	// node-to-node TLS is disabled
+	tlsIdentity *network.TLSIdentity
+
 	persistate *state.PersistentStorage
 
 	netMapSource netmapCore.Source
@@ -192,6 +219,8 @@ type cfgObject struct {
 
 	pool cfgObjectRoutines
 
+	limiter *ratelimit.Service
+
 	cfgLocalStorage cfgLocalStorage
 }
 
@@ -199,12 +228,15 @@ type cfgNotifications struct {
 	enabled      bool
 	nw           notificationWriter
 	defaultTopic string
+	events       *objectEventFeed
 }
 
 type cfgLocalStorage struct {
 	localStorage *engine.StorageEngine
 
 	shardOpts [][]shard.Option
+
+	tombstoneSource *tombstone.ExpirationChecker
 }
 
 type cfgObjectRoutines struct {
@@ -217,6 +249,11 @@ type cfgObjectRoutines struct {
 
 type cfgControlService struct {
 	server *grpc.Server
+
+	svc *controlSvc.Server
+
+	// TLS identity of the control service, nil if TLS is disabled
+	tls *network.TLSIdentity
 }
 
 type cfgReputation struct {
@@ -240,7 +277,7 @@ func initCfg(path string) *cfg {
 
 	key := nodeconfig.Key(appCfg)
 
-	var logPrm logger.Prm
+	logPrm := new(logger.Prm)
 
 	err := logPrm.SetLevelString(
 		loggerconfig.Level(appCfg),
@@ -265,6 +302,8 @@ func initCfg(path string) *cfg {
 	persistate, err := state.NewPersistentStorage(nodeconfig.PersistentState(appCfg).Path())
 	fatalOnErr(err)
 
+	tlsIdentity := initTLSIdentity(appCfg, key, log)
+
 	containerWorkerPool, err := ants.NewPool(notificationHandlerPoolSize)
 	fatalOnErr(err)
 
@@ -278,7 +317,9 @@ func initCfg(path string) *cfg {
 		ctx:          context.Background(),
 		appCfg:       appCfg,
 		internalErr:  make(chan error),
+		sighup:       make(chan os.Signal, 1),
 		log:          log,
+		logPrm:       logPrm,
 		wg:           new(sync.WaitGroup),
 		key:          key,
 		binPublicKey: key.PublicKey().Bytes(),
@@ -319,8 +360,10 @@ func initCfg(path string) *cfg {
 		clientCache: cache.NewSDKClientCache(cache.ClientCacheOpts{
 			DialTimeout: apiclientconfig.DialTimeout(appCfg),
 			Key:         &key.PrivateKey,
+			TLS:         tlsIdentity,
 		}),
-		persistate: persistate,
+		tlsIdentity: tlsIdentity,
+		persistate:  persistate,
 	}
 
 	user.IDFromKey(&c.ownerIDFromKey, key.PrivateKey.PublicKey)
@@ -349,6 +392,7 @@ func initLocalStorage(c *cfg) {
 		engine.WithLogger(c.log),
 		engine.WithShardPoolSize(engineconfig.ShardPoolSize(c.appCfg)),
 		engine.WithErrorThreshold(engineconfig.ShardErrorThreshold(c.appCfg)),
+		engine.WithObjectEventHandler(c.handleObjectEvent),
 	}
 	if c.metricsCollector != nil {
 		engineOpts = append(engineOpts, engine.WithMetrics(c.metricsCollector))
@@ -364,13 +408,14 @@ func initLocalStorage(c *cfg) {
 	tssPrm.SetGetService(c.cfgObject.getSvc)
 	tombstoneSrc := tsourse.NewSource(tssPrm)
 
-	tombstoneSource := tombstone.NewChecker(
+	c.cfgObject.cfgLocalStorage.tombstoneSource = tombstone.NewChecker(
 		tombstone.WithLogger(c.log),
 		tombstone.WithTombstoneSource(tombstoneSrc),
 	)
 
 	for _, opts := range c.cfgObject.cfgLocalStorage.shardOpts {
-		id, err := ls.AddShard(append(opts, shard.WithTombstoneSource(tombstoneSource))...)
+		id, err := ls.AddShard(append(opts,
+			shard.WithTombstoneSource(c.cfgObject.cfgLocalStorage.tombstoneSource))...)
 		fatalOnErr(err)
 
 		c.log.Info("shard attached to engine",
@@ -380,6 +425,10 @@ func initLocalStorage(c *cfg) {
 
 	c.cfgObject.cfgLocalStorage.localStorage = ls
 
+	addNewEpochNotificationHandler(c, func(ev event.Event) {
+		ls.HandleNewEpoch(ev.(netmap2.NewEpoch).EpochNumber())
+	})
+
 	c.onShutdown(func() {
 		c.log.Info("closing components of the storage engine...")
 
@@ -395,102 +444,297 @@ func initLocalStorage(c *cfg) {
 }
 
 func initShardOptions(c *cfg) {
-	var opts [][]shard.Option
+	opts, err := c.readShardOptions()
+	fatalOnErr(err)
+
+	c.cfgObject.cfgLocalStorage.shardOpts = opts
+}
+
+// readShardOptions returns options to create the shards
+// listed in the application config.
+func (c *cfg) readShardOptions() ([][]shard.Option, error) {
+	var (
+		opts [][]shard.Option
+		err  error
+	)
 
 	require := !nodeconfig.Relay(c.appCfg) // relay node does not require shards
 
 	engineconfig.IterateShards(c.appCfg, require, func(sc *shardconfig.Config) {
-		var writeCacheOpts []writecache.Option
-
-		writeCacheCfg := sc.WriteCache()
-		if writeCacheCfg.Enabled() {
-			writeCacheOpts = []writecache.Option{
-				writecache.WithPath(writeCacheCfg.Path()),
-				writecache.WithLogger(c.log),
-				writecache.WithMaxBatchSize(writeCacheCfg.BoltDB().MaxBatchSize()),
-				writecache.WithMaxBatchDelay(writeCacheCfg.BoltDB().MaxBatchDelay()),
-				writecache.WithMaxMemSize(writeCacheCfg.MemSize()),
-				writecache.WithMaxObjectSize(writeCacheCfg.MaxObjectSize()),
-				writecache.WithSmallObjectSize(writeCacheCfg.SmallObjectSize()),
-				writecache.WithFlushWorkersCount(writeCacheCfg.WorkersNumber()),
-				writecache.WithMaxCacheSize(writeCacheCfg.SizeLimit()),
+		if err != nil {
+			return
+		}
+
+		var shOpts []shard.Option
+
+		shOpts, err = c.shardOpts(sc)
+		opts = append(opts, shOpts)
+	})
+
+	return opts, err
+}
+
+// parseShardConfig returns options to create a shard from the YAML or JSON
+// encoded shard config section. It is used to attach shards at runtime, so
+// invalid values are reported as an error instead of the panic.
+func (c *cfg) parseShardConfig(data []byte) (opts []shard.Option, err error) {
+	appCfg, err := config.NewFromYAML(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// config accessors panic on the values of invalid types,
+	// they are not checked one by one
+	defer func() {
+		if r := recover(); r != nil {
+			opts, err = nil, fmt.Errorf("invalid shard configuration: %v", r)
+		}
+	}()
+
+	sc := shardconfig.From(appCfg)
+
+	err = checkShardConfig(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err = c.shardOpts(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(opts, shard.WithTombstoneSource(c.cfgObject.cfgLocalStorage.tombstoneSource)), nil
+}
+
+// checkShardConfig checks the values of the shard config section which
+// are required or limited, config accessors panic on such invalid values.
+func checkShardConfig(sc *shardconfig.Config) error {
+	raw := (*config.Config)(sc)
+
+	if config.StringSafe(raw.Sub("metabase"), "path") == "" {
+		return errors.New("metabase path is missing")
+	}
+
+	storages := sc.BlobStor().Storages()
+	for i := range storages {
+		if config.StringSafe((*config.Config)(storages[i]), "path") == "" {
+			return fmt.Errorf("path of blobstor sub-storage #%d is missing", i)
+		}
+	}
+
+	if sc.WriteCache().Enabled() && config.StringSafe(raw.Sub("writecache"), "path") == "" {
+		return errors.New("write-cache path is missing")
+	}
+
+	switch m := config.StringSafe(raw, "mode"); m {
+	case "", "read-write", "read-only", "degraded", "degraded-read-only":
+	default:
+		return fmt.Errorf("unknown shard mode: %s", m)
+	}
+
+	if v := config.Uint32Safe(raw, "fill_watermark"); v > 100 {
+		return fmt.Errorf("fill watermark must be a percentage, got %d", v)
+	}
+
+	return nil
+}
+
+// shardOpts returns options to create a shard from the shard config section.
+func (c *cfg) shardOpts(sc *shardconfig.Config) ([]shard.Option, error) {
+	var writeCacheOpts []writecache.Option
+
+	writeCacheCfg := sc.WriteCache()
+	if writeCacheCfg.Enabled() {
+		writeCacheOpts = []writecache.Option{
+			writecache.WithPath(writeCacheCfg.Path()),
+			writecache.WithLogger(c.log),
+			writecache.WithMaxBatchSize(writeCacheCfg.BoltDB().MaxBatchSize()),
+			writecache.WithMaxBatchDelay(writeCacheCfg.BoltDB().MaxBatchDelay()),
+			writecache.WithMaxMemSize(writeCacheCfg.MemSize()),
+			writecache.WithMaxObjectSize(writeCacheCfg.MaxObjectSize()),
+			writecache.WithSmallObjectSize(writeCacheCfg.SmallObjectSize()),
+			writecache.WithFlushWorkersCount(writeCacheCfg.WorkersNumber()),
+			writecache.WithMaxCacheSize(writeCacheCfg.SizeLimit()),
+		}
+	}
+
+	blobStorCfg := sc.BlobStor()
+	storages := blobStorCfg.Storages()
+	if len(storages) == 0 {
+		return nil, errors.New("no blobstor sub-storages are configured")
+	}
+
+	metabaseCfg := sc.Metabase()
+	gcCfg := sc.GC()
+
+	var piloramaOpts []pilorama.Option
+
+	piloramaCfg := sc.Pilorama()
+	if config.BoolSafe(c.appCfg.Sub("tree"), "enabled") {
+		piloramaPath := piloramaCfg.Path()
+		if piloramaPath == "" {
+			piloramaPath = filepath.Join(storages[len(storages)-1].Path(), "pilorama.db")
+		}
+
+		piloramaOpts = []pilorama.Option{
+			pilorama.WithPath(piloramaPath),
+			pilorama.WithPerm(piloramaCfg.Perm()),
+			pilorama.WithNoSync(piloramaCfg.NoSync()),
+			pilorama.WithMaxBatchSize(piloramaCfg.MaxBatchSize()),
+			pilorama.WithMaxBatchDelay(piloramaCfg.MaxBatchDelay())}
+	}
+
+	ss := make([]blobstor.SubStorage, 0, len(storages))
+	for i := range storages {
+		switch storages[i].Type() {
+		case blobovniczatree.Type:
+			sub := blobovniczaconfig.From((*config.Config)(storages[i]))
+			lim := sc.SmallSizeLimit()
+			ss = append(ss, blobstor.SubStorage{
+				Storage: blobovniczatree.NewBlobovniczaTree(
+					blobovniczatree.WithRootPath(storages[i].Path()),
+					blobovniczatree.WithPermissions(storages[i].Perm()),
+					blobovniczatree.WithBlobovniczaSize(sub.Size()),
+					blobovniczatree.WithBlobovniczaShallowDepth(sub.ShallowDepth()),
+					blobovniczatree.WithBlobovniczaShallowWidth(sub.ShallowWidth()),
+					blobovniczatree.WithOpenedCacheSize(sub.OpenedCacheSize()),
+					blobovniczatree.WithLogger(c.log)),
+				Policy: func(_ *objectSDK.Object, data []byte) bool {
+					return uint64(len(data)) <= lim
+				},
+			})
+		case fstree.Type:
+			sub := fstreeconfig.From((*config.Config)(storages[i]))
+			ss = append(ss, blobstor.SubStorage{
+				Storage: fstree.New(
+					fstree.WithPath(storages[i].Path()),
+					fstree.WithPerm(storages[i].Perm()),
+					fstree.WithDepth(sub.Depth())),
+			})
+		default:
+			return nil, fmt.Errorf("invalid blobstor sub-storage type: %s", storages[i].Type())
+		}
+	}
+
+	compressionRules, err := readCompressionRules(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	dedupOpts, err := readDedupConfig(sc)
+	if err != nil {
+		return nil, err
+	}
+
+	metaPath := metabaseCfg.Path()
+	metaPerm := metabaseCfg.BoltDB().Perm()
+	if err := util.MkdirAllX(filepath.Dir(metaPath), metaPerm); err != nil {
+		return nil, fmt.Errorf("could not create metabase directory: %w", err)
+	}
+
+	return []shard.Option{
+		shard.WithLogger(c.log),
+		shard.WithRefillMetabase(sc.RefillMetabase()),
+		shard.WithMode(sc.Mode()),
+		shard.WithFillWatermark(float64(sc.FillWatermark()) / 100),
+		shard.WithBlobStorOptions(append([]blobstor.Option{
+			blobstor.WithCompressObjects(sc.Compress()),
+			blobstor.WithUncompressableContentTypes(sc.UncompressableContentTypes()),
+			blobstor.WithCompressionCodec(compression.Type(sc.CompressionCodec()), sc.CompressionLevel()),
+			blobstor.WithCompressionRules(compressionRules),
+			blobstor.WithCompressibilityEstimate(sc.EstimateCompressibility(), sc.EstimateCompressibilityThreshold()),
+			blobstor.WithStorages(ss),
+			blobstor.WithLogger(c.log),
+		}, dedupOpts...)...),
+		shard.WithMetaBaseOptions(
+			meta.WithLogger(c.log),
+			meta.WithPath(metaPath),
+			meta.WithPermissions(metaPerm),
+			meta.WithMaxBatchSize(metabaseCfg.BoltDB().MaxBatchSize()),
+			meta.WithMaxBatchDelay(metabaseCfg.BoltDB().MaxBatchDelay()),
+			meta.WithBoltDBOptions(&bbolt.Options{
+				Timeout: 100 * time.Millisecond,
+			}),
+			meta.WithEpochState(c.cfgNetmap.state),
+		),
+		shard.WithPiloramaOptions(piloramaOpts...),
+		shard.WithWriteCache(writeCacheCfg.Enabled()),
+		shard.WithWriteCacheOptions(writeCacheOpts...),
+		shard.WithRemoverBatchSize(gcCfg.RemoverBatchSize()),
+		shard.WithGCRemoverSleepInterval(gcCfg.RemoverSleepInterval()),
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			fatalOnErr(err)
+
+			return pool
+		}),
+	}, nil
+}
+
+// readCompressionRules reads compression rules from the shard config section.
+func readCompressionRules(sc *shardconfig.Config) ([]compression.Rule, error) {
+	rulesCfg := sc.CompressionRules()
+
+	rules := make([]compression.Rule, len(rulesCfg))
+	for i := range rulesCfg {
+		rules[i].Codec = compression.Type(rulesCfg[i].Codec())
+		if !compression.IsRegistered(rules[i].Codec) {
+			return nil, fmt.Errorf("invalid codec of compression rule #%d: %s", i, rules[i].Codec)
+		}
+
+		rules[i].ContentTypes = rulesCfg[i].ContentTypes()
+
+		cnrs := rulesCfg[i].Containers()
+		rules[i].Containers = make([]cid.ID, len(cnrs))
+		for j := range cnrs {
+			if err := rules[i].Containers[j].DecodeString(cnrs[j]); err != nil {
+				return nil, fmt.Errorf("invalid container of compression rule #%d: %w", i, err)
 			}
 		}
+	}
 
-		blobStorCfg := sc.BlobStor()
-		blobovniczaCfg := blobStorCfg.Blobovnicza()
-		metabaseCfg := sc.Metabase()
-		gcCfg := sc.GC()
+	return rules, nil
+}
+
+// readDedupConfig reads payload deduplication options from the shard config section.
+func readDedupConfig(sc *shardconfig.Config) ([]blobstor.Option, error) {
+	dedupCfg := sc.Dedup()
+
+	path := dedupCfg.Path()
+	if path == "" {
+		return nil, nil
+	}
+
+	var filter func(*objectSDK.Object) bool
 
-		var piloramaOpts []pilorama.Option
+	if !dedupCfg.Enabled() {
+		strCnrs := dedupCfg.Containers()
+		cnrs := make(map[cid.ID]struct{}, len(strCnrs))
 
-		piloramaCfg := sc.Pilorama()
-		if config.BoolSafe(c.appCfg.Sub("tree"), "enabled") {
-			piloramaPath := piloramaCfg.Path()
-			if piloramaPath == "" {
-				piloramaPath = filepath.Join(blobStorCfg.Path(), "pilorama.db")
+		for i := range strCnrs {
+			var cnr cid.ID
+			if err := cnr.DecodeString(strCnrs[i]); err != nil {
+				return nil, fmt.Errorf("invalid container of payload deduplication: %w", err)
 			}
 
-			piloramaOpts = []pilorama.Option{
-				pilorama.WithPath(piloramaPath),
-				pilorama.WithPerm(piloramaCfg.Perm()),
-				pilorama.WithNoSync(piloramaCfg.NoSync()),
-				pilorama.WithMaxBatchSize(piloramaCfg.MaxBatchSize()),
-				pilorama.WithMaxBatchDelay(piloramaCfg.MaxBatchDelay())}
+			cnrs[cnr] = struct{}{}
 		}
 
-		metaPath := metabaseCfg.Path()
-		metaPerm := metabaseCfg.BoltDB().Perm()
-		fatalOnErr(util.MkdirAllX(filepath.Dir(metaPath), metaPerm))
-
-		gcEventChannel := make(chan shard.Event)
-		addNewEpochNotificationHandler(c, func(ev event.Event) {
-			gcEventChannel <- shard.EventNewEpoch(ev.(netmap2.NewEpoch).EpochNumber())
-		})
-
-		opts = append(opts, []shard.Option{
-			shard.WithLogger(c.log),
-			shard.WithRefillMetabase(sc.RefillMetabase()),
-			shard.WithMode(sc.Mode()),
-			shard.WithBlobStorOptions(
-				blobstor.WithRootPath(blobStorCfg.Path()),
-				blobstor.WithCompressObjects(blobStorCfg.Compress()),
-				blobstor.WithRootPerm(blobStorCfg.Perm()),
-				blobstor.WithShallowDepth(blobStorCfg.ShallowDepth()),
-				blobstor.WithSmallSizeLimit(blobStorCfg.SmallSizeLimit()),
-				blobstor.WithBlobovniczaSize(blobovniczaCfg.Size()),
-				blobstor.WithBlobovniczaShallowDepth(blobovniczaCfg.ShallowDepth()),
-				blobstor.WithBlobovniczaShallowWidth(blobovniczaCfg.ShallowWidth()),
-				blobstor.WithBlobovniczaOpenedCacheSize(blobovniczaCfg.OpenedCacheSize()),
-				blobstor.WithLogger(c.log),
-			),
-			shard.WithMetaBaseOptions(
-				meta.WithLogger(c.log),
-				meta.WithPath(metaPath),
-				meta.WithPermissions(metaPerm),
-				meta.WithMaxBatchSize(metabaseCfg.BoltDB().MaxBatchSize()),
-				meta.WithMaxBatchDelay(metabaseCfg.BoltDB().MaxBatchDelay()),
-				meta.WithBoltDBOptions(&bbolt.Options{
-					Timeout: 100 * time.Millisecond,
-				}),
-				meta.WithEpochState(c.cfgNetmap.state),
-			),
-			shard.WithPiloramaOptions(piloramaOpts...),
-			shard.WithWriteCache(writeCacheCfg.Enabled()),
-			shard.WithWriteCacheOptions(writeCacheOpts...),
-			shard.WithRemoverBatchSize(gcCfg.RemoverBatchSize()),
-			shard.WithGCRemoverSleepInterval(gcCfg.RemoverSleepInterval()),
-			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
-				pool, err := ants.NewPool(sz)
-				fatalOnErr(err)
-
-				return pool
-			}),
-			shard.WithGCEventChannel(gcEventChannel),
-		})
-	})
+		filter = func(obj *objectSDK.Object) bool {
+			cnr, _ := obj.ContainerID()
+			_, ok := cnrs[cnr]
+			return ok
+		}
+	}
 
-	c.cfgObject.cfgLocalStorage.shardOpts = opts
+	return []blobstor.Option{
+		blobstor.WithPayloadDedup(blobstor.DedupPrm{
+			Storage: fstree.New(
+				fstree.WithPath(path),
+				fstree.WithPerm(storageconfig.PermDefault)),
+			Filter: filter,
+		}),
+	}, nil
 }
 
 func initObjectPool(cfg *config.Config) (pool cfgObjectRoutines) {
@@ -549,5 +793,5 @@ func (c *cfg) needBootstrap() bool {
 // It is calculated as size/capacity ratio of "remote object put" worker.
 // Returns float value between 0.0 and 1.0.
 func (c *cfg) ObjectServiceLoad() float64 {
-	return float64(c.cfgObject.pool.putRemote.Running()) / float64(c.cfgObject.pool.putRemoteCapacity)
+	return float64(c.cfgObject.pool.putRemote.Running()) / float64(c.cfgObject.pool.putRemote.Cap())
 }
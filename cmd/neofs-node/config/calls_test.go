@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
@@ -77,3 +78,37 @@ func TestConfig_SetDefault(t *testing.T) {
 		require.Equal(t, "y", config.String(s, "overridden"))
 	})
 }
+
+func TestNewFromYAML(t *testing.T) {
+	for _, data := range []string{
+		"section:\n  value: 42\n",
+		`{"section": {"value": 42}}`,
+	} {
+		c, err := config.NewFromYAML([]byte(data))
+		require.NoError(t, err)
+		require.Equal(t, uint32(42), config.Uint32(c.Sub("section"), "value"))
+	}
+
+	_, err := config.NewFromYAML([]byte("section: ["))
+	require.Error(t, err)
+}
+
+func TestConfig_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("section:\n  value: 1\n"), 0600))
+
+	c := config.New(config.Prm{}, config.WithConfigFile(path))
+	sub := c.Sub("section")
+	require.Equal(t, uint32(1), config.Uint32(sub, "value"))
+
+	require.NoError(t, os.WriteFile(path, []byte("section:\n  value: 2\n"), 0600))
+	require.NoError(t, c.Reload())
+	require.Equal(t, uint32(2), config.Uint32(sub, "value"))
+
+	// Invalid file doesn't change the values.
+	require.NoError(t, os.WriteFile(path, []byte("section: ["), 0600))
+	require.Error(t, c.Reload())
+	require.Equal(t, uint32(2), config.Uint32(sub, "value"))
+
+	require.NoError(t, config.New(config.Prm{}).Reload())
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -19,6 +20,10 @@ type Config struct {
 
 	defaultPath []string
 	path        []string
+
+	// path to the configuration file,
+	// used to reload the Config
+	file string
 }
 
 const separator = "."
@@ -53,6 +58,41 @@ func New(_ Prm, opts ...Option) *Config {
 	}
 
 	return &Config{
-		v: v,
+		v:    v,
+		file: o.path,
 	}
 }
+
+// Reload re-reads the configuration file the Config was created with
+// (see WithConfigFile). Sub-sections of the Config see new values as well.
+//
+// Returns an error if the file can't be read, the values are left
+// unchanged in this case. Does nothing if the Config has no file.
+func (x *Config) Reload() error {
+	if x.file == "" {
+		return nil
+	}
+
+	err := x.v.ReadInConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+
+	return nil
+}
+
+// NewFromYAML creates a new Config instance from the YAML encoded data.
+// JSON data is accepted as well. Environment variables are not used.
+func NewFromYAML(data []byte) (*Config, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	err := v.ReadConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	return &Config{
+		v: v,
+	}, nil
+}
@@ -13,9 +13,16 @@ type GRPCConfig struct {
 	cfg *config.Config
 }
 
+// TLSConfig is a wrapper over "tls" subsection of "grpc" config section
+// which provides access to mutual TLS configuration of control service.
+type TLSConfig struct {
+	cfg *config.Config
+}
+
 const (
 	subsection     = "control"
 	grpcSubsection = "grpc"
+	tlsSubsection  = "tls"
 
 	// GRPCEndpointDefault is a default endpoint of gRPC Control service.
 	GRPCEndpointDefault = ""
@@ -60,3 +67,33 @@ func (g GRPCConfig) Endpoint() string {
 
 	return GRPCEndpointDefault
 }
+
+// TLS returns a structure that provides access to "tls" subsection of
+// "grpc" subsection of "control" section.
+func (g GRPCConfig) TLS() TLSConfig {
+	return TLSConfig{
+		g.cfg.Sub(tlsSubsection),
+	}
+}
+
+// Enabled returns the value of "enabled" config parameter.
+//
+// Returns false if the value is not presented.
+func (t TLSConfig) Enabled() bool {
+	return config.BoolSafe(t.cfg, "enabled")
+}
+
+// CertificateFile returns the value of "certificate" config parameter.
+//
+// Returns empty string if the value is not presented, the certificate
+// bound to the node key is used in this case.
+func (t TLSConfig) CertificateFile() string {
+	return config.StringSafe(t.cfg, "certificate")
+}
+
+// KeyFile returns the value of "key" config parameter.
+//
+// Returns empty string if the value is not presented.
+func (t TLSConfig) KeyFile() string {
+	return config.StringSafe(t.cfg, "key")
+}
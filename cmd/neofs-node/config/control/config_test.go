@@ -16,6 +16,11 @@ func TestControlSection(t *testing.T) {
 
 		require.Empty(t, controlconfig.AuthorizedKeys(empty))
 		require.Equal(t, controlconfig.GRPCEndpointDefault, controlconfig.GRPC(empty).Endpoint())
+
+		tlsCfg := controlconfig.GRPC(empty).TLS()
+		require.False(t, tlsCfg.Enabled())
+		require.Empty(t, tlsCfg.CertificateFile())
+		require.Empty(t, tlsCfg.KeyFile())
 	})
 
 	const path = "../../../../config/example/node"
@@ -27,6 +32,11 @@ func TestControlSection(t *testing.T) {
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, pubs, controlconfig.AuthorizedKeys(c))
 		require.Equal(t, "localhost:8090", controlconfig.GRPC(c).Endpoint())
+
+		tlsCfg := controlconfig.GRPC(c).TLS()
+		require.True(t, tlsCfg.Enabled())
+		require.Equal(t, "/path/to/control/cert", tlsCfg.CertificateFile())
+		require.Equal(t, "/path/to/control/key", tlsCfg.KeyFile())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -35,11 +35,11 @@ func IterateShards(c *config.Config, required bool, f func(*shardconfig.Config))
 			c.Sub(si),
 		)
 
-		// Path for the blobstor can't be present in the default section, because different shards
+		// Path for the metabase can't be present in the default section, because different shards
 		// must have different paths, so if it is missing, the shard is not here.
-		// At the same time checking for "blobstor" section doesn't work proper
+		// At the same time checking for "metabase" section doesn't work proper
 		// with configuration via the environment.
-		if (*config.Config)(sc).Value("blobstor.path") == nil {
+		if (*config.Config)(sc).Value("metabase.path") == nil {
 			break
 		}
 		(*config.Config)(sc).SetDefault(def)
@@ -8,6 +8,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	blobovniczaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/blobovnicza"
+	fstreeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/fstree"
 	piloramaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/pilorama"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
@@ -35,6 +37,9 @@ func TestEngineSection(t *testing.T) {
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
 		require.EqualValues(t, mode.ReadWrite, shardconfig.From(empty).Mode())
+		require.EqualValues(t, 0, shardconfig.From(empty).FillWatermark())
+		require.Empty(t, shardconfig.From(empty).Dedup().Path())
+		require.False(t, shardconfig.From(empty).Dedup().Enabled())
 	})
 
 	const path = "../../../../config/example/node"
@@ -52,10 +57,10 @@ func TestEngineSection(t *testing.T) {
 
 			wc := sc.WriteCache()
 			meta := sc.Metabase()
-			blob := sc.BlobStor()
-			blz := blob.Blobovnicza()
+			ss := sc.BlobStor().Storages()
 			pl := sc.Pilorama()
 			gc := sc.GC()
+			dedup := sc.Dedup()
 
 			switch num {
 			case 0:
@@ -79,23 +84,47 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, 100, meta.BoltDB().MaxBatchSize())
 				require.Equal(t, 10*time.Millisecond, meta.BoltDB().MaxBatchDelay())
 
-				require.Equal(t, "tmp/0/blob", blob.Path())
-				require.EqualValues(t, 0644, blob.Perm())
-				require.Equal(t, true, blob.Compress())
-				require.Equal(t, []string{"audio/*", "video/*"}, blob.UncompressableContentTypes())
-				require.EqualValues(t, 5, blob.ShallowDepth())
-				require.EqualValues(t, 102400, blob.SmallSizeLimit())
-
+				require.Equal(t, true, sc.Compress())
+				require.Equal(t, "zstd", sc.CompressionCodec())
+				require.Equal(t, 3, sc.CompressionLevel())
+				require.Equal(t, []string{"audio/*", "video/*"}, sc.UncompressableContentTypes())
+				require.Equal(t, true, sc.EstimateCompressibility())
+				require.Equal(t, 0.15, sc.EstimateCompressibilityThreshold())
+
+				rules := sc.CompressionRules()
+				require.Len(t, rules, 3)
+				require.Equal(t, "s2", rules[0].Codec())
+				require.Equal(t, []string{"text/*"}, rules[0].ContentTypes())
+				require.Equal(t, []string(nil), rules[0].Containers())
+				require.Equal(t, "lz4", rules[1].Codec())
+				require.Equal(t, []string{"application/json"}, rules[1].ContentTypes())
+				require.Equal(t, "none", rules[2].Codec())
+				require.Equal(t, []string{"4wBqpZM9xaSheZzJSMawUKKwhdpChKbZ5eu5ky4Vigw"}, rules[2].Containers())
+				require.EqualValues(t, 102400, sc.SmallSizeLimit())
+
+				require.Equal(t, 2, len(ss))
+				blz := blobovniczaconfig.From((*config.Config)(ss[0]))
+				require.Equal(t, "tmp/0/blob/blobovnicza", ss[0].Path())
+				require.EqualValues(t, 0644, blz.BoltDB().Perm())
 				require.EqualValues(t, 4194304, blz.Size())
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
 
+				require.Equal(t, "tmp/0/blob", ss[1].Path())
+				require.EqualValues(t, 0644, ss[1].Perm())
+				require.EqualValues(t, 5, fstreeconfig.From((*config.Config)(ss[1])).Depth())
+
+				require.Equal(t, "tmp/0/payloads", dedup.Path())
+				require.False(t, dedup.Enabled())
+				require.Equal(t, []string{"4wBqpZM9xaSheZzJSMawUKKwhdpChKbZ5eu5ky4Vigw"}, dedup.Containers())
+
 				require.EqualValues(t, 150, gc.RemoverBatchSize())
 				require.Equal(t, 2*time.Minute, gc.RemoverSleepInterval())
 
 				require.Equal(t, false, sc.RefillMetabase())
 				require.Equal(t, mode.ReadOnly, sc.Mode())
+				require.EqualValues(t, 95, sc.FillWatermark())
 			case 1:
 				require.Equal(t, "tmp/1/blob/pilorama.db", pl.Path())
 				require.Equal(t, fs.FileMode(0644), pl.Perm())
@@ -117,23 +146,36 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, 200, meta.BoltDB().MaxBatchSize())
 				require.Equal(t, 20*time.Millisecond, meta.BoltDB().MaxBatchDelay())
 
-				require.Equal(t, "tmp/1/blob", blob.Path())
-				require.EqualValues(t, 0644, blob.Perm())
-				require.Equal(t, false, blob.Compress())
-				require.Equal(t, []string(nil), blob.UncompressableContentTypes())
-				require.EqualValues(t, 5, blob.ShallowDepth())
-				require.EqualValues(t, 102400, blob.SmallSizeLimit())
-
+				require.Equal(t, false, sc.Compress())
+				require.Equal(t, "", sc.CompressionCodec())
+				require.Equal(t, []string(nil), sc.UncompressableContentTypes())
+				require.Equal(t, false, sc.EstimateCompressibility())
+				require.Len(t, sc.CompressionRules(), 0)
+				require.EqualValues(t, 102400, sc.SmallSizeLimit())
+
+				require.Equal(t, 2, len(ss))
+				blz := blobovniczaconfig.From((*config.Config)(ss[0]))
+				require.Equal(t, "tmp/1/blob/blobovnicza", ss[0].Path())
+				require.EqualValues(t, 0644, blz.BoltDB().Perm())
 				require.EqualValues(t, 4194304, blz.Size())
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
 
+				require.Equal(t, "tmp/1/blob", ss[1].Path())
+				require.EqualValues(t, 0644, ss[1].Perm())
+				require.EqualValues(t, 5, fstreeconfig.From((*config.Config)(ss[1])).Depth())
+
+				require.Equal(t, "tmp/1/payloads", dedup.Path())
+				require.True(t, dedup.Enabled())
+				require.Empty(t, dedup.Containers())
+
 				require.EqualValues(t, 200, gc.RemoverBatchSize())
 				require.Equal(t, 5*time.Minute, gc.RemoverSleepInterval())
 
 				require.Equal(t, true, sc.RefillMetabase())
 				require.Equal(t, mode.ReadWrite, sc.Mode())
+				require.EqualValues(t, 0, sc.FillWatermark())
 			}
 		})
 
@@ -3,6 +3,7 @@ package blobovniczaconfig
 import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	boltdbconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/boltdb"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 )
 
 // Config is a wrapper over the config section
@@ -29,6 +30,11 @@ func From(c *config.Config) *Config {
 	return (*Config)(c)
 }
 
+// Type returns the storage type.
+func (x *Config) Type() string {
+	return blobovniczatree.Type
+}
+
 // Size returns the value of "size" config parameter.
 //
 // Returns SizeDefault if the value is not a positive number.
@@ -0,0 +1,43 @@
+package fstreeconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+)
+
+// Config is a wrapper over the config section
+// which provides access to FSTree configurations.
+type Config config.Config
+
+// config defaults
+const (
+	// DepthDefault is a default shallow dir depth.
+	DepthDefault = 4
+)
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Type returns the storage type.
+func (x *Config) Type() string {
+	return fstree.Type
+}
+
+// Depth returns the value of "depth" config parameter.
+//
+// Returns DepthDefault if the value is out of
+// [1:fstree.MaxDepth] range.
+func (x *Config) Depth() int {
+	d := config.IntSafe(
+		(*config.Config)(x),
+		"depth",
+	)
+
+	if d >= 1 && d <= fstree.MaxDepth {
+		return int(d)
+	}
+
+	return DepthDefault
+}
@@ -0,0 +1,70 @@
+package storageconfig
+
+import (
+	"io/fs"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to the common parameters of BlobStor sub-storages.
+type Config config.Config
+
+// config defaults
+const (
+	// PermDefault are default permission bits for BlobStor data.
+	PermDefault = 0660
+)
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Type returns the value of "type" config parameter.
+//
+// Panics if the value is not a non-empty string.
+func (x *Config) Type() string {
+	t := config.String(
+		(*config.Config)(x),
+		"type",
+	)
+
+	if t == "" {
+		panic("blobstor sub-storage type not set")
+	}
+
+	return t
+}
+
+// Path returns the value of "path" config parameter.
+//
+// Panics if the value is not a non-empty string.
+func (x *Config) Path() string {
+	p := config.String(
+		(*config.Config)(x),
+		"path",
+	)
+
+	if p == "" {
+		panic("blobstor sub-storage path not set")
+	}
+
+	return p
+}
+
+// Perm returns the value of "perm" config parameter as a fs.FileMode.
+//
+// Returns PermDefault if the value is not a non-zero number.
+func (x *Config) Perm() fs.FileMode {
+	p := config.UintSafe(
+		(*config.Config)(x),
+		"perm",
+	)
+
+	if p == 0 {
+		p = PermDefault
+	}
+
+	return fs.FileMode(p)
+}
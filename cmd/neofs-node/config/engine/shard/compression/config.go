@@ -0,0 +1,50 @@
+package compressionconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to the compression rule configuration.
+type Config config.Config
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Codec returns the value of "codec" config parameter.
+//
+// Panics if the value is not a non-empty string.
+func (x *Config) Codec() string {
+	c := config.String(
+		(*config.Config)(x),
+		"codec",
+	)
+
+	if c == "" {
+		panic("compression rule codec not set")
+	}
+
+	return c
+}
+
+// Containers returns the value of "containers" config parameter.
+//
+// Returns nil if the value is missing or is invalid.
+func (x *Config) Containers() []string {
+	return config.StringSliceSafe(
+		(*config.Config)(x),
+		"containers",
+	)
+}
+
+// ContentTypes returns the value of "content_types" config parameter.
+//
+// Returns nil if the value is missing or is invalid.
+func (x *Config) ContentTypes() []string {
+	return config.StringSliceSafe(
+		(*config.Config)(x),
+		"content_types",
+	)
+}
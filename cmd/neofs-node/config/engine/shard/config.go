@@ -2,9 +2,12 @@ package shardconfig
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	blobstorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor"
+	compressionconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/compression"
+	dedupconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/dedup"
 	gcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/gc"
 	metabaseconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/pilorama"
@@ -16,11 +19,107 @@ import (
 // which provides access to Shard configurations.
 type Config config.Config
 
+// SmallSizeLimitDefault is a default limit of small objects payload in bytes.
+const SmallSizeLimitDefault = 1 << 20
+
 // From wraps config section into Config.
 func From(c *config.Config) *Config {
 	return (*Config)(c)
 }
 
+// Compress returns the value of "compress" config parameter.
+//
+// Returns false if the value is not a valid bool.
+func (x *Config) Compress() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"compress",
+	)
+}
+
+// UncompressableContentTypes returns the value of "compression_exclude_content_types" config parameter.
+//
+// Returns nil if a the value is missing or is invalid.
+func (x *Config) UncompressableContentTypes() []string {
+	return config.StringSliceSafe(
+		(*config.Config)(x),
+		"compression_exclude_content_types")
+}
+
+// CompressionCodec returns the value of "compression_codec" config parameter.
+//
+// Returns empty string if the value is not a valid string.
+func (x *Config) CompressionCodec() string {
+	return config.StringSafe(
+		(*config.Config)(x),
+		"compression_codec",
+	)
+}
+
+// CompressionLevel returns the value of "compression_level" config parameter.
+//
+// Returns 0 if the value is not a valid number.
+func (x *Config) CompressionLevel() int {
+	return int(config.IntSafe(
+		(*config.Config)(x),
+		"compression_level",
+	))
+}
+
+// CompressionRules returns the value of "compression_rules" config parameter.
+//
+// Subsection names are expected to be consecutive integer numbers, starting from 0.
+// The order of the rules defines the order in which they are matched.
+func (x *Config) CompressionRules() []*compressionconfig.Config {
+	var rs []*compressionconfig.Config
+	for i := 0; ; i++ {
+		sub := (*config.Config)(x).Sub("compression_rules").Sub(strconv.Itoa(i))
+		if config.StringSafe(sub, "codec") == "" {
+			return rs
+		}
+
+		rs = append(rs, compressionconfig.From(sub))
+	}
+}
+
+// EstimateCompressibility returns the value of "compression_estimate_compressibility"
+// config parameter.
+//
+// Returns false if the value is not a valid bool.
+func (x *Config) EstimateCompressibility() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"compression_estimate_compressibility",
+	)
+}
+
+// EstimateCompressibilityThreshold returns the value of
+// "compression_estimate_compressibility_threshold" config parameter.
+//
+// Returns 0 if the value is not a valid number.
+func (x *Config) EstimateCompressibilityThreshold() float64 {
+	return config.FloatSafe(
+		(*config.Config)(x),
+		"compression_estimate_compressibility_threshold",
+	)
+}
+
+// SmallSizeLimit returns the value of "small_object_size" config parameter.
+//
+// Returns SmallSizeLimitDefault if the value is not a positive number.
+func (x *Config) SmallSizeLimit() uint64 {
+	l := config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"small_object_size",
+	)
+
+	if l > 0 {
+		return l
+	}
+
+	return SmallSizeLimitDefault
+}
+
 // BlobStor returns "blobstor" subsection as a blobstorconfig.Config.
 func (x *Config) BlobStor() *blobstorconfig.Config {
 	return blobstorconfig.From(
@@ -53,6 +152,14 @@ func (x *Config) Pilorama() *piloramaconfig.Config {
 	)
 }
 
+// Dedup returns "dedup" subsection as a dedupconfig.Config.
+func (x *Config) Dedup() *dedupconfig.Config {
+	return dedupconfig.From(
+		(*config.Config)(x).
+			Sub("dedup"),
+	)
+}
+
 // GC returns "gc" subsection as a gcconfig.Config.
 func (x *Config) GC() *gcconfig.Config {
 	return gcconfig.From(
@@ -71,6 +178,22 @@ func (x *Config) RefillMetabase() bool {
 	)
 }
 
+// FillWatermark returns the value of "fill_watermark" config parameter.
+//
+// Returns 0 if the value is not a valid number.
+// Panics if the value is greater than 100.
+func (x *Config) FillWatermark() uint32 {
+	v := config.Uint32Safe(
+		(*config.Config)(x),
+		"fill_watermark",
+	)
+	if v > 100 {
+		panic(fmt.Sprintf("fill watermark must be a percentage, got %d", v))
+	}
+
+	return v
+}
+
 // Mode return the value of "mode" config parameter.
 //
 // Panics if read the value is not one of predefined
@@ -0,0 +1,45 @@
+package dedupconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to the payload deduplication configuration.
+type Config config.Config
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Path returns the value of "path" config parameter.
+//
+// Returns empty string if the value is missing, deduplication is
+// disabled in this case.
+func (x *Config) Path() string {
+	return config.StringSafe(
+		(*config.Config)(x),
+		"path",
+	)
+}
+
+// Enabled returns the value of "enabled" config parameter.
+//
+// Returns false if the value is not a valid bool.
+func (x *Config) Enabled() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"enabled",
+	)
+}
+
+// Containers returns the value of "containers" config parameter.
+//
+// Returns nil if the value is missing or is invalid.
+func (x *Config) Containers() []string {
+	return config.StringSliceSafe(
+		(*config.Config)(x),
+		"containers",
+	)
+}
@@ -78,6 +78,14 @@ func (tls TLSConfig) CertificateFile() string {
 	return v
 }
 
+// NodeCertificate returns true if neither "certificate" nor "key" config
+// parameter is set. The certificate bound to the node key (see "tls"
+// subsection of "node" section) is used in this case.
+func (tls TLSConfig) NodeCertificate() bool {
+	return config.StringSafe(tls.cfg, "certificate") == "" &&
+		config.StringSafe(tls.cfg, "key") == ""
+}
+
 // UseInsecureCrypto returns true if TLS 1.2 cipher suite should not be restricted.
 func (tls TLSConfig) UseInsecureCrypto() bool {
 	return config.BoolSafe(tls.cfg, "use_insecure_crypto")
@@ -34,6 +34,7 @@ func TestGRPCSection(t *testing.T) {
 				require.NotNil(t, tls)
 				require.Equal(t, "/path/to/cert", tls.CertificateFile())
 				require.Equal(t, "/path/to/key", tls.KeyFile())
+				require.False(t, tls.NodeCertificate())
 				require.False(t, tls.UseInsecureCrypto())
 			case 1:
 				require.Equal(t, "s02.neofs.devenv:8080", sc.Endpoint())
@@ -42,6 +43,7 @@ func TestGRPCSection(t *testing.T) {
 				require.Equal(t, "s03.neofs.devenv:8080", sc.Endpoint())
 				require.NotNil(t, tls)
 				require.True(t, tls.UseInsecureCrypto())
+				require.True(t, tls.NodeCertificate())
 			}
 		})
 	}
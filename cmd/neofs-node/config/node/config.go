@@ -30,11 +30,34 @@ type NotificationConfig struct {
 	cfg *config.Config
 }
 
+// NotificationWebhookConfig is a wrapper over "webhook" subsection of
+// "notification" config section which provides access to webhook
+// notification writer configuration of node.
+type NotificationWebhookConfig struct {
+	cfg *config.Config
+}
+
+// NotificationEventsConfig is a wrapper over "events" subsection of
+// "notification" config section which provides access to object event
+// feed configuration of node.
+type NotificationEventsConfig struct {
+	cfg *config.Config
+}
+
+// TLSConfig is a wrapper over "tls" config section which provides access
+// to the configuration of the TLS certificate bound to the node key.
+type TLSConfig struct {
+	cfg *config.Config
+}
+
 const (
-	subsection                   = "node"
-	persistentSessionsSubsection = "persistent_sessions"
-	persistentStateSubsection    = "persistent_state"
-	notificationSubsection       = "notification"
+	subsection                    = "node"
+	tlsSubsection                 = "tls"
+	persistentSessionsSubsection  = "persistent_sessions"
+	persistentStateSubsection     = "persistent_state"
+	notificationSubsection        = "notification"
+	notificationEventsSubsection  = "events"
+	notificationWebhookSubsection = "webhook"
 
 	attributePrefix = "attribute"
 
@@ -43,6 +66,17 @@ const (
 
 	// NotificationTimeoutDefault is a default timeout for object notification operation.
 	NotificationTimeoutDefault = 5 * time.Second
+
+	// NotificationTypeDefault is a default type of the object notification writer.
+	NotificationTypeDefault = "nats"
+
+	// NotificationWebhookRetriesDefault is a default number of the webhook
+	// request retries.
+	NotificationWebhookRetriesDefault = 3
+
+	// NotificationOutboxCapacityDefault is a default number of the object
+	// events which may wait for publication.
+	NotificationOutboxCapacityDefault = 100000
 )
 
 // Key returns the  value of "key" config parameter
@@ -222,6 +256,19 @@ func (n NotificationConfig) Enabled() bool {
 	return config.BoolSafe(n.cfg, "enabled")
 }
 
+// Type returns the value of "type" config parameter from "notification"
+// subsection of "node" section.
+//
+// Returns NotificationTypeDefault if the value is not presented.
+func (n NotificationConfig) Type() string {
+	v := config.StringSafe(n.cfg, "type")
+	if v != "" {
+		return v
+	}
+
+	return NotificationTypeDefault
+}
+
 // DefaultTopic returns the value of "default_topic" config parameter from
 // "notification" subsection of "node" section.
 //
@@ -274,3 +321,119 @@ func (n NotificationConfig) KeyPath() string {
 func (n NotificationConfig) CAPath() string {
 	return config.StringSafe(n.cfg, "ca")
 }
+
+// Webhook returns structure that provides access to "webhook" subsection
+// of "notification" subsection of "node" section.
+func (n NotificationConfig) Webhook() NotificationWebhookConfig {
+	return NotificationWebhookConfig{
+		n.cfg.Sub(notificationWebhookSubsection),
+	}
+}
+
+// Secret returns the value of "secret" config parameter from "webhook"
+// subsection of "notification" subsection of "node" section.
+//
+// Returns empty string if the value is not presented, requests are
+// not signed in this case.
+func (n NotificationWebhookConfig) Secret() string {
+	return config.StringSafe(n.cfg, "secret")
+}
+
+// Retries returns the value of "retries" config parameter from "webhook"
+// subsection of "notification" subsection of "node" section.
+//
+// Returns NotificationWebhookRetriesDefault if the value is not presented.
+func (n NotificationWebhookConfig) Retries() uint32 {
+	if n.cfg.Value("retries") == nil {
+		return NotificationWebhookRetriesDefault
+	}
+
+	return config.Uint32Safe(n.cfg, "retries")
+}
+
+// Events returns structure that provides access to "events" subsection
+// of "notification" subsection of "node" section.
+func (n NotificationConfig) Events() NotificationEventsConfig {
+	return NotificationEventsConfig{
+		n.cfg.Sub(notificationEventsSubsection),
+	}
+}
+
+// Containers returns the value of "containers" config parameter from
+// "events" subsection of "notification" subsection of "node" section.
+//
+// Returns nil if the value is not presented, object event feed is
+// disabled in this case.
+func (n NotificationEventsConfig) Containers() []string {
+	return config.StringSliceSafe(n.cfg, "containers")
+}
+
+// Topic returns the value of "topic" config parameter from "events"
+// subsection of "notification" subsection of "node" section.
+//
+// Returns empty string if the value is not presented.
+func (n NotificationEventsConfig) Topic() string {
+	return config.StringSafe(n.cfg, "topic")
+}
+
+// OutboxPath returns the value of "outbox_path" config parameter from
+// "events" subsection of "notification" subsection of "node" section.
+//
+// Returns empty string if the value is not presented.
+func (n NotificationEventsConfig) OutboxPath() string {
+	return config.StringSafe(n.cfg, "outbox_path")
+}
+
+// OutboxCapacity returns the value of "outbox_capacity" config parameter
+// from "events" subsection of "notification" subsection of "node" section.
+//
+// Returns NotificationOutboxCapacityDefault if the value is not positive.
+func (n NotificationEventsConfig) OutboxCapacity() int {
+	v := config.IntSafe(n.cfg, "outbox_capacity")
+	if v > 0 {
+		return int(v)
+	}
+
+	return NotificationOutboxCapacityDefault
+}
+
+// TLS returns structure that provides access to "tls" subsection of
+// "node" section.
+func TLS(c *config.Config) TLSConfig {
+	return TLSConfig{
+		c.Sub(subsection).Sub(tlsSubsection),
+	}
+}
+
+// Enabled returns the value of "enabled" config parameter from "tls"
+// subsection of "node" section.
+//
+// Returns false if the value is not presented.
+func (t TLSConfig) Enabled() bool {
+	return config.BoolSafe(t.cfg, "enabled")
+}
+
+// CertificateFile returns the value of "certificate" config parameter from
+// "tls" subsection of "node" section.
+//
+// Returns empty string if the value is not presented, certificate is
+// generated from the node key in this case.
+func (t TLSConfig) CertificateFile() string {
+	return config.StringSafe(t.cfg, "certificate")
+}
+
+// KeyFile returns the value of "key" config parameter from "tls"
+// subsection of "node" section.
+//
+// Returns empty string if the value is not presented.
+func (t TLSConfig) KeyFile() string {
+	return config.StringSafe(t.cfg, "key")
+}
+
+// CAFallback returns the value of "ca_fallback" config parameter from "tls"
+// subsection of "node" section.
+//
+// Returns false if the value is not presented.
+func (t TLSConfig) CAFallback() bool {
+	return config.BoolSafe(t.cfg, "ca_fallback")
+}
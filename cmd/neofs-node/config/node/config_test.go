@@ -40,6 +40,10 @@ func TestNodeSection(t *testing.T) {
 		notificationDefaultCertPath := Notification(empty).CertPath()
 		notificationDefaultKeyPath := Notification(empty).KeyPath()
 		notificationDefaultCAPath := Notification(empty).CAPath()
+		eventsDefault := Notification(empty).Events()
+		notificationDefaultType := Notification(empty).Type()
+		webhookDefault := Notification(empty).Webhook()
+		tlsDefault := TLS(empty)
 
 		require.Empty(t, attribute)
 		require.Equal(t, false, relay)
@@ -52,6 +56,17 @@ func TestNodeSection(t *testing.T) {
 		require.Equal(t, "", notificationDefaultCertPath)
 		require.Equal(t, "", notificationDefaultKeyPath)
 		require.Equal(t, "", notificationDefaultCAPath)
+		require.Equal(t, NotificationTypeDefault, notificationDefaultType)
+		require.Equal(t, "", webhookDefault.Secret())
+		require.EqualValues(t, NotificationWebhookRetriesDefault, webhookDefault.Retries())
+		require.Empty(t, eventsDefault.Containers())
+		require.Equal(t, "", eventsDefault.Topic())
+		require.Equal(t, "", eventsDefault.OutboxPath())
+		require.Equal(t, NotificationOutboxCapacityDefault, eventsDefault.OutboxCapacity())
+		require.False(t, tlsDefault.Enabled())
+		require.Equal(t, "", tlsDefault.CertificateFile())
+		require.Equal(t, "", tlsDefault.KeyFile())
+		require.False(t, tlsDefault.CAFallback())
 
 		var subnetCfg SubnetConfig
 
@@ -85,6 +100,10 @@ func TestNodeSection(t *testing.T) {
 		notificationCertPath := Notification(c).CertPath()
 		notificationKeyPath := Notification(c).KeyPath()
 		notificationCAPath := Notification(c).CAPath()
+		events := Notification(c).Events()
+		notificationType := Notification(c).Type()
+		webhook := Notification(c).Webhook()
+		tlsCfg := TLS(c)
 
 		expectedAddr := []struct {
 			str  string
@@ -143,6 +162,17 @@ func TestNodeSection(t *testing.T) {
 		require.Equal(t, "/cert/path", notificationCertPath)
 		require.Equal(t, "/key/path", notificationKeyPath)
 		require.Equal(t, "/ca/path", notificationCAPath)
+		require.Equal(t, "nats", notificationType)
+		require.Equal(t, "hmac-secret", webhook.Secret())
+		require.EqualValues(t, 5, webhook.Retries())
+		require.Equal(t, []string{"4wBqpZM9xaSheZzJSMawUKKwhdpChKbZ5eu5ky4Vigw"}, events.Containers())
+		require.Equal(t, "events", events.Topic())
+		require.Equal(t, "/outbox", events.OutboxPath())
+		require.Equal(t, 5000, events.OutboxCapacity())
+		require.True(t, tlsCfg.Enabled())
+		require.Equal(t, "/path/to/node/cert", tlsCfg.CertificateFile())
+		require.Equal(t, "/path/to/node/key", tlsCfg.KeyFile())
+		require.True(t, tlsCfg.CAFallback())
 
 		var subnetCfg SubnetConfig
 
@@ -18,8 +18,32 @@ const (
 	// PutPoolSizeDefault is a default value of routine pool size to
 	// process object.Put requests in object service.
 	PutPoolSizeDefault = 10
+
+	getSubsection = "get"
+
+	// GetAssemblyPrefetchDefault is a default number of the children
+	// requested concurrently during the big object assembly.
+	GetAssemblyPrefetchDefault = 8
+
+	// GetAssemblyMemoryLimitDefault is a default limit of the payload size
+	// fetched ahead during the big object assembly.
+	GetAssemblyMemoryLimitDefault = 256 << 20
+
+	limitsSubsection = "limits"
 )
 
+// GetConfig is a wrapper over "get" config section which provides access
+// to object get pipeline configuration of object service.
+type GetConfig struct {
+	cfg *config.Config
+}
+
+// LimitsConfig is a wrapper over "limits" config section which provides
+// access to request limits of object service.
+type LimitsConfig struct {
+	cfg *config.Config
+}
+
 // Put returns structure that provides access to "put" subsection of
 // "object" section.
 func Put(c *config.Config) PutConfig {
@@ -39,3 +63,82 @@ func (g PutConfig) PoolSizeRemote() int {
 
 	return PutPoolSizeDefault
 }
+
+// Get returns structure that provides access to "get" subsection of
+// "object" section.
+func Get(c *config.Config) GetConfig {
+	return GetConfig{
+		c.Sub(subsection).Sub(getSubsection),
+	}
+}
+
+// AssemblyPrefetch returns the value of "assembly_prefetch" config parameter.
+//
+// Returns GetAssemblyPrefetchDefault if the value is not a positive number.
+func (g GetConfig) AssemblyPrefetch() int {
+	v := config.Int(g.cfg, "assembly_prefetch")
+	if v > 0 {
+		return int(v)
+	}
+
+	return GetAssemblyPrefetchDefault
+}
+
+// AssemblyMemoryLimit returns the value of "assembly_memory_limit" config parameter.
+//
+// Returns GetAssemblyMemoryLimitDefault if the value is not a positive number.
+func (g GetConfig) AssemblyMemoryLimit() uint64 {
+	v := config.SizeInBytesSafe(g.cfg, "assembly_memory_limit")
+	if v > 0 {
+		return v
+	}
+
+	return GetAssemblyMemoryLimitDefault
+}
+
+// Limits returns structure that provides access to "limits" subsection of
+// "object" section.
+func Limits(c *config.Config) LimitsConfig {
+	return LimitsConfig{
+		c.Sub(subsection).Sub(limitsSubsection),
+	}
+}
+
+// MaxStreams returns the value of "max_streams" config parameter.
+//
+// Returns 0 (no limit) if the value is missing or invalid.
+func (l LimitsConfig) MaxStreams() uint32 {
+	return config.Uint32Safe(l.cfg, "max_streams")
+}
+
+// MaxPayloadBandwidth returns the value of "max_payload_bandwidth" config
+// parameter in bytes per second.
+//
+// Returns 0 (no limit) if the value is missing or invalid.
+func (l LimitsConfig) MaxPayloadBandwidth() uint64 {
+	return config.SizeInBytesSafe(l.cfg, "max_payload_bandwidth")
+}
+
+// MaxOwnerOpsPerSecond returns the value of "max_owner_ops_per_second"
+// config parameter.
+//
+// Returns 0 (no limit) if the value is missing or invalid.
+func (l LimitsConfig) MaxOwnerOpsPerSecond() uint32 {
+	return config.Uint32Safe(l.cfg, "max_owner_ops_per_second")
+}
+
+// MaxContainerOpsPerSecond returns the value of "max_container_ops_per_second"
+// config parameter.
+//
+// Returns 0 (no limit) if the value is missing or invalid.
+func (l LimitsConfig) MaxContainerOpsPerSecond() uint32 {
+	return config.Uint32Safe(l.cfg, "max_container_ops_per_second")
+}
+
+// MaxMethodOpsPerSecond returns the value of the config parameter named after
+// the method from "max_method_ops_per_second" subsection.
+//
+// Returns 0 (no limit) if the value is missing or invalid.
+func (l LimitsConfig) MaxMethodOpsPerSecond(method string) uint32 {
+	return config.Uint32Safe(l.cfg.Sub("max_method_ops_per_second"), method)
+}
@@ -14,12 +14,32 @@ func TestObjectSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeRemote())
+		require.Equal(t, objectconfig.GetAssemblyPrefetchDefault, objectconfig.Get(empty).AssemblyPrefetch())
+		require.EqualValues(t, objectconfig.GetAssemblyMemoryLimitDefault, objectconfig.Get(empty).AssemblyMemoryLimit())
+
+		limits := objectconfig.Limits(empty)
+		require.Zero(t, limits.MaxStreams())
+		require.Zero(t, limits.MaxPayloadBandwidth())
+		require.Zero(t, limits.MaxOwnerOpsPerSecond())
+		require.Zero(t, limits.MaxContainerOpsPerSecond())
+		require.Zero(t, limits.MaxMethodOpsPerSecond("put"))
 	})
 
 	const path = "../../../../config/example/node"
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 100, objectconfig.Put(c).PoolSizeRemote())
+		require.Equal(t, 16, objectconfig.Get(c).AssemblyPrefetch())
+		require.EqualValues(t, 512<<20, objectconfig.Get(c).AssemblyMemoryLimit())
+
+		limits := objectconfig.Limits(c)
+		require.EqualValues(t, 1000, limits.MaxStreams())
+		require.EqualValues(t, 100<<20, limits.MaxPayloadBandwidth())
+		require.EqualValues(t, 100, limits.MaxOwnerOpsPerSecond())
+		require.EqualValues(t, 500, limits.MaxContainerOpsPerSecond())
+		require.EqualValues(t, 200, limits.MaxMethodOpsPerSecond("put"))
+		require.EqualValues(t, 50, limits.MaxMethodOpsPerSecond("search"))
+		require.Zero(t, limits.MaxMethodOpsPerSecond("get"))
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -25,3 +25,11 @@ func HeadTimeout(c *config.Config) time.Duration {
 
 	return HeadTimeoutDefault
 }
+
+// MaxOpsPerSecond returns the value of "max_ops_per_second" config parameter
+// from "policer" section.
+//
+// Returns 0 (no limit) if the value is missing or invalid.
+func MaxOpsPerSecond(c *config.Config) uint32 {
+	return config.Uint32Safe(c.Sub(subsection), "max_ops_per_second")
+}
@@ -15,12 +15,14 @@ func TestPolicerSection(t *testing.T) {
 		empty := configtest.EmptyConfig()
 
 		require.Equal(t, policerconfig.HeadTimeoutDefault, policerconfig.HeadTimeout(empty))
+		require.Zero(t, policerconfig.MaxOpsPerSecond(empty))
 	})
 
 	const path = "../../../../config/example/node"
 
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 15*time.Second, policerconfig.HeadTimeout(c))
+		require.EqualValues(t, 500, policerconfig.MaxOpsPerSecond(c))
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
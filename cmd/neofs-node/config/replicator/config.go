@@ -25,3 +25,11 @@ func PutTimeout(c *config.Config) time.Duration {
 
 	return PutTimeoutDefault
 }
+
+// MaxBandwidth returns the value of "max_bandwidth" config parameter
+// from "replicator" section in bytes per second.
+//
+// Returns 0 (no limit) if the value is missing or invalid.
+func MaxBandwidth(c *config.Config) uint64 {
+	return config.SizeInBytesSafe(c.Sub(subsection), "max_bandwidth")
+}
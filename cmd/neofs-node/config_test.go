@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAddShardInvalidConfig(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	c := new(cfg)
+	srv := controlSvc.New(
+		controlSvc.WithKey(key),
+		controlSvc.WithAuthorizedKeys([][]byte{elliptic.MarshalCompressed(key.Curve, key.X, key.Y)}),
+		controlSvc.WithShardConfigParser(c.parseShardConfig),
+	)
+
+	testCases := map[string]string{
+		"invalid YAML":             "metabase: [",
+		"missing metabase path":    "blobstor: [{type: fstree, path: /tmp/fstree}]",
+		"missing sub-storage path": "metabase: {path: /tmp/meta}\nblobstor: [{type: fstree}]",
+		"missing write-cache path": "metabase: {path: /tmp/meta}\nwritecache: {enabled: true}",
+		"unknown mode":             "metabase: {path: /tmp/meta}\nmode: broken",
+		"fill watermark":           "metabase: {path: /tmp/meta}\nfill_watermark: 101",
+		"invalid value type":       "metabase: {path: /tmp/meta}\nwritecache: {enabled: [1, 2]}",
+	}
+
+	for name, data := range testCases {
+		t.Run(name, func(t *testing.T) {
+			body := new(control.AddShardRequest_Body)
+			body.SetConfig([]byte(data))
+
+			req := new(control.AddShardRequest)
+			req.SetBody(body)
+
+			signControlRequest(t, key, req)
+
+			_, err := srv.AddShard(context.Background(), req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err), err)
+		})
+	}
+}
+
+// signControlRequest signs Control service request in the same format as
+// controlSvc.SignMessage does.
+func signControlRequest(t *testing.T, key *ecdsa.PrivateKey, req controlSvc.SignedMessage) {
+	data, err := req.ReadSignedData(nil)
+	require.NoError(t, err)
+
+	h := sha512.Sum512(data)
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, h[:])
+	require.NoError(t, err)
+
+	sign := make([]byte, 65)
+	sign[0] = 0x04
+	r.FillBytes(sign[1:33])
+	s.FillBytes(sign[33:])
+
+	sig := new(control.Signature)
+	sig.SetKey(elliptic.MarshalCompressed(key.Curve, key.X, key.Y))
+	sig.SetSign(sign)
+
+	req.SetSignature(sig)
+}
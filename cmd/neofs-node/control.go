@@ -2,14 +2,18 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net"
 
 	controlconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/control"
+	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func initControlService(c *cfg) {
@@ -18,14 +22,7 @@ func initControlService(c *cfg) {
 		return
 	}
 
-	pubs := controlconfig.AuthorizedKeys(c.appCfg)
-	rawPubs := make([][]byte, 0, len(pubs)+1) // +1 for node key
-
-	rawPubs = append(rawPubs, c.key.PublicKey().Bytes())
-
-	for i := range pubs {
-		rawPubs = append(rawPubs, pubs[i].Bytes())
-	}
+	rawPubs := controlAuthorizedKeys(c)
 
 	ctlSvc := controlSvc.New(
 		controlSvc.WithKey(&c.key.PrivateKey),
@@ -44,12 +41,27 @@ func initControlService(c *cfg) {
 		}),
 		controlSvc.WithLocalStorage(c.cfgObject.cfgLocalStorage.localStorage),
 		controlSvc.WithTreeService(c.treeService),
+		controlSvc.WithContainerSource(c.cfgObject.cnrSource),
+		controlSvc.WithReplicator(c.replicator),
+		controlSvc.WithPolicer(c.policer),
+		controlSvc.WithShardConfigParser(c.parseShardConfig),
 	)
 
+	c.cfgControlService.svc = ctlSvc
+
 	lis, err := net.Listen("tcp", endpoint)
 	fatalOnErr(err)
 
-	c.cfgControlService.server = grpc.NewServer()
+	var serverOpts []grpc.ServerOption
+
+	if controlconfig.GRPC(c.appCfg).TLS().Enabled() {
+		c.cfgControlService.tls = network.NewTLSIdentity(c.key)
+		fatalOnErrDetails("could not load control service TLS certificates", c.loadControlTLS(rawPubs))
+
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(c.cfgControlService.tls.MutualServerConfig())))
+	}
+
+	c.cfgControlService.server = grpc.NewServer(serverOpts...)
 
 	c.onShutdown(func() {
 		stopGRPC("NeoFS Control API", c.cfgControlService.server, c.log)
@@ -64,6 +76,51 @@ func initControlService(c *cfg) {
 	}))
 }
 
+// controlAuthorizedKeys returns the public keys which have rights to use
+// the control service: the node key and the configured ones.
+func controlAuthorizedKeys(c *cfg) [][]byte {
+	pubs := controlconfig.AuthorizedKeys(c.appCfg)
+	rawPubs := make([][]byte, 0, len(pubs)+1) // +1 for node key
+
+	rawPubs = append(rawPubs, c.key.PublicKey().Bytes())
+
+	for i := range pubs {
+		rawPubs = append(rawPubs, pubs[i].Bytes())
+	}
+
+	return rawPubs
+}
+
+// loadControlTLS loads the certificates of the control service from the
+// current config. Clients must present the certificates bound to rawPubs.
+// The node certificate is presented to the clients which check the node
+// key, the configured certificate is presented to the other ones.
+func (c *cfg) loadControlTLS(rawPubs [][]byte) error {
+	id := c.cfgControlService.tls
+
+	var certFile, keyFile string
+
+	if nodeTLS := nodeconfig.TLS(c.appCfg); nodeTLS.Enabled() {
+		certFile, keyFile = nodeTLS.CertificateFile(), nodeTLS.KeyFile()
+	}
+
+	err := id.Load(certFile, keyFile)
+	if err != nil {
+		return fmt.Errorf("could not load node certificate: %w", err)
+	}
+
+	tlsCfg := controlconfig.GRPC(c.appCfg).TLS()
+
+	err = id.LoadServerCertificate(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
+	if err != nil {
+		return fmt.Errorf("could not load control service certificate: %w", err)
+	}
+
+	id.SetPeerKeys(rawPubs)
+
+	return nil
+}
+
 func (c *cfg) NetmapStatus() control.NetmapStatus {
 	return c.cfgNetmap.state.controlNetmapStatus()
 }
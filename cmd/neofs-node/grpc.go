@@ -27,8 +27,19 @@ func initGRPC(c *cfg) {
 		tlsCfg := sc.TLS()
 
 		if tlsCfg != nil {
-			cert, err := tls.LoadX509KeyPair(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
-			fatalOnErrDetails("could not read certificate from file", err)
+			var cert *tls.Certificate
+
+			if tlsCfg.NodeCertificate() {
+				if c.tlsIdentity == nil {
+					fatalOnErr(fmt.Errorf("TLS certificate of %s endpoint is not set and node TLS is disabled, see `node.tls` section",
+						sc.Endpoint()))
+				}
+			} else {
+				pair, err := tls.LoadX509KeyPair(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
+				fatalOnErrDetails("could not read certificate from file", err)
+
+				cert = &pair
+			}
 
 			var cipherSuites []uint16
 			if !tlsCfg.UseInsecureCrypto() {
@@ -46,9 +57,9 @@ func initGRPC(c *cfg) {
 				}
 			}
 			creds := credentials.NewTLS(&tls.Config{
-				MinVersion:   tls.VersionTLS12,
-				CipherSuites: cipherSuites,
-				Certificates: []tls.Certificate{cert},
+				MinVersion:     tls.VersionTLS12,
+				CipherSuites:   cipherSuites,
+				GetCertificate: serverCertificate(c.tlsIdentity, cert),
 			})
 
 			serverOpts = append(serverOpts, grpc.Creds(creds))
@@ -68,7 +68,10 @@ func initAndLog(c *cfg, name string, initializer func(*cfg)) {
 }
 
 func initApp(c *cfg) {
-	c.ctx, c.ctxCancel = signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	c.ctx, c.ctxCancel = signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+
+	// SIGHUP received during the startup is handled after the application is started
+	signal.Notify(c.sighup, syscall.SIGHUP)
 
 	initAndLog(c, "storage engine", func(c *cfg) {
 		fatalOnErr(c.cfgObject.cfgLocalStorage.localStorage.Open())
@@ -101,7 +104,7 @@ func runAndLog(c *cfg, name string, logSuccess bool, starter func(*cfg)) {
 }
 
 func bootUp(c *cfg) {
-	runAndLog(c, "NATS", true, connectNats)
+	runAndLog(c, "notification writer", true, connectNotifications)
 	runAndLog(c, "gRPC", false, serveGRPC)
 	runAndLog(c, "notary", true, makeAndWaitNotaryDeposit)
 
@@ -113,18 +116,27 @@ func wait(c *cfg) {
 	c.log.Info("application started",
 		zap.String("version", misc.Version))
 
-	select {
-	case <-c.ctx.Done(): // graceful shutdown
-	case err := <-c.internalErr: // internal application error
-		close(c.internalErr)
-		c.ctxCancel()
-
-		c.log.Warn("internal application error",
-			zap.String("message", err.Error()))
+	for {
+		select {
+		case <-c.sighup: // configuration reload
+			c.reloadConfig()
+		case <-c.ctx.Done(): // graceful shutdown
+			return
+		case err := <-c.internalErr: // internal application error
+			close(c.internalErr)
+			c.ctxCancel()
+
+			c.log.Warn("internal application error",
+				zap.String("message", err.Error()))
+
+			return
+		}
 	}
 }
 
 func shutdown(c *cfg) {
+	signal.Stop(c.sighup)
+
 	for _, closer := range c.closers {
 		closer()
 	}
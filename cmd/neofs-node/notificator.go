@@ -1,7 +1,12 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"os"
 
 	"github.com/mr-tron/base58"
 	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
@@ -10,6 +15,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/services/notificator"
 	"github.com/nspcc-dev/neofs-node/pkg/services/notificator/nats"
+	"github.com/nspcc-dev/neofs-node/pkg/services/notificator/socket"
+	"github.com/nspcc-dev/neofs-node/pkg/services/notificator/webhook"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
@@ -91,9 +99,18 @@ func (n *notificationSource) processAddress(
 	return nil
 }
 
+// notificationBackend is a writer of the object notifications
+// to the external system.
+type notificationBackend interface {
+	notificator.EventWriter
+
+	Notify(topic string, address oid.Address) error
+	Connect(ctx context.Context, endpoint string) error
+}
+
 type notificationWriter struct {
 	l *zap.Logger
-	w *nats.Writer
+	w notificationBackend
 }
 
 func (n notificationWriter) Notify(topic string, address oid.Address) {
@@ -106,6 +123,90 @@ func (n notificationWriter) Notify(topic string, address oid.Address) {
 	}
 }
 
+// objectEventFeed passes the object events of the configured
+// containers to the notificator.EventFeed.
+type objectEventFeed struct {
+	cnrs map[cid.ID]struct{}
+	feed *notificator.EventFeed
+}
+
+func (f *objectEventFeed) handleEvent(ev engine.ObjectEvent) {
+	if _, ok := f.cnrs[ev.Address.Container()]; !ok {
+		return
+	}
+
+	nEv := notificator.NewEvent(ev.Type.String(), ev.Address)
+
+	switch ev.Type {
+	case engine.EventPut:
+		nEv.Header = notificator.NewHeaderSummary(ev.Header)
+	case engine.EventInhume:
+		nEv.Tombstone = ev.Tombstone.Object().EncodeToString()
+	case engine.EventLock:
+		nEv.Locker = ev.Locker.Object().EncodeToString()
+	}
+
+	f.feed.Push(nEv)
+}
+
+// handleObjectEvent is a handler of the storage engine object events.
+func (c *cfg) handleObjectEvent(ev engine.ObjectEvent) {
+	if f := c.cfgNotifications.events; f != nil {
+		f.handleEvent(ev)
+	}
+}
+
+func initObjectEventFeed(c *cfg, w notificator.EventWriter, defaultTopic string) {
+	eventsCfg := nodeconfig.Notification(c.appCfg).Events()
+
+	strCnrs := eventsCfg.Containers()
+	if len(strCnrs) == 0 {
+		return
+	}
+
+	cnrs := make(map[cid.ID]struct{}, len(strCnrs))
+
+	for i := range strCnrs {
+		var cnr cid.ID
+
+		err := cnr.DecodeString(strCnrs[i])
+		fatalOnErrDetails("invalid container ID in object event feed config", err)
+
+		cnrs[cnr] = struct{}{}
+	}
+
+	path := eventsCfg.OutboxPath()
+	if path == "" {
+		fatalOnErr(errors.New("object event outbox path is not set"))
+	}
+
+	outbox, err := notificator.OpenOutbox(path, eventsCfg.OutboxCapacity())
+	fatalOnErr(err)
+
+	topic := eventsCfg.Topic()
+	if topic == "" {
+		topic = defaultTopic + "_events"
+	}
+
+	feed := notificator.NewEventFeed(new(notificator.FeedPrm).
+		SetLogger(c.log).
+		SetWriter(w).
+		SetOutbox(outbox).
+		SetTopic(topic),
+	)
+
+	c.cfgNotifications.events = &objectEventFeed{
+		cnrs: cnrs,
+		feed: feed,
+	}
+
+	c.workers = append(c.workers, newWorkerFromFunc(func(ctx context.Context) {
+		feed.Run(ctx)
+
+		_ = outbox.Close()
+	}))
+}
+
 func initNotifications(c *cfg) {
 	if nodeconfig.Notification(c.appCfg).Enabled() {
 		topic := nodeconfig.Notification(c.appCfg).DefaultTopic()
@@ -115,26 +216,20 @@ func initNotifications(c *cfg) {
 			topic = pubKey
 		}
 
-		natsSvc := nats.New(
-			nats.WithConnectionName("NeoFS Storage Node: "+pubKey), // connection name is used in the server side logs
-			nats.WithTimeout(nodeconfig.Notification(c.appCfg).Timeout()),
-			nats.WithClientCert(
-				nodeconfig.Notification(c.appCfg).CertPath(),
-				nodeconfig.Notification(c.appCfg).KeyPath(),
-			),
-			nats.WithRootCA(nodeconfig.Notification(c.appCfg).CAPath()),
-			nats.WithLogger(c.log),
-		)
+		w, err := newNotificationBackend(c, pubKey)
+		fatalOnErr(err)
 
 		c.cfgNotifications = cfgNotifications{
 			enabled: true,
 			nw: notificationWriter{
 				l: c.log,
-				w: natsSvc,
+				w: w,
 			},
 			defaultTopic: topic,
 		}
 
+		initObjectEventFeed(c, w, topic)
+
 		n := notificator.New(new(notificator.Prm).
 			SetLogger(c.log).
 			SetNotificationSource(
@@ -154,14 +249,92 @@ func initNotifications(c *cfg) {
 	}
 }
 
-func connectNats(c *cfg) {
+// newNotificationBackend creates the notification writer of the configured type.
+func newNotificationBackend(c *cfg, pubKey string) (notificationBackend, error) {
+	notificationCfg := nodeconfig.Notification(c.appCfg)
+
+	switch typ := notificationCfg.Type(); typ {
+	case "nats":
+		return nats.New(
+			nats.WithConnectionName("NeoFS Storage Node: "+pubKey), // connection name is used in the server side logs
+			nats.WithTimeout(notificationCfg.Timeout()),
+			nats.WithClientCert(
+				notificationCfg.CertPath(),
+				notificationCfg.KeyPath(),
+			),
+			nats.WithRootCA(notificationCfg.CAPath()),
+			nats.WithLogger(c.log),
+		), nil
+	case "webhook":
+		webhookCfg := notificationCfg.Webhook()
+
+		opts := []webhook.Option{
+			webhook.WithTimeout(notificationCfg.Timeout()),
+			webhook.WithSecret([]byte(webhookCfg.Secret())),
+			webhook.WithRetries(webhookCfg.Retries()),
+			webhook.WithLogger(c.log),
+		}
+
+		tlsCfg, err := notificationTLSConfig(notificationCfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook TLS configuration: %w", err)
+		}
+
+		if tlsCfg != nil {
+			opts = append(opts, webhook.WithTLSConfig(tlsCfg))
+		}
+
+		return webhook.New(opts...), nil
+	case "socket":
+		return socket.New(
+			socket.WithTimeout(notificationCfg.Timeout()),
+			socket.WithLogger(c.log),
+		), nil
+	default:
+		return nil, fmt.Errorf("unknown notification writer type %q", typ)
+	}
+}
+
+// notificationTLSConfig returns TLS configuration with the client certificate
+// and the root CA from the notification config. Returns nil if none is set.
+func notificationTLSConfig(notificationCfg nodeconfig.NotificationConfig) (*tls.Config, error) {
+	certPath, keyPath, caPath := notificationCfg.CertPath(), notificationCfg.KeyPath(), notificationCfg.CAPath()
+	if certPath == "" && keyPath == "" && caPath == "" {
+		return nil, nil
+	}
+
+	tlsCfg := new(tls.Config)
+
+	if certPath != "" || keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not read client certificate: %w", err)
+		}
+
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if caPath != "" {
+		ca, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not read root CA: %w", err)
+		}
+
+		tlsCfg.RootCAs = x509.NewCertPool()
+		if !tlsCfg.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates in root CA file %s", caPath)
+		}
+	}
+
+	return tlsCfg, nil
+}
+
+func connectNotifications(c *cfg) {
 	if !c.cfgNotifications.enabled {
 		return
 	}
 
 	endpoint := nodeconfig.Notification(c.appCfg).Endpoint()
 	err := c.cfgNotifications.nw.w.Connect(c.ctx, endpoint)
-	if err != nil {
-		panic(fmt.Sprintf("could not connect to a nats endpoint %s: %v", endpoint, err))
-	}
+	fatalOnErrDetails(fmt.Sprintf("could not connect to a notification endpoint %s", endpoint), err)
 }
@@ -3,11 +3,13 @@ package main
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectGRPC "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
 	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -18,6 +20,8 @@ import (
 	morphClient "github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
 	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	netmapEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
 	objectTransportGRPC "github.com/nspcc-dev/neofs-node/pkg/network/transport/object/grpc"
 	objectService "github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl"
@@ -29,6 +33,7 @@ import (
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	putsvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/put/v2"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/ratelimit"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	searchsvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/search/v2"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
@@ -41,6 +46,7 @@ import (
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	apireputation "github.com/nspcc-dev/neofs-sdk-go/reputation"
@@ -207,16 +213,25 @@ func initObjectService(c *cfg) {
 		log:     c.log,
 	}
 
-	repl := replicator.New(
+	replicatorOpts := []replicator.Option{
 		replicator.WithLogger(c.log),
 		replicator.WithPutTimeout(
 			replicatorconfig.PutTimeout(c.appCfg),
 		),
 		replicator.WithLocalStorage(ls),
+		replicator.WithBandwidthLimit(
+			replicatorconfig.MaxBandwidth(c.appCfg),
+		),
 		replicator.WithRemoteSender(
 			putsvc.NewRemoteSender(keyStorage, coreConstructor),
 		),
-	)
+	}
+
+	if c.metricsCollector != nil {
+		replicatorOpts = append(replicatorOpts, replicator.WithMetrics(c.metricsCollector))
+	}
+
+	c.replicator = replicator.New(replicatorOpts...)
 
 	pol := policer.New(
 		policer.WithLogger(c.log),
@@ -228,11 +243,19 @@ func initObjectService(c *cfg) {
 		policer.WithRemoteHeader(
 			headsvc.NewRemoteHeader(keyStorage, clientConstructor),
 		),
+		policer.WithRemoteSearcher(
+			searchsvc.NewRemoteSearcher(keyStorage, coreConstructor),
+		),
+		policer.WithGetService(c.cfgObject.getSvc),
+		policer.WithKeyStorage(keyStorage),
 		policer.WithNetmapKeys(c),
 		policer.WithHeadTimeout(
 			policerconfig.HeadTimeout(c.appCfg),
 		),
-		policer.WithReplicator(repl),
+		policer.WithMaxOpsPerSecond(
+			policerconfig.MaxOpsPerSecond(c.appCfg),
+		),
+		policer.WithReplicator(c.replicator),
 		policer.WithRedundantCopyCallback(func(addr oid.Address) {
 			var inhumePrm engine.InhumePrm
 			inhumePrm.MarkAsGarbage(addr)
@@ -252,6 +275,23 @@ func initObjectService(c *cfg) {
 	traverseGen := util.NewTraverserGenerator(c.netMapSource, c.cfgObject.cnrSource, c)
 
 	c.workers = append(c.workers, pol)
+	c.policer = pol
+
+	addNewEpochAsyncNotificationHandler(c, func(ev event.Event) {
+		e := ev.(netmapEvent.NewEpoch).EpochNumber()
+
+		nm, err := c.netMapSource.GetNetMapByEpoch(e)
+		if err != nil {
+			c.log.Error("could not get network map to prioritize policer checks",
+				zap.Uint64("epoch", e),
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		pol.HandleNewNetmap(nm)
+	})
 
 	var os putsvc.ObjectStorage
 	if c.cfgNotifications.enabled {
@@ -317,7 +357,10 @@ func initObjectService(c *cfg) {
 			),
 		),
 		getsvc.WithNetMapSource(c.netMapSource),
+		getsvc.WithContainerSource(c.cfgObject.cnrSource),
 		getsvc.WithKeyStorage(keyStorage),
+		getsvc.WithAssemblyPrefetch(objectconfig.Get(c.appCfg).AssemblyPrefetch()),
+		getsvc.WithAssemblyMemoryLimit(objectconfig.Get(c.appCfg).AssemblyMemoryLimit()),
 	)
 
 	*c.cfgObject.getSvc = *sGet // need smth better
@@ -346,7 +389,7 @@ func initObjectService(c *cfg) {
 	)
 
 	// build service pipeline
-	// grpc | <metrics> | signature | response | acl | split
+	// grpc | <metrics> | signature | limiter | response | acl | split
 
 	splitSvc := objectService.NewTransportSplitter(
 		c.cfgGRPC.maxChunkSize,
@@ -359,9 +402,11 @@ func initObjectService(c *cfg) {
 		},
 	)
 
+	cachedIRFetcher := newCachedIRFetcher(irFetcher)
+
 	aclSvc := v2.New(
 		v2.WithLogger(c.log),
-		v2.WithIRFetcher(newCachedIRFetcher(irFetcher)),
+		v2.WithIRFetcher(cachedIRFetcher),
 		v2.WithNetmapSource(c.netMapSource),
 		v2.WithContainerSource(
 			c.cfgObject.cnrSource,
@@ -382,9 +427,22 @@ func initObjectService(c *cfg) {
 		c.respSvc,
 	)
 
+	limiterOpts := []ratelimit.Option{
+		ratelimit.WithSystemKeyChecker(&systemKeyChecker{
+			irFetcher:  cachedIRFetcher,
+			netmap:     c.netMapSource,
+			containers: c.cfgObject.cnrSource,
+		}),
+	}
+	if c.metricsCollector != nil {
+		limiterOpts = append(limiterOpts, ratelimit.WithMetrics(c.metricsCollector))
+	}
+
+	c.cfgObject.limiter = ratelimit.New(respSvc, readObjectLimits(c), limiterOpts...)
+
 	signSvc := objectService.NewSignService(
 		&c.key.PrivateKey,
-		respSvc,
+		c.cfgObject.limiter,
 	)
 
 	var firstSvc objectService.ServiceServer = signSvc
@@ -399,6 +457,77 @@ func initObjectService(c *cfg) {
 	}
 }
 
+// readObjectLimits reads the object service request limits from the config.
+func readObjectLimits(c *cfg) ratelimit.Limits {
+	limitsCfg := objectconfig.Limits(c.appCfg)
+
+	limits := ratelimit.Limits{
+		MaxStreams:               limitsCfg.MaxStreams(),
+		MaxPayloadBandwidth:      limitsCfg.MaxPayloadBandwidth(),
+		MaxOwnerOpsPerSecond:     limitsCfg.MaxOwnerOpsPerSecond(),
+		MaxContainerOpsPerSecond: limitsCfg.MaxContainerOpsPerSecond(),
+		MaxMethodOpsPerSecond:    make(map[string]uint32, len(ratelimit.Methods)),
+	}
+
+	for _, method := range ratelimit.Methods {
+		limits.MaxMethodOpsPerSecond[method] = limitsCfg.MaxMethodOpsPerSecond(method)
+	}
+
+	return limits
+}
+
+// systemKeyChecker checks if the object request is sent by the Inner Ring
+// node or by the node of the container in the current or previous epoch.
+type systemKeyChecker struct {
+	irFetcher  v2.InnerRingFetcher
+	netmap     netmap.Source
+	containers containercore.Source
+}
+
+func (x *systemKeyChecker) IsSystemKey(key []byte, idCnr cid.ID) bool {
+	irKeys, err := x.irFetcher.InnerRingKeys()
+	if err == nil {
+		for i := range irKeys {
+			if bytes.Equal(irKeys[i], key) {
+				return true
+			}
+		}
+	}
+
+	cnr, err := x.containers.Get(idCnr)
+	if err != nil {
+		return false
+	}
+
+	binCnr := make([]byte, sha256.Size)
+	idCnr.Encode(binCnr)
+
+	for _, getNetmap := range []func(netmap.Source) (*netmapSDK.NetMap, error){
+		netmap.GetLatestNetworkMap,
+		netmap.GetPreviousNetworkMap,
+	} {
+		nm, err := getNetmap(x.netmap)
+		if err != nil {
+			return false
+		}
+
+		nodes, err := nm.ContainerNodes(cnr.Value.PlacementPolicy(), binCnr)
+		if err != nil {
+			return false
+		}
+
+		for i := range nodes {
+			for j := range nodes[i] {
+				if bytes.Equal(nodes[i][j].PublicKey(), key) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
 type morphEACLFetcher struct {
 	w *cntClient.Client
 }
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	loggerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/logger"
+	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
+	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
+	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"go.uber.org/zap"
+)
+
+// restartSections contains config sections which
+// changes are applied only after the application restart.
+var restartSections = []string{
+	"node",
+	"grpc",
+	"tree",
+	"control",
+	"contracts",
+	"morph",
+	"apiclient",
+	"pprof",
+	"prometheus",
+	"object.get",
+}
+
+// reloadableSubsections contains subsections of restartSections
+// which changes are applied at runtime.
+var reloadableSubsections = map[string][]string{
+	"node":    {"tls"},
+	"control": {"authorized_keys", "grpc.tls"},
+}
+
+// reloadConfig re-reads the configuration file and applies the changes
+// which can be applied at runtime:
+//  * logging level;
+//  * size of the remote PUT and replication worker pools;
+//  * Policer HEAD timeout and rate limit;
+//  * Replicator PUT timeout and bandwidth limit;
+//  * object service request limits;
+//  * node TLS certificate and CA fallback;
+//  * control service TLS certificates and authorized keys;
+//  * size of the per-shard worker pools;
+//  * list of the shards and their modes.
+//
+// Changes of the other values are logged as skipped.
+func (c *cfg) reloadConfig() {
+	c.log.Info("SIGHUP has been received, rereading configuration...")
+
+	// config getters panic on invalid values,
+	// it must not stop the working application
+	defer func() {
+		if r := recover(); r != nil {
+			c.log.Error("invalid configuration, reload is interrupted",
+				zap.String("error", fmt.Sprint(r)))
+		}
+	}()
+
+	oldValues := make([]interface{}, len(restartSections))
+	for i := range restartSections {
+		oldValues[i] = c.restartSectionValue(restartSections[i])
+	}
+
+	oldErrThreshold := engineconfig.ShardErrorThreshold(c.appCfg)
+
+	err := c.appCfg.Reload()
+	if err != nil {
+		c.log.Error("configuration reading failure",
+			zap.String("error", err.Error()))
+		return
+	}
+
+	var skipped []string
+	for i := range restartSections {
+		if !reflect.DeepEqual(oldValues[i], c.restartSectionValue(restartSections[i])) {
+			skipped = append(skipped, restartSections[i])
+		}
+	}
+
+	if engineconfig.ShardErrorThreshold(c.appCfg) != oldErrThreshold {
+		skipped = append(skipped, "storage.shard_ro_error_threshold")
+	}
+
+	if len(skipped) != 0 {
+		c.log.Warn("configuration changes require restart, skipped",
+			zap.Strings("sections", skipped))
+	}
+
+	err = c.logPrm.SetLevelString(loggerconfig.Level(c.appCfg))
+	if err != nil {
+		c.log.Error("invalid logging level, skipped",
+			zap.String("error", err.Error()))
+	} else {
+		c.logPrm.Reload()
+	}
+
+	c.reloadReplication()
+	c.cfgObject.limiter.SetLimits(readObjectLimits(c))
+
+	err = c.reloadTLSIdentity()
+	if err != nil {
+		c.log.Error("node TLS certificate reload failure",
+			zap.String("error", err.Error()))
+	}
+
+	err = c.reloadStorageEngine()
+	if err != nil {
+		c.log.Error("storage engine configuration failure",
+			zap.String("error", err.Error()))
+	}
+
+	c.log.Info("configuration has been reloaded")
+}
+
+// reloadReplication applies the parameters of the object replication.
+func (c *cfg) reloadReplication() {
+	poolSize := objectconfig.Put(c.appCfg).PoolSizeRemote()
+
+	c.cfgObject.pool.putRemote.Tune(poolSize)
+
+	// replication pool is tuned by Policer according to the node load,
+	// so only its maximum capacity is changed
+	c.policer.SetMaxCapacity(poolSize)
+	c.policer.SetHeadTimeout(policerconfig.HeadTimeout(c.appCfg))
+	c.policer.SetMaxOpsPerSecond(policerconfig.MaxOpsPerSecond(c.appCfg))
+
+	c.replicator.SetPutTimeout(replicatorconfig.PutTimeout(c.appCfg))
+	c.replicator.SetMaxBandwidth(replicatorconfig.MaxBandwidth(c.appCfg))
+}
+
+// restartSectionValue returns the value of the config section without
+// its reloadable subsections.
+func (c *cfg) restartSectionValue(section string) interface{} {
+	v := c.appCfg.Value(section)
+
+	for _, sub := range reloadableSubsections[section] {
+		v = withoutSubsection(v, strings.Split(sub, "."))
+	}
+
+	return v
+}
+
+// withoutSubsection returns a copy of the section value without the
+// subsection at the path.
+func withoutSubsection(v interface{}, path []string) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	res := make(map[string]interface{}, len(m))
+	for k := range m {
+		res[k] = m[k]
+	}
+
+	if len(path) == 1 {
+		delete(res, path[0])
+	} else if sub, ok := res[path[0]]; ok {
+		res[path[0]] = withoutSubsection(sub, path[1:])
+	}
+
+	return res
+}
+
+// reloadStorageEngine attaches and detaches shards according to the
+// current application config and updates the parameters of the
+// remaining ones.
+func (c *cfg) reloadStorageEngine() error {
+	var (
+		rcfg engine.ReConfiguration
+		err  error
+	)
+
+	rcfg.SetShardPoolSize(engineconfig.ShardPoolSize(c.appCfg))
+
+	require := !nodeconfig.Relay(c.appCfg) // relay node does not require shards
+
+	engineconfig.IterateShards(c.appCfg, require, func(sc *shardconfig.Config) {
+		if err != nil {
+			return
+		}
+
+		var opts []shard.Option
+
+		opts, err = c.shardOpts(sc)
+		if err != nil {
+			return
+		}
+
+		rcfg.AddShard(sc.Metabase().Path(), sc.Mode(), append(opts,
+			shard.WithTombstoneSource(c.cfgObject.cfgLocalStorage.tombstoneSource)))
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.cfgObject.cfgLocalStorage.localStorage.Reload(rcfg)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	controlconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/control"
+	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"go.uber.org/zap"
+)
+
+// initTLSIdentity loads the TLS certificate bound to the node key.
+// Returns nil if node-to-node TLS is disabled.
+func initTLSIdentity(appCfg *config.Config, key *keys.PrivateKey, log *logger.Logger) *network.TLSIdentity {
+	tlsCfg := nodeconfig.TLS(appCfg)
+	if !tlsCfg.Enabled() {
+		return nil
+	}
+
+	id := network.NewTLSIdentity(key)
+
+	err := id.Load(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
+	fatalOnErrDetails("could not load node TLS certificate", err)
+
+	setCAFallback(id, tlsCfg, log)
+
+	return id
+}
+
+// setCAFallback enables the verification of the node certificates against
+// the certificate authorities if it is configured. Every such connection
+// is logged, the fallback is meant for the migration to node TLS only.
+func setCAFallback(id *network.TLSIdentity, tlsCfg nodeconfig.TLSConfig, log *logger.Logger) {
+	if !tlsCfg.CAFallback() {
+		id.SetCAFallback(nil)
+		return
+	}
+
+	log.Warn("node TLS: certificates of the nodes without node TLS are verified against certificate authorities, " +
+		"disable ca_fallback once node TLS is enabled on all the nodes")
+
+	id.SetCAFallback(func(serverKey []byte, host string) {
+		log.Warn("node TLS: server certificate is not bound to the node key, trusted by certificate authority",
+			zap.String("node", hex.EncodeToString(serverKey)),
+			zap.String("host", host),
+		)
+	})
+}
+
+// reloadTLSIdentity replaces the node certificate, the certificates and the
+// authorized keys of the control service with the ones from the current
+// config. The old ones are kept on failure.
+func (c *cfg) reloadTLSIdentity() error {
+	if c.tlsIdentity != nil {
+		tlsCfg := nodeconfig.TLS(c.appCfg)
+		if !tlsCfg.Enabled() {
+			c.log.Warn("node TLS can't be disabled at runtime, skipped")
+		} else {
+			err := c.tlsIdentity.Load(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
+			if err != nil {
+				return fmt.Errorf("could not load node TLS certificate: %w", err)
+			}
+
+			c.log.Info("node TLS certificate has been reloaded",
+				zap.Time("expires", c.tlsIdentity.Certificate().Leaf.NotAfter))
+
+			setCAFallback(c.tlsIdentity, tlsCfg, c.log)
+		}
+	}
+
+	if c.cfgControlService.svc == nil {
+		return nil
+	}
+
+	rawPubs := controlAuthorizedKeys(c)
+
+	switch enabled := controlconfig.GRPC(c.appCfg).TLS().Enabled(); {
+	case c.cfgControlService.tls == nil && enabled:
+		c.log.Warn("control service TLS can't be enabled at runtime, skipped")
+	case c.cfgControlService.tls != nil && !enabled:
+		c.log.Warn("control service TLS can't be disabled at runtime, skipped")
+	case c.cfgControlService.tls != nil:
+		err := c.loadControlTLS(rawPubs)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the same keys are checked in the client certificates
+	// and the request signatures
+	c.cfgControlService.svc.SetAuthorizedKeys(rawPubs)
+
+	c.log.Info("control service authorized keys have been reloaded")
+
+	return nil
+}
+
+// serverCertificate returns a function selecting the certificate presented
+// by the gRPC server. Other nodes indicate network.NodeServerName and
+// always get the node certificate, the other clients get cert if it is set.
+func serverCertificate(id *network.TLSIdentity, cert *tls.Certificate) func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		if cert == nil || (id != nil && hello.ServerName == network.NodeServerName) {
+			return id.Certificate(), nil
+		}
+
+		return cert, nil
+	}
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 
 	treeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tree"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	containerEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/container"
 	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
+	"go.uber.org/zap"
 )
 
+const containerDeleteSuccessNotifyEvent = "DeleteSuccess"
+
 func initTreeService(c *cfg) {
 	treeConfig := treeconfig.Tree(c.appCfg)
 	if !treeConfig.Enabled() {
@@ -19,6 +24,7 @@ func initTreeService(c *cfg) {
 		tree.WithNetmapSource(c.netMapSource),
 		tree.WithPrivateKey(&c.key.PrivateKey),
 		tree.WithLogger(c.log),
+		tree.WithTLSIdentity(c.tlsIdentity),
 		tree.WithStorage(c.cfgObject.cfgLocalStorage.localStorage),
 		tree.WithContainerCacheSize(treeConfig.CacheSize()),
 		tree.WithReplicationChannelCapacity(treeConfig.ReplicationChannelCapacity()),
@@ -32,5 +38,19 @@ func initTreeService(c *cfg) {
 		c.treeService.Start(ctx)
 	}))
 
+	setContainerNotificationParser(c, containerDeleteSuccessNotifyEvent, containerEvent.ParseDeleteSuccess)
+	addContainerAsyncNotificationHandler(c, containerDeleteSuccessNotifyEvent, func(e event.Event) {
+		ev := e.(containerEvent.DeleteSuccess)
+
+		c.log.Debug("removing all trees for container", zap.Stringer("cid", ev.ID))
+
+		err := c.treeService.DropTrees(ev.ID)
+		if err != nil {
+			c.log.Error("container removal event received, but trees weren't removed",
+				zap.Stringer("cid", ev.ID),
+				zap.String("error", err.Error()))
+		}
+	})
+
 	c.onShutdown(c.treeService.Shutdown)
 }
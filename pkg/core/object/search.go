@@ -0,0 +1,39 @@
+package object
+
+import (
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Numeric search match types. They are defined by the NeoFS API
+// (NUM_GT, NUM_GE, NUM_LT and NUM_LE) but are not supported by the SDK yet.
+// Filter values are compared as decimal integers.
+const (
+	MatchNumGT objectSDK.SearchMatchType = objectSDK.MatchCommonPrefix + 1 + iota
+	MatchNumGE
+	MatchNumLT
+	MatchNumLE
+)
+
+// IsNumericMatch checks whether m is a numeric search match type.
+func IsNumericMatch(m objectSDK.SearchMatchType) bool {
+	return m >= MatchNumGT && m <= MatchNumLE
+}
+
+// SearchFiltersFromV2 converts search filters from the NeoFS API
+// message. Unlike objectSDK.NewSearchFiltersFromV2, numeric match
+// types are kept.
+func SearchFiltersFromV2(v2 []v2object.SearchFilter) objectSDK.SearchFilters {
+	filters := make(objectSDK.SearchFilters, 0, len(v2))
+
+	for i := range v2 {
+		m := objectSDK.SearchMatchType(v2[i].GetMatchType())
+		if !IsNumericMatch(m) {
+			m = objectSDK.SearchMatchFromV2(v2[i].GetMatchType())
+		}
+
+		filters.AddFilter(v2[i].GetKey(), v2[i].GetValue(), m)
+	}
+
+	return filters
+}
@@ -0,0 +1,34 @@
+package object
+
+import (
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchFiltersFromV2(t *testing.T) {
+	newFilter := func(key, val string, m v2object.MatchType) v2object.SearchFilter {
+		var f v2object.SearchFilter
+		f.SetKey(key)
+		f.SetValue(val)
+		f.SetMatchType(m)
+		return f
+	}
+
+	fs := SearchFiltersFromV2([]v2object.SearchFilter{
+		newFilter("a", "1", v2object.MatchStringEqual),
+		newFilter("b", "2", 5),
+		newFilter("c", "3", 8),
+		newFilter("d", "4", 100),
+	})
+
+	require.Len(t, fs, 4)
+	require.Equal(t, objectSDK.MatchStringEqual, fs[0].Operation())
+	require.Equal(t, MatchNumGT, fs[1].Operation())
+	require.Equal(t, "b", fs[1].Header())
+	require.Equal(t, "2", fs[1].Value())
+	require.Equal(t, MatchNumLE, fs[2].Operation())
+	require.Equal(t, objectSDK.MatchUnknown, fs[3].Operation())
+}
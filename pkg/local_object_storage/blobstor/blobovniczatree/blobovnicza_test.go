@@ -1,4 +1,4 @@
-package blobstor
+package blobovniczatree
 
 import (
 	"math/rand"
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
@@ -38,32 +39,23 @@ func TestBlobovniczas(t *testing.T) {
 	l := test.NewLogger(false)
 	p := "./test_blz"
 
-	c := defaultCfg()
-
 	var width, depth uint64 = 2, 2
 
 	// sizeLim must be big enough, to hold at least multiple pages.
 	// 32 KiB is the initial size after all by-size buckets are created.
 	var szLim uint64 = 32*1024 + 1
 
-	for _, opt := range []Option{
+	b := NewBlobovniczaTree(
 		WithLogger(l),
-		WithSmallSizeLimit(szLim),
+		WithObjectSizeLimit(szLim),
 		WithBlobovniczaShallowWidth(width),
 		WithBlobovniczaShallowDepth(depth),
 		WithRootPath(p),
-		WithBlobovniczaSize(szLim),
-	} {
-		opt(c)
-	}
-
-	c.blzRootPath = p
-
-	b := newBlobovniczaTree(c)
+		WithBlobovniczaSize(szLim))
 
 	defer os.RemoveAll(p)
 
-	require.NoError(t, b.init())
+	require.NoError(t, b.Init())
 
 	objSz := uint64(szLim / 2)
 
@@ -80,67 +72,64 @@ func TestBlobovniczas(t *testing.T) {
 		require.NoError(t, err)
 
 		// save object in blobovnicza
-		id, err := b.put(addr, d)
+		pRes, err := b.Put(common.PutPrm{Address: addr, RawData: d})
 		require.NoError(t, err, i)
 
 		// get w/ blobovnicza ID
-		var prm GetSmallPrm
-		prm.SetBlobovniczaID(id)
-		prm.SetAddress(addr)
+		var prm common.GetPrm
+		prm.StorageID = pRes.StorageID
+		prm.Address = addr
 
-		res, err := b.get(prm)
+		res, err := b.Get(prm)
 		require.NoError(t, err)
-		require.Equal(t, obj, res.Object())
+		require.Equal(t, obj, res.Object)
 
 		// get w/o blobovnicza ID
-		prm.SetBlobovniczaID(nil)
+		prm.StorageID = nil
 
-		res, err = b.get(prm)
+		res, err = b.Get(prm)
 		require.NoError(t, err)
-		require.Equal(t, obj, res.Object())
+		require.Equal(t, obj, res.Object)
 
 		// get range w/ blobovnicza ID
-		var rngPrm GetRangeSmallPrm
-		rngPrm.SetBlobovniczaID(id)
-		rngPrm.SetAddress(addr)
+		var rngPrm common.GetRangePrm
+		rngPrm.StorageID = pRes.StorageID
+		rngPrm.Address = addr
 
 		payload := obj.Payload()
 		pSize := uint64(len(obj.Payload()))
 
-		rng := objectSDK.NewRange()
-		rngPrm.SetRange(rng)
-
 		off, ln := pSize/3, 2*pSize/3
-		rng.SetOffset(off)
-		rng.SetLength(ln)
+		rngPrm.Range.SetOffset(off)
+		rngPrm.Range.SetLength(ln)
 
-		rngRes, err := b.getRange(rngPrm)
+		rngRes, err := b.GetRange(rngPrm)
 		require.NoError(t, err)
-		require.Equal(t, payload[off:off+ln], rngRes.RangeData())
+		require.Equal(t, payload[off:off+ln], rngRes.Data)
 
 		// get range w/o blobovnicza ID
-		rngPrm.SetBlobovniczaID(nil)
+		rngPrm.StorageID = nil
 
-		rngRes, err = b.getRange(rngPrm)
+		rngRes, err = b.GetRange(rngPrm)
 		require.NoError(t, err)
-		require.Equal(t, payload[off:off+ln], rngRes.RangeData())
+		require.Equal(t, payload[off:off+ln], rngRes.Data)
 	}
 
-	var dPrm DeleteSmallPrm
-	var gPrm GetSmallPrm
+	var dPrm common.DeletePrm
+	var gPrm common.GetPrm
 
 	for i := range addrList {
-		dPrm.SetAddress(addrList[i])
+		dPrm.Address = addrList[i]
 
-		_, err := b.delete(dPrm)
+		_, err := b.Delete(dPrm)
 		require.NoError(t, err)
 
-		gPrm.SetAddress(addrList[i])
+		gPrm.Address = addrList[i]
 
-		_, err = b.get(gPrm)
+		_, err = b.Get(gPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 
-		_, err = b.delete(dPrm)
+		_, err = b.Delete(dPrm)
 		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	}
 }
@@ -0,0 +1,44 @@
+package blobovniczatree
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Iterate iterates over all objects in b.
+func (b *Blobovniczas) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
+	return common.IterateRes{}, b.iterateBlobovniczas(prm.IgnoreErrors, func(p string, blz *blobovnicza.Blobovnicza) error {
+		err := blobovnicza.IterateObjects(blz, func(addr oid.Address, data []byte) error {
+			if prm.LazyHandler != nil {
+				return prm.LazyHandler(addr, func() ([]byte, error) {
+					return b.Decompress(data)
+				})
+			}
+
+			data, err := b.Decompress(data)
+			if err != nil {
+				if prm.IgnoreErrors {
+					if prm.ErrorHandler != nil {
+						return prm.ErrorHandler(addr, err)
+					}
+					return nil
+				}
+				return fmt.Errorf("could not decompress object data: %w", err)
+			}
+
+			return prm.Handler(common.IterationElement{
+				Address:    addr,
+				ObjectData: data,
+				StorageID:  []byte(p),
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("blobovnicza iterator failure %s: %w", p, err)
+		}
+
+		return nil
+	})
+}
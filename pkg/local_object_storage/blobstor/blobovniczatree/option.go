@@ -0,0 +1,108 @@
+package blobovniczatree
+
+import (
+	"io/fs"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"go.uber.org/zap"
+)
+
+type cfg struct {
+	log             *logger.Logger
+	perm            fs.FileMode
+	readOnly        bool
+	rootPath        string
+	openedCacheSize int
+	blzShallowDepth uint64
+	blzShallowWidth uint64
+	*compression.Config
+	blzOpts []blobovnicza.Option
+}
+
+// Option represents Blobovniczas' constructor option.
+type Option func(*cfg)
+
+const (
+	defaultPerm            = 0700
+	defaultOpenedCacheSize = 50
+	defaultBlzShallowDepth = 2
+	defaultBlzShallowWidth = 16
+)
+
+func initConfig(c *cfg) {
+	*c = cfg{
+		log:             zap.L(),
+		perm:            defaultPerm,
+		openedCacheSize: defaultOpenedCacheSize,
+		blzShallowDepth: defaultBlzShallowDepth,
+		blzShallowWidth: defaultBlzShallowWidth,
+		Config:          &compression.Config{},
+	}
+}
+
+// WithLogger returns option to specify Blobovniczas' logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = l
+		c.blzOpts = append(c.blzOpts, blobovnicza.WithLogger(l))
+	}
+}
+
+// WithPermissions returns option to set permission bits
+// of the blobovnicza tree.
+func WithPermissions(perm fs.FileMode) Option {
+	return func(c *cfg) {
+		c.perm = perm
+		c.blzOpts = append(c.blzOpts, blobovnicza.WithPermissions(perm))
+	}
+}
+
+// WithBlobovniczaShallowWidth returns option to specify
+// width of blobovnicza directories.
+func WithBlobovniczaShallowWidth(width uint64) Option {
+	return func(c *cfg) {
+		c.blzShallowWidth = width
+	}
+}
+
+// WithBlobovniczaShallowDepth returns option to specify
+// depth of blobovnicza directories.
+func WithBlobovniczaShallowDepth(depth uint64) Option {
+	return func(c *cfg) {
+		c.blzShallowDepth = depth
+	}
+}
+
+// WithRootPath returns option to set path to the root
+// directory of the blobovnicza tree.
+func WithRootPath(p string) Option {
+	return func(c *cfg) {
+		c.rootPath = p
+	}
+}
+
+// WithBlobovniczaSize returns option to specify maximum volume
+// of each blobovnicza.
+func WithBlobovniczaSize(sz uint64) Option {
+	return func(c *cfg) {
+		c.blzOpts = append(c.blzOpts, blobovnicza.WithFullSizeLimit(sz))
+	}
+}
+
+// WithOpenedCacheSize return option to specify
+// maximum number of opened non-active blobovnicza's.
+func WithOpenedCacheSize(sz int) Option {
+	return func(c *cfg) {
+		c.openedCacheSize = sz
+	}
+}
+
+// WithObjectSizeLimit returns option to specify maximum size
+// of the objects stored in blobovniczas.
+func WithObjectSizeLimit(sz uint64) Option {
+	return func(c *cfg) {
+		c.blzOpts = append(c.blzOpts, blobovnicza.WithObjectSizeLimit(sz))
+	}
+}
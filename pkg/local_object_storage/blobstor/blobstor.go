@@ -1,101 +1,83 @@
 package blobstor
 
 import (
-	"encoding/hex"
-	"io/fs"
-	"path/filepath"
 	"sync"
 
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
 
+// SubStorage represents single storage component with some storage policy.
+type SubStorage struct {
+	Storage common.Storage
+
+	// Policy decides whether the object should be saved in the Storage.
+	// Objects are given to the first sub-storage whose Policy returns true,
+	// nil Policy accepts any object. The object can be nil if only its
+	// marshaled representation is known.
+	Policy func(*objectSDK.Object, []byte) bool
+}
+
 // BlobStor represents NeoFS local BLOB storage.
 type BlobStor struct {
-	*cfg
-
-	blobovniczas *blobovniczas
+	cfg
 
 	modeMtx sync.RWMutex
 	mode    mode.Mode
 }
 
-type Info = fstree.Info
+// Info contains information about the sub-storages of BlobStor.
+type Info struct {
+	SubStorages []SubStorageInfo
+}
+
+// SubStorageInfo contains information about the blobstor sub-storage.
+type SubStorageInfo struct {
+	Type string
+	Path string
+}
 
 // Option represents BlobStor's constructor option.
 type Option func(*cfg)
 
 type cfg struct {
-	fsTree fstree.FSTree
-
-	compressionEnabled bool
-
-	uncompressableContentTypes []string
-
-	compressor func([]byte) []byte
-
-	decompressor func([]byte) ([]byte, error)
-
-	smallSizeLimit uint64
-
-	log *logger.Logger
+	compression compression.Config
+	log         *logger.Logger
+	storage     []SubStorage
 
-	openedCacheSize int
-
-	blzShallowDepth, blzShallowWidth uint64
-
-	blzRootPath string
-
-	readOnly bool
-
-	blzOpts []blobovnicza.Option
+	dedup       *dedup
+	payloadRefs PayloadRefs
 }
 
-const (
-	defaultShallowDepth = 4
-	defaultPerm         = 0700
-
-	defaultSmallSizeLimit  = 1 << 20 // 1MB
-	defaultOpenedCacheSize = 50
-	defaultBlzShallowDepth = 2
-	defaultBlzShallowWidth = 16
-)
-
-const blobovniczaDir = "blobovnicza"
-
-func defaultCfg() *cfg {
-	return &cfg{
-		fsTree: fstree.FSTree{
-			Depth:      defaultShallowDepth,
-			DirNameLen: hex.EncodedLen(fstree.DirNameLen),
-			Info: Info{
-				Permissions: defaultPerm,
-				RootPath:    "./",
-			},
-		},
-		smallSizeLimit:  defaultSmallSizeLimit,
-		log:             zap.L(),
-		openedCacheSize: defaultOpenedCacheSize,
-		blzShallowDepth: defaultBlzShallowDepth,
-		blzShallowWidth: defaultBlzShallowWidth,
+func initConfig(c *cfg) {
+	*c = cfg{
+		log: zap.L(),
 	}
 }
 
 // New creates, initializes and returns new BlobStor instance.
 func New(opts ...Option) *BlobStor {
-	c := defaultCfg()
+	bs := new(BlobStor)
+	initConfig(&bs.cfg)
 
 	for i := range opts {
-		opts[i](c)
+		opts[i](&bs.cfg)
 	}
 
-	return &BlobStor{
-		cfg:          c,
-		blobovniczas: newBlobovniczaTree(c),
+	for i := range bs.storage {
+		bs.storage[i].Storage.SetCompressor(&bs.compression)
 	}
+
+	if bs.dedup != nil {
+		bs.dedup.Storage.SetCompressor(&bs.compression)
+		bs.dedup.refs = bs.payloadRefs
+	}
+
+	return bs
 }
 
 // SetLogger sets logger. It is used after the shard ID was generated to use it in logs.
@@ -103,31 +85,37 @@ func (b *BlobStor) SetLogger(l *zap.Logger) {
 	b.log = l
 }
 
-// WithShallowDepth returns option to set the
-// depth of the object file subdirectory tree.
+// WithStorages provides sub-blobstors.
 //
-// Depth is reduced to maximum value in case of overflow.
-func WithShallowDepth(depth int) Option {
+// Sub-storages are tried in the specified order: an object is saved in
+// the first one whose policy accepts it. Non-empty storage IDs are expected
+// to be returned by the first sub-storage only, an empty storage ID refers
+// to the last one.
+func WithStorages(st []SubStorage) Option {
 	return func(c *cfg) {
-		if depth > fstree.MaxDepth {
-			depth = fstree.MaxDepth
-		}
+		c.storage = st
+	}
+}
 
-		c.fsTree.Depth = depth
+// WithLogger returns option to specify BlobStor's logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = l.With(zap.String("component", "BlobStor"))
 	}
 }
 
 // WithCompressObjects returns option to toggle
 // compression of the stored objects.
 //
-// If true, Zstandard algorithm is used for data compression.
+// If true, the codec set by WithCompressionCodec (Zstandard
+// by default) is used for data compression.
 //
 // If compressor (decompressor) creation failed,
 // the uncompressed option will be used, and the error
 // is recorded in the provided log.
 func WithCompressObjects(comp bool) Option {
 	return func(c *cfg) {
-		c.compressionEnabled = comp
+		c.compression.Enabled = comp
 	}
 }
 
@@ -135,73 +123,34 @@ func WithCompressObjects(comp bool) Option {
 // for specific content types as seen by object.AttributeContentType attribute.
 func WithUncompressableContentTypes(values []string) Option {
 	return func(c *cfg) {
-		c.uncompressableContentTypes = values
-	}
-}
-
-// WithRootPath returns option to set path to root directory
-// of the fs tree to write the objects.
-func WithRootPath(rootDir string) Option {
-	return func(c *cfg) {
-		c.fsTree.RootPath = rootDir
-		c.blzRootPath = filepath.Join(rootDir, blobovniczaDir)
-	}
-}
-
-// WithRootPerm returns option to set permission
-// bits of the fs tree.
-func WithRootPerm(perm fs.FileMode) Option {
-	return func(c *cfg) {
-		c.fsTree.Permissions = perm
-		c.blzOpts = append(c.blzOpts, blobovnicza.WithPermissions(perm))
-	}
-}
-
-// WithSmallSizeLimit returns option to set maximum size of
-// "small" object.
-func WithSmallSizeLimit(lim uint64) Option {
-	return func(c *cfg) {
-		c.smallSizeLimit = lim
-		c.blzOpts = append(c.blzOpts, blobovnicza.WithObjectSizeLimit(lim))
-	}
-}
-
-// WithLogger returns option to specify BlobStor's logger.
-func WithLogger(l *logger.Logger) Option {
-	return func(c *cfg) {
-		c.log = l.With(zap.String("component", "BlobStor"))
-		c.blzOpts = append(c.blzOpts, blobovnicza.WithLogger(l))
-	}
-}
-
-// WithBlobovniczaShallowDepth returns option to specify
-// depth of blobovnicza directories.
-func WithBlobovniczaShallowDepth(d uint64) Option {
-	return func(c *cfg) {
-		c.blzShallowDepth = d
+		c.compression.UncompressableContentTypes = values
 	}
 }
 
-// WithBlobovniczaShallowWidth returns option to specify
-// width of blobovnicza directories.
-func WithBlobovniczaShallowWidth(w uint64) Option {
+// WithCompressionCodec returns option to set the default compression
+// codec and the compression level. Zero level means codec-specific default.
+func WithCompressionCodec(t compression.Type, level int) Option {
 	return func(c *cfg) {
-		c.blzShallowWidth = w
+		c.compression.Codec = t
+		c.compression.Level = level
 	}
 }
 
-// WithBlobovniczaOpenedCacheSize return option to specify
-// maximum number of opened non-active blobovnicza's.
-func WithBlobovniczaOpenedCacheSize(sz int) Option {
+// WithCompressionRules returns option to select compression codecs
+// for the objects from specific containers or with specific content types.
+// The first matching rule is applied.
+func WithCompressionRules(rules []compression.Rule) Option {
 	return func(c *cfg) {
-		c.openedCacheSize = sz
+		c.compression.Rules = rules
 	}
 }
 
-// WithBlobovniczaSize returns option to specify maximum volume
-// of each blobovnicza.
-func WithBlobovniczaSize(sz uint64) Option {
+// WithCompressibilityEstimate returns option to skip compression
+// of the data which estimated compressibility is lower than the threshold.
+// Zero threshold means compression.EstimateCompressibilityThresholdDefault.
+func WithCompressibilityEstimate(enabled bool, threshold float64) Option {
 	return func(c *cfg) {
-		c.blzOpts = append(c.blzOpts, blobovnicza.WithFullSizeLimit(sz))
+		c.compression.EstimateCompressibility = enabled
+		c.compression.EstimateCompressibilityThreshold = threshold
 	}
 }
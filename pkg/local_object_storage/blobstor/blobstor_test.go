@@ -2,13 +2,55 @@ package blobstor
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
 
+const blobovniczaDir = "blobovnicza"
+
+func defaultStorages(p string, smallSizeLimit uint64, opts ...blobovniczatree.Option) []SubStorage {
+	return []SubStorage{
+		{
+			Storage: blobovniczatree.NewBlobovniczaTree(append([]blobovniczatree.Option{
+				blobovniczatree.WithRootPath(filepath.Join(p, blobovniczaDir)),
+				blobovniczatree.WithBlobovniczaShallowWidth(1)}, // default width is 16, slow init
+				opts...)...),
+			Policy: func(_ *objectSDK.Object, data []byte) bool {
+				return uint64(len(data)) <= smallSizeLimit
+			},
+		},
+		{
+			Storage: fstree.New(fstree.WithPath(p)),
+		},
+	}
+}
+
+func testObject(sz uint64) *objectSDK.Object {
+	raw := objectSDK.New()
+
+	raw.SetID(oidtest.ID())
+	raw.SetContainerID(cidtest.ID())
+
+	raw.SetPayload(make([]byte, sz))
+
+	// fit the binary size to the required
+	data, _ := raw.Marshal()
+	if ln := uint64(len(data)); ln > sz {
+		raw.SetPayload(raw.Payload()[:sz-(ln-sz)])
+	}
+
+	return raw
+}
+
 func TestCompression(t *testing.T) {
 	dir, err := os.MkdirTemp("", "neofs*")
 	require.NoError(t, err)
@@ -21,9 +63,7 @@ func TestCompression(t *testing.T) {
 
 	newBlobStor := func(t *testing.T, compress bool) *BlobStor {
 		bs := New(WithCompressObjects(compress),
-			WithRootPath(dir),
-			WithSmallSizeLimit(smallSizeLimit),
-			WithBlobovniczaShallowWidth(1)) // default width is 16, slow init
+			WithStorages(defaultStorages(dir, smallSizeLimit)))
 		require.NoError(t, bs.Open(false))
 		require.NoError(t, bs.Init())
 		return bs
@@ -37,24 +77,20 @@ func TestCompression(t *testing.T) {
 	}
 
 	testGet := func(t *testing.T, b *BlobStor, i int) {
-		res1, err := b.GetSmall(GetSmallPrm{address: address{object.AddressOf(smallObj[i])}})
+		res1, err := b.Get(common.GetPrm{Address: object.AddressOf(smallObj[i])})
 		require.NoError(t, err)
-		require.Equal(t, smallObj[i], res1.Object())
+		require.Equal(t, smallObj[i], res1.Object)
 
-		res2, err := b.GetBig(GetBigPrm{address: address{object.AddressOf(bigObj[i])}})
+		res2, err := b.Get(common.GetPrm{Address: object.AddressOf(bigObj[i])})
 		require.NoError(t, err)
-		require.Equal(t, bigObj[i], res2.Object())
+		require.Equal(t, bigObj[i], res2.Object)
 	}
 
 	testPut := func(t *testing.T, b *BlobStor, i int) {
-		var prm PutPrm
-		prm.SetObject(smallObj[i])
-		_, err = b.Put(prm)
+		_, err = b.Put(common.PutPrm{Object: smallObj[i]})
 		require.NoError(t, err)
 
-		prm = PutPrm{}
-		prm.SetObject(bigObj[i])
-		_, err = b.Put(prm)
+		_, err = b.Put(common.PutPrm{Object: bigObj[i]})
 		require.NoError(t, err)
 	}
 
@@ -86,9 +122,7 @@ func TestBlobstor_needsCompression(t *testing.T) {
 		t.Cleanup(func() { _ = os.RemoveAll(dir) })
 
 		bs := New(WithCompressObjects(compress),
-			WithRootPath(dir),
-			WithSmallSizeLimit(smallSizeLimit),
-			WithBlobovniczaShallowWidth(1),
+			WithStorages(defaultStorages(dir, smallSizeLimit)),
 			WithUncompressableContentTypes(ct))
 		require.NoError(t, bs.Open(false))
 		require.NoError(t, bs.Init())
@@ -139,3 +173,54 @@ func TestBlobstor_needsCompression(t *testing.T) {
 		require.False(t, b.NeedsCompression(obj))
 	})
 }
+
+func TestBlobstor_StorageID(t *testing.T) {
+	const smallSizeLimit = 512
+
+	dir := t.TempDir()
+	b := New(WithStorages(defaultStorages(dir, smallSizeLimit)))
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { require.NoError(t, b.Close()) })
+
+	small := testObject(smallSizeLimit / 2)
+	big := testObject(smallSizeLimit * 2)
+
+	res, err := b.Put(common.PutPrm{Object: small})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.StorageID)
+	smallID := res.StorageID
+
+	res, err = b.Put(common.PutPrm{Object: big})
+	require.NoError(t, err)
+	require.NotNil(t, res.StorageID)
+	require.Empty(t, res.StorageID)
+	bigID := res.StorageID
+
+	t.Run("get with storage ID", func(t *testing.T) {
+		gRes, err := b.Get(common.GetPrm{Address: object.AddressOf(small), StorageID: smallID})
+		require.NoError(t, err)
+		require.Equal(t, small, gRes.Object)
+
+		gRes, err = b.Get(common.GetPrm{Address: object.AddressOf(big), StorageID: bigID})
+		require.NoError(t, err)
+		require.Equal(t, big, gRes.Object)
+	})
+	t.Run("wrong storage ID", func(t *testing.T) {
+		_, err := b.Get(common.GetPrm{Address: object.AddressOf(small), StorageID: bigID})
+		require.Error(t, err)
+	})
+	t.Run("delete", func(t *testing.T) {
+		_, err := b.Delete(common.DeletePrm{Address: object.AddressOf(small), StorageID: smallID})
+		require.NoError(t, err)
+
+		_, err = b.Delete(common.DeletePrm{Address: object.AddressOf(big)})
+		require.NoError(t, err)
+
+		for _, obj := range []*objectSDK.Object{small, big} {
+			eRes, err := b.Exists(common.ExistsPrm{Address: object.AddressOf(obj)})
+			require.NoError(t, err)
+			require.False(t, eRes.Exists)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package common
+
+import (
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// GetPrm groups the parameters of Get operation.
+type GetPrm struct {
+	Address   oid.Address
+	StorageID []byte
+}
+
+// GetRes groups the resulting values of Get operation.
+type GetRes struct {
+	Object  *objectSDK.Object
+	RawData []byte
+}
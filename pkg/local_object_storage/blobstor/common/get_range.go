@@ -0,0 +1,18 @@
+package common
+
+import (
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// GetRangePrm groups the parameters of GetRange operation.
+type GetRangePrm struct {
+	Address   oid.Address
+	Range     objectSDK.Range
+	StorageID []byte
+}
+
+// GetRangeRes groups the resulting values of GetRange operation.
+type GetRangeRes struct {
+	Data []byte
+}
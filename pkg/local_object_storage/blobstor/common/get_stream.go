@@ -0,0 +1,103 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// GetStreamRes groups the resulting values of GetStream operation.
+type GetStreamRes struct {
+	// Header of the object without payload.
+	Header *objectSDK.Object
+	// Payload of the object, must be closed by the caller.
+	Payload io.ReadCloser
+}
+
+// Streamer is an optional interface of the Storage which reads
+// the object payload without loading the whole object into memory.
+type Streamer interface {
+	GetStream(GetPrm) (GetStreamRes, error)
+}
+
+// StreamObject returns GetStreamRes for the object held in memory.
+func StreamObject(obj *objectSDK.Object) GetStreamRes {
+	return GetStreamRes{
+		Header:  obj.CutPayload(),
+		Payload: io.NopCloser(bytes.NewReader(obj.Payload())),
+	}
+}
+
+const (
+	// payloadField is a number of the payload field in the protobuf
+	// message of the object.
+	payloadField = 4
+
+	// maxHeaderFieldSize limits the size of the header fields read
+	// into memory in order to reject corrupted data.
+	maxHeaderFieldSize = 16 << 20
+)
+
+// ReadObjectStream reads the header of the object from the stream of the
+// marshaled object and returns the header and the reader of the payload.
+// Payload must be the last field of the message, this is true for the
+// objects marshaled by the node.
+func ReadObjectStream(r io.Reader) (*objectSDK.Object, io.Reader, error) {
+	var (
+		br  = bufio.NewReader(r)
+		hdr []byte
+	)
+
+	for {
+		tag, err := binary.ReadUvarint(br)
+		if errors.Is(err, io.EOF) {
+			// object without payload
+			obj, err := unmarshalHeader(hdr)
+			return obj, bytes.NewReader(nil), err
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("could not read field tag: %w", err)
+		}
+
+		num, typ := protowire.DecodeTag(tag)
+		if typ != protowire.BytesType {
+			return nil, nil, fmt.Errorf("unexpected wire type %d of the field %d", typ, num)
+		}
+
+		ln, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read length of the field %d: %w", num, err)
+		}
+
+		if num == payloadField {
+			obj, err := unmarshalHeader(hdr)
+			return obj, io.LimitReader(br, int64(ln)), err
+		} else if ln > maxHeaderFieldSize {
+			return nil, nil, fmt.Errorf("too big field %d: %d bytes", num, ln)
+		}
+
+		hdr = protowire.AppendVarint(hdr, tag)
+		hdr = protowire.AppendVarint(hdr, ln)
+
+		start := len(hdr)
+		hdr = append(hdr, make([]byte, ln)...)
+
+		if _, err := io.ReadFull(br, hdr[start:]); err != nil {
+			return nil, nil, fmt.Errorf("could not read field %d: %w", num, err)
+		}
+	}
+}
+
+func unmarshalHeader(data []byte) (*objectSDK.Object, error) {
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the object header: %w", err)
+	}
+
+	return obj, nil
+}
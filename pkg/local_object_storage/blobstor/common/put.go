@@ -0,0 +1,23 @@
+package common
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// PutPrm groups the parameters of Put operation.
+type PutPrm struct {
+	Address      oid.Address
+	Object       *objectSDK.Object
+	RawData      []byte
+	DontCompress bool
+	// Codec is the type of the compression codec,
+	// the default one is used if empty.
+	Codec compression.Type
+}
+
+// PutRes groups the resulting values of Put operation.
+type PutRes struct {
+	StorageID []byte
+}
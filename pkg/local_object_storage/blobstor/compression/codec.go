@@ -0,0 +1,109 @@
+package compression
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Type is a type of the compression codec.
+type Type string
+
+const (
+	// TypeNone is a codec type which leaves data untouched.
+	TypeNone Type = "none"
+	// TypeZstd is a Zstandard codec type.
+	TypeZstd Type = "zstd"
+	// TypeS2 is an S2 (Snappy extension) codec type.
+	TypeS2 Type = "s2"
+	// TypeLZ4 is an LZ4 codec type.
+	TypeLZ4 Type = "lz4"
+)
+
+// Codec represents a compression algorithm.
+//
+// Codec must be safe for concurrent use.
+type Codec interface {
+	// Compress returns compressed data.
+	Compress(data []byte) []byte
+	// Decompress returns data decompressed from the codec format.
+	Decompress(data []byte) ([]byte, error)
+	// Magic returns the prefix of any data compressed by the codec.
+	// It is used to detect the codec on read, so it must not
+	// intersect with magics of the other codecs. Empty magic means
+	// that data is stored untouched.
+	Magic() []byte
+	// Close releases codec resources.
+	Close() error
+}
+
+// StreamDecompressor is an optional interface of the Codec which
+// decompresses the data stream without loading it into memory.
+type StreamDecompressor interface {
+	// NewDecompressReader returns reader of the data decompressed from r.
+	NewDecompressReader(r io.Reader) (io.ReadCloser, error)
+}
+
+// NewCodecFunc is a constructor of the codec with the specified
+// compression level. Zero level means codec-specific default.
+type NewCodecFunc func(level int) (Codec, error)
+
+var (
+	registryMtx sync.RWMutex
+	registry    = make(map[Type]NewCodecFunc)
+)
+
+func init() {
+	RegisterCodec(TypeNone, func(int) (Codec, error) { return noneCodec{}, nil })
+	RegisterCodec(TypeZstd, newZstdCodec)
+	RegisterCodec(TypeS2, newS2Codec)
+	RegisterCodec(TypeLZ4, newLZ4Codec)
+}
+
+// RegisterCodec registers the codec constructor for the specified type.
+// All registered codecs are available for decompression, so data compressed
+// by any of them can be read regardless of the current configuration.
+//
+// Panics if the codec with the same type is already registered.
+func RegisterCodec(t Type, f NewCodecFunc) {
+	registryMtx.Lock()
+	defer registryMtx.Unlock()
+
+	if _, ok := registry[t]; ok {
+		panic(fmt.Sprintf("compression codec %s is already registered", t))
+	}
+
+	registry[t] = f
+}
+
+// Codecs returns the sorted list of the registered codec types.
+func Codecs() []Type {
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+
+	res := make([]Type, 0, len(registry))
+	for t := range registry {
+		res = append(res, t)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+
+	return res
+}
+
+// IsRegistered checks whether the codec of the specified type is registered.
+func IsRegistered(t Type) bool {
+	registryMtx.RLock()
+	_, ok := registry[t]
+	registryMtx.RUnlock()
+
+	return ok
+}
+
+type noneCodec struct{}
+
+func (noneCodec) Compress(data []byte) []byte            { return data }
+func (noneCodec) Decompress(data []byte) ([]byte, error) { return data, nil }
+func (noneCodec) Magic() []byte                          { return nil }
+func (noneCodec) Close() error                           { return nil }
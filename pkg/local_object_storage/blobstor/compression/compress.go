@@ -0,0 +1,280 @@
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Config represents common compression-related configuration.
+type Config struct {
+	Enabled                    bool
+	UncompressableContentTypes []string
+
+	// Codec is the type of the codec used by default.
+	// Zstandard is used if empty.
+	Codec Type
+	// Level is the compression level passed to the codecs,
+	// zero means codec-specific default.
+	Level int
+	// Rules overrides the default codec for specific objects.
+	Rules []Rule
+
+	// EstimateCompressibility enables skipping of compression for
+	// data which compressibility is lower than
+	// EstimateCompressibilityThreshold.
+	EstimateCompressibility bool
+	// EstimateCompressibilityThreshold is the minimum estimated share
+	// of data which must be saved by compression, between 0 and 1.
+	// EstimateCompressibilityThresholdDefault is used if zero.
+	EstimateCompressibilityThreshold float64
+
+	codecs map[Type]Codec
+}
+
+// Rule selects the codec for the objects from the specified containers
+// or with the specified content types.
+type Rule struct {
+	// Containers is a list of containers the rule is applied to.
+	Containers []cid.ID
+	// ContentTypes is a list of the content type patterns
+	// the rule is applied to. Patterns have the same format
+	// as UncompressableContentTypes.
+	ContentTypes []string
+	// Codec is the type of the codec for the matched objects.
+	Codec Type
+}
+
+// Init initializes compression routines.
+func (c *Config) Init() error {
+	if c.Codec == "" {
+		c.Codec = TypeZstd
+	}
+
+	if !IsRegistered(c.Codec) {
+		return fmt.Errorf("unknown compression codec: %s", c.Codec)
+	}
+
+	for i := range c.Rules {
+		if !IsRegistered(c.Rules[i].Codec) {
+			return fmt.Errorf("unknown compression codec in rule #%d: %s", i, c.Rules[i].Codec)
+		}
+	}
+
+	if c.EstimateCompressibilityThreshold == 0 {
+		c.EstimateCompressibilityThreshold = EstimateCompressibilityThresholdDefault
+	}
+
+	// All codecs are created regardless of the settings,
+	// because we should be able to read any object
+	// we have previously written.
+	registryMtx.RLock()
+	defer registryMtx.RUnlock()
+
+	c.codecs = make(map[Type]Codec, len(registry))
+	for t, f := range registry {
+		codec, err := f(c.Level)
+		if err != nil {
+			_ = c.Close()
+			return fmt.Errorf("could not create %s codec: %w", t, err)
+		}
+
+		c.codecs[t] = codec
+	}
+
+	return nil
+}
+
+// NeedsCompression returns true if the object should be compressed.
+// For an object to be compressed 2 conditions must hold:
+// 1. Compression is enabled in settings.
+// 2. Codec selected for the object is not TypeNone.
+func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
+	return c.SelectCodec(obj) != TypeNone
+}
+
+// SelectCodec returns the type of the codec the object should be
+// compressed with:
+// 1. TypeNone if compression is disabled in settings.
+// 2. Codec of the first rule matching the object.
+// 3. TypeNone if object MIME Content-Type is not allowed for compression.
+// 4. Default codec otherwise.
+func (c *Config) SelectCodec(obj *objectSDK.Object) Type {
+	if !c.Enabled {
+		return TypeNone
+	}
+
+	var contentType string
+	for _, attr := range obj.Attributes() {
+		if attr.Key() == objectSDK.AttributeContentType {
+			contentType = attr.Value()
+			break
+		}
+	}
+
+	cnr, cnrSet := obj.ContainerID()
+
+	for i := range c.Rules {
+		if cnrSet {
+			for j := range c.Rules[i].Containers {
+				if c.Rules[i].Containers[j].Equals(cnr) {
+					return c.Rules[i].Codec
+				}
+			}
+		}
+
+		if contentType != "" && matchContentType(contentType, c.Rules[i].ContentTypes) {
+			return c.Rules[i].Codec
+		}
+	}
+
+	if contentType != "" && matchContentType(contentType, c.UncompressableContentTypes) {
+		return TypeNone
+	}
+
+	if c.Codec == "" {
+		return TypeZstd
+	}
+
+	return c.Codec
+}
+
+// matchContentType checks whether the content type matches any of the patterns.
+// Pattern can end with '*' to match prefix or start with '*' to match suffix.
+func matchContentType(contentType string, patterns []string) bool {
+	for _, value := range patterns {
+		var match bool
+		switch {
+		case len(value) > 0 && value[len(value)-1] == '*':
+			match = strings.HasPrefix(contentType, value[:len(value)-1])
+		case len(value) > 0 && value[0] == '*':
+			match = strings.HasSuffix(contentType, value[1:])
+		default:
+			match = contentType == value
+		}
+		if match {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Decompress decompresses data if it starts with the magic
+// of any registered codec and returns data untouched otherwise.
+func (c *Config) Decompress(data []byte) ([]byte, error) {
+	for _, codec := range c.codecs {
+		magic := codec.Magic()
+		if len(magic) != 0 && bytes.HasPrefix(data, magic) {
+			return codec.Decompress(data)
+		}
+	}
+
+	// Fallback to reading decompressed objects.
+	return data, nil
+}
+
+// DecompressReader returns reader of the data decompressed from r if it
+// starts with the magic of any registered codec and reader of the data
+// untouched otherwise. Data is decompressed on the fly if the codec
+// supports it (see StreamDecompressor).
+func (c *Config) DecompressReader(r io.Reader) (io.ReadCloser, error) {
+	var maxMagic int
+
+	for _, codec := range c.codecs {
+		if l := len(codec.Magic()); l > maxMagic {
+			maxMagic = l
+		}
+	}
+
+	br := bufio.NewReader(r)
+
+	// short data is checked as is
+	prefix, err := br.Peek(maxMagic)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	for _, codec := range c.codecs {
+		magic := codec.Magic()
+		if len(magic) == 0 || !bytes.HasPrefix(prefix, magic) {
+			continue
+		}
+
+		if sd, ok := codec.(StreamDecompressor); ok {
+			return sd.NewDecompressReader(br)
+		}
+
+		data, err := io.ReadAll(br)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err = codec.Decompress(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	return io.NopCloser(br), nil
+}
+
+// Compress compresses data with the default codec if compression
+// is enabled and returns data untouched otherwise.
+func (c *Config) Compress(data []byte) []byte {
+	if c == nil {
+		return data
+	}
+	return c.CompressWith(c.Codec, data)
+}
+
+// CompressWith compresses data with the codec of the specified type if
+// compression is enabled and returns data untouched otherwise. Empty
+// type means the default codec.
+//
+// Data is also left untouched if it is estimated to be incompressible
+// or if the compressed data is not smaller than the original one.
+func (c *Config) CompressWith(t Type, data []byte) []byte {
+	if c == nil || !c.Enabled {
+		return data
+	}
+
+	if t == "" {
+		t = c.Codec
+	}
+
+	codec, ok := c.codecs[t]
+	if !ok || t == TypeNone {
+		return data
+	}
+
+	if c.EstimateCompressibility && estimateCompressibility(data) < c.EstimateCompressibilityThreshold {
+		return data
+	}
+
+	res := codec.Compress(data)
+	if len(res) >= len(data) {
+		return data
+	}
+
+	return res
+}
+
+// Close closes all the codecs, returns any error occurred.
+func (c *Config) Close() error {
+	var firstErr error
+	for _, codec := range c.codecs {
+		if err := codec.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
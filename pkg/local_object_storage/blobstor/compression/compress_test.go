@@ -0,0 +1,163 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func newConfig(t *testing.T, c Config) *Config {
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+	return &c
+}
+
+func TestCompressDecompress(t *testing.T) {
+	data := bytes.Repeat([]byte("neofs object payload "), 1000)
+
+	for _, codec := range []Type{TypeZstd, TypeS2, TypeLZ4} {
+		t.Run(string(codec), func(t *testing.T) {
+			c := newConfig(t, Config{Enabled: true, Codec: codec})
+
+			compressed := c.Compress(data)
+			require.Less(t, len(compressed), len(data))
+
+			// Any codec is detected on read.
+			other := newConfig(t, Config{})
+			res, err := other.Decompress(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, res)
+		})
+	}
+
+	t.Run("lz4 levels", func(t *testing.T) {
+		for _, level := range []int{-1, 0, 5, 9, 20} {
+			c := newConfig(t, Config{Enabled: true, Codec: TypeLZ4, Level: level})
+
+			compressed := c.Compress(data)
+			require.Less(t, len(compressed), len(data))
+
+			res, err := c.Decompress(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, res)
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		c := newConfig(t, Config{Enabled: true, Codec: TypeNone})
+		require.Equal(t, data, c.Compress(data))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		c := newConfig(t, Config{Codec: TypeS2})
+		require.Equal(t, data, c.CompressWith(TypeZstd, data))
+	})
+
+	t.Run("legacy zstd", func(t *testing.T) {
+		enc, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+
+		compressed := enc.EncodeAll(data, nil)
+		require.NoError(t, enc.Close())
+
+		c := newConfig(t, Config{Enabled: true, Codec: TypeS2})
+		res, err := c.Decompress(compressed)
+		require.NoError(t, err)
+		require.Equal(t, data, res)
+	})
+
+	t.Run("unknown codec", func(t *testing.T) {
+		c := Config{Enabled: true, Codec: "unknown"}
+		require.Error(t, c.Init())
+
+		c = Config{Enabled: true, Rules: []Rule{{Codec: "unknown"}}}
+		require.Error(t, c.Init())
+	})
+}
+
+func TestDecompressReader(t *testing.T) {
+	data := bytes.Repeat([]byte("neofs object payload "), 1000)
+
+	for _, codec := range []Type{TypeZstd, TypeS2, TypeLZ4, TypeNone} {
+		t.Run(string(codec), func(t *testing.T) {
+			c := newConfig(t, Config{Enabled: true, Codec: codec})
+
+			r, err := c.DecompressReader(bytes.NewReader(c.Compress(data)))
+			require.NoError(t, err)
+
+			res, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			require.Equal(t, data, res)
+		})
+	}
+
+	t.Run("short data", func(t *testing.T) {
+		c := newConfig(t, Config{})
+
+		r, err := c.DecompressReader(bytes.NewReader([]byte{1, 2}))
+		require.NoError(t, err)
+
+		res, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2}, res)
+	})
+}
+
+func TestEstimateCompressibility(t *testing.T) {
+	random := make([]byte, 1<<20)
+	_, _ = rand.Read(random)
+
+	c := newConfig(t, Config{Enabled: true, EstimateCompressibility: true})
+	require.Equal(t, random, c.Compress(random))
+
+	data := bytes.Repeat([]byte{1, 2, 3, 4}, 1<<18)
+	require.Less(t, len(c.Compress(data)), len(data))
+
+	require.Less(t, estimateCompressibility(random), EstimateCompressibilityThresholdDefault)
+	require.Equal(t, 1.0, estimateCompressibility(make([]byte, 100)))
+}
+
+func TestSelectCodec(t *testing.T) {
+	cnr := cidtest.ID()
+
+	obj := func(cnr cid.ID, contentType string) *objectSDK.Object {
+		o := objectSDK.New()
+		o.SetContainerID(cnr)
+		if contentType != "" {
+			var a objectSDK.Attribute
+			a.SetKey(objectSDK.AttributeContentType)
+			a.SetValue(contentType)
+			o.SetAttributes(a)
+		}
+		return o
+	}
+
+	c := Config{
+		Enabled:                    true,
+		Codec:                      TypeZstd,
+		UncompressableContentTypes: []string{"video/*"},
+		Rules: []Rule{
+			{Containers: []cid.ID{cnr}, Codec: TypeNone},
+			{ContentTypes: []string{"text/*", "*/json"}, Codec: TypeS2},
+		},
+	}
+
+	require.Equal(t, TypeNone, c.SelectCodec(obj(cnr, "text/plain")))
+	require.Equal(t, TypeS2, c.SelectCodec(obj(cidtest.ID(), "text/plain")))
+	require.Equal(t, TypeS2, c.SelectCodec(obj(cidtest.ID(), "application/json")))
+	require.Equal(t, TypeNone, c.SelectCodec(obj(cidtest.ID(), "video/mpeg")))
+	require.Equal(t, TypeZstd, c.SelectCodec(obj(cidtest.ID(), "")))
+	require.True(t, c.NeedsCompression(obj(cidtest.ID(), "")))
+	require.False(t, c.NeedsCompression(obj(cnr, "")))
+
+	c.Enabled = false
+	require.Equal(t, TypeNone, c.SelectCodec(obj(cidtest.ID(), "text/plain")))
+}
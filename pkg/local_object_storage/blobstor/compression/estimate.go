@@ -0,0 +1,61 @@
+package compression
+
+import (
+	"math"
+)
+
+const (
+	// estimateSampleSize is the size of a single data sample
+	// used to estimate compressibility.
+	estimateSampleSize = 4 << 10
+	// estimateSampleCount is the maximum number of samples
+	// taken from the data.
+	estimateSampleCount = 8
+)
+
+// EstimateCompressibilityThresholdDefault is the default threshold
+// of the data compressibility.
+const EstimateCompressibilityThresholdDefault = 0.1
+
+// estimateCompressibility returns the estimated share of the data
+// which can be saved by compression: 0 means random data, 1 means
+// data consisting of a single repeated byte.
+//
+// The estimation is based on the Shannon entropy of the bytes taken
+// from several evenly distributed samples of the data.
+func estimateCompressibility(data []byte) float64 {
+	if len(data) == 0 {
+		return 0
+	}
+
+	var (
+		hist  [256]uint64
+		total uint64
+	)
+
+	count := func(sample []byte) {
+		for _, b := range sample {
+			hist[b]++
+		}
+		total += uint64(len(sample))
+	}
+
+	if len(data) <= estimateSampleSize*estimateSampleCount {
+		count(data)
+	} else {
+		step := (len(data) - estimateSampleSize) / (estimateSampleCount - 1)
+		for i := 0; i < estimateSampleCount; i++ {
+			count(data[i*step : i*step+estimateSampleSize])
+		}
+	}
+
+	var entropy float64
+	for i := range hist {
+		if hist[i] != 0 {
+			p := float64(hist[i]) / float64(total)
+			entropy -= p * math.Log2(p)
+		}
+	}
+
+	return 1 - entropy/8
+}
@@ -0,0 +1,96 @@
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+// lz4FrameMagic contains first 4 bytes of any LZ4 frame
+// https://github.com/lz4/lz4/blob/dev/doc/lz4_Frame_format.md .
+var lz4FrameMagic = []byte{0x04, 0x22, 0x4d, 0x18}
+
+var lz4Levels = [...]lz4.CompressionLevel{
+	lz4.Fast, lz4.Level1, lz4.Level2, lz4.Level3, lz4.Level4,
+	lz4.Level5, lz4.Level6, lz4.Level7, lz4.Level8, lz4.Level9,
+}
+
+type lz4Codec struct {
+	writers sync.Pool
+	readers sync.Pool
+}
+
+// newLZ4Codec creates LZ4 codec. Level 0 and below means the fast
+// compression, levels 1-9 mean the high compression of the corresponding
+// level and levels above are mapped to the level 9.
+func newLZ4Codec(level int) (Codec, error) {
+	switch {
+	case level < 0:
+		level = 0
+	case level >= len(lz4Levels):
+		level = len(lz4Levels) - 1
+	}
+
+	opts := []lz4.Option{
+		lz4.CompressionLevelOption(lz4Levels[level]),
+		lz4.ConcurrencyOption(1),
+	}
+
+	w := lz4.NewWriter(nil)
+	if err := w.Apply(opts...); err != nil {
+		return nil, fmt.Errorf("could not create lz4 writer: %w", err)
+	}
+
+	c := new(lz4Codec)
+	c.writers.New = func() interface{} {
+		w := lz4.NewWriter(nil)
+		// options are checked above
+		_ = w.Apply(opts...)
+		return w
+	}
+	c.writers.Put(w)
+	c.readers.New = func() interface{} {
+		return lz4.NewReader(nil)
+	}
+
+	return c, nil
+}
+
+func (c *lz4Codec) Compress(data []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
+
+	w := c.writers.Get().(*lz4.Writer)
+	defer c.writers.Put(w)
+
+	w.Reset(buf)
+
+	// writing to bytes.Buffer can't fail
+	_, _ = w.Write(data)
+	_ = w.Close()
+
+	return buf.Bytes()
+}
+
+func (c *lz4Codec) Decompress(data []byte) ([]byte, error) {
+	r := c.readers.Get().(*lz4.Reader)
+	defer c.readers.Put(r)
+
+	r.Reset(bytes.NewReader(data))
+
+	return io.ReadAll(r)
+}
+
+func (c *lz4Codec) NewDecompressReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(lz4.NewReader(r)), nil
+}
+
+func (c *lz4Codec) Magic() []byte {
+	return lz4FrameMagic
+}
+
+func (c *lz4Codec) Close() error {
+	return nil
+}
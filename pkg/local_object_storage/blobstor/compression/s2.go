@@ -0,0 +1,77 @@
+package compression
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+)
+
+// s2StreamMagic contains first 10 bytes of any S2 stream
+// (stream identifier chunk) https://github.com/google/snappy/blob/main/framing_format.txt .
+var s2StreamMagic = []byte{0xff, 0x06, 0x00, 0x00, 'S', '2', 's', 'T', 'w', 'O'}
+
+type s2Codec struct {
+	writers sync.Pool
+	readers sync.Pool
+}
+
+// newS2Codec creates S2 codec. Level 1 and below means default
+// compression, level 2 means better compression and levels above
+// mean the best compression.
+func newS2Codec(level int) (Codec, error) {
+	opts := []s2.WriterOption{s2.WriterConcurrency(1)}
+	switch {
+	case level == 2:
+		opts = append(opts, s2.WriterBetterCompression())
+	case level > 2:
+		opts = append(opts, s2.WriterBestCompression())
+	}
+
+	c := new(s2Codec)
+	c.writers.New = func() interface{} {
+		return s2.NewWriter(nil, opts...)
+	}
+	c.readers.New = func() interface{} {
+		return s2.NewReader(nil)
+	}
+
+	return c, nil
+}
+
+func (c *s2Codec) Compress(data []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
+
+	w := c.writers.Get().(*s2.Writer)
+	defer c.writers.Put(w)
+
+	w.Reset(buf)
+
+	// writing to bytes.Buffer can't fail
+	_, _ = w.Write(data)
+	_ = w.Close()
+
+	return buf.Bytes()
+}
+
+func (c *s2Codec) Decompress(data []byte) ([]byte, error) {
+	r := c.readers.Get().(*s2.Reader)
+	defer c.readers.Put(r)
+
+	r.Reset(bytes.NewReader(data))
+
+	return io.ReadAll(r)
+}
+
+func (c *s2Codec) NewDecompressReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(s2.NewReader(r)), nil
+}
+
+func (c *s2Codec) Magic() []byte {
+	return s2StreamMagic
+}
+
+func (c *s2Codec) Close() error {
+	return nil
+}
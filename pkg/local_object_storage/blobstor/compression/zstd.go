@@ -0,0 +1,68 @@
+package compression
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// zstdFrameMagic contains first 4 bytes of any compressed object
+// https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
+var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+// newZstdCodec creates Zstandard codec. Level is interpreted
+// as a zstd compression level (1-22) and is mapped to the nearest
+// level supported by the encoder.
+func newZstdCodec(level int) (Codec, error) {
+	var opts []zstd.EOption
+	if level != 0 {
+		opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+
+	encoder, err := zstd.NewWriter(nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		_ = encoder.Close()
+		return nil, err
+	}
+
+	return &zstdCodec{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+func (c *zstdCodec) Compress(data []byte) []byte {
+	return c.encoder.EncodeAll(data, make([]byte, 0, len(data)))
+}
+
+func (c *zstdCodec) Decompress(data []byte) ([]byte, error) {
+	return c.decoder.DecodeAll(data, nil)
+}
+
+func (c *zstdCodec) NewDecompressReader(r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+
+	return d.IOReadCloser(), nil
+}
+
+func (c *zstdCodec) Magic() []byte {
+	return zstdFrameMagic
+}
+
+func (c *zstdCodec) Close() error {
+	c.decoder.Close()
+	return c.encoder.Close()
+}
@@ -3,14 +3,26 @@ package blobstor
 import (
 	"errors"
 	"fmt"
+
+	"go.uber.org/zap"
 )
 
 // Open opens BlobStor.
 func (b *BlobStor) Open(readOnly bool) error {
 	b.log.Debug("opening...")
 
-	b.blobovniczas.readOnly = readOnly
+	for i := range b.storage {
+		err := b.storage[i].Storage.Open(readOnly)
+		if err != nil {
+			return err
+		}
+	}
 
+	if b.dedup != nil {
+		if err := b.dedup.Storage.Open(readOnly); err != nil {
+			return fmt.Errorf("could not open payload storage: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -21,15 +33,26 @@ var ErrInitBlobovniczas = errors.New("failure on blobovnicza initialization stag
 //
 // If BlobStor is already initialized, no action is taken.
 //
-// Returns wrapped ErrInitBlobovniczas on blobovnicza tree's initializaiton failure.
+// Returns wrapped ErrInitBlobovniczas on sub-storage initialization failure.
 func (b *BlobStor) Init() error {
 	b.log.Debug("initializing...")
 
-	err := b.blobovniczas.init()
-	if err != nil {
-		return fmt.Errorf("%w: %v", ErrInitBlobovniczas, err)
+	if err := b.compression.Init(); err != nil {
+		return err
 	}
 
+	for i := range b.storage {
+		err := b.storage[i].Storage.Init()
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInitBlobovniczas, err)
+		}
+	}
+
+	if b.dedup != nil {
+		if err := b.dedup.Storage.Init(); err != nil {
+			return fmt.Errorf("could not initialize payload storage: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -37,5 +60,31 @@ func (b *BlobStor) Init() error {
 func (b *BlobStor) Close() error {
 	b.log.Debug("closing...")
 
-	return b.blobovniczas.close()
+	var firstErr error
+	for i := range b.storage {
+		err := b.storage[i].Storage.Close()
+		if err != nil {
+			b.log.Info("couldn't close storage", zap.String("error", err.Error()))
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+	}
+
+	if b.dedup != nil {
+		err := b.dedup.Storage.Close()
+		if err != nil {
+			b.log.Info("couldn't close payload storage", zap.String("error", err.Error()))
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	err := b.compression.Close()
+	if firstErr == nil {
+		firstErr = err
+	}
+	return firstErr
 }
@@ -0,0 +1,343 @@
+package blobstor
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// MinDedupPayloadSize is a minimum payload size of the deduplicated objects.
+// Payloads and headers of the deduplicated objects are stored separately,
+// it is not worth doing for small objects.
+const MinDedupPayloadSize = 64 << 10
+
+// PayloadRefs counts references of the objects to the deduplicated payloads.
+type PayloadRefs interface {
+	// AddPayloadRef references the payload with the given SHA256 checksum
+	// from the object. Repeated references from the same object are ignored.
+	// Returns true if the reference has been added.
+	AddPayloadRef(addr oid.Address, sum [sha256.Size]byte) (bool, error)
+	// RemovePayloadRef removes the reference of the object to the payload.
+	// Returns the payload checksum and true if the payload is not referenced
+	// anymore. Missing reference is ignored.
+	RemovePayloadRef(addr oid.Address) ([sha256.Size]byte, bool, error)
+	// PayloadRefs returns the number of the objects referencing the payload.
+	PayloadRefs(sum [sha256.Size]byte) (uint64, error)
+}
+
+// DedupPrm groups the parameters of the payload deduplication.
+type DedupPrm struct {
+	// Storage of the deduplicated payloads.
+	Storage common.Storage
+
+	// Filter selects the objects which payloads are deduplicated,
+	// nil Filter selects all the objects.
+	Filter func(*objectSDK.Object) bool
+}
+
+type dedup struct {
+	DedupPrm
+
+	refs PayloadRefs
+
+	// locks serialize referencing and releasing of the same payloads
+	locks [256]sync.Mutex
+}
+
+// WithPayloadDedup returns option to store identical payloads of the
+// objects once. Objects are stored without payload which is saved in
+// the separate storage by its checksum. References to the payloads are
+// counted by PayloadRefs set by WithPayloadRefs, objects are stored
+// as is if it is not set.
+//
+// Payload storage is also required to read the objects deduplicated
+// earlier, so it must be kept even if no objects are selected
+// for deduplication.
+func WithPayloadDedup(prm DedupPrm) Option {
+	return func(c *cfg) {
+		c.dedup = &dedup{DedupPrm: prm}
+	}
+}
+
+// WithPayloadRefs returns option to set the counter of the references
+// to the deduplicated payloads.
+func WithPayloadRefs(refs PayloadRefs) Option {
+	return func(c *cfg) {
+		c.payloadRefs = refs
+	}
+}
+
+// DeduplicatedPayload checks whether the object is stored without payload
+// because of deduplication and returns the checksum of its payload.
+func DeduplicatedPayload(obj *objectSDK.Object) ([sha256.Size]byte, bool) {
+	if len(obj.Payload()) != 0 || obj.PayloadSize() == 0 {
+		return [sha256.Size]byte{}, false
+	}
+
+	return payloadSum(obj)
+}
+
+func payloadSum(obj *objectSDK.Object) ([sha256.Size]byte, bool) {
+	var sum [sha256.Size]byte
+
+	cs, ok := obj.PayloadChecksum()
+	if !ok || cs.Type() != checksum.SHA256 || len(cs.Value()) != sha256.Size {
+		return sum, false
+	}
+
+	copy(sum[:], cs.Value())
+
+	return sum, true
+}
+
+// payloadAddress returns the address of the deduplicated payload
+// in the payload storage. Both container and object IDs are set to
+// the payload checksum.
+func payloadAddress(sum [sha256.Size]byte) oid.Address {
+	var addr oid.Address
+	addr.SetContainer(cid.ID(sum))
+	addr.SetObject(oid.ID(sum))
+
+	return addr
+}
+
+// putDeduplicated saves the payload of the object to the payload storage if
+// it is not saved yet and returns the parameters to save the object header.
+// Parameters are returned as is if the object payload is not deduplicated.
+// Returns true if the new reference to the payload has been added, it must
+// be dropped via dropPayloadRef if the object is not saved.
+func (b *BlobStor) putDeduplicated(prm common.PutPrm) (common.PutPrm, bool, error) {
+	obj := prm.Object
+	if obj == nil {
+		if len(prm.RawData) < MinDedupPayloadSize {
+			return prm, false, nil
+		}
+
+		obj = objectSDK.New()
+		if err := obj.Unmarshal(prm.RawData); err != nil {
+			return prm, false, nil
+		}
+	}
+
+	if obj.Type() != objectSDK.TypeRegular || len(obj.Payload()) < MinDedupPayloadSize ||
+		obj.PayloadSize() != uint64(len(obj.Payload())) ||
+		b.dedup.Filter != nil && !b.dedup.Filter(obj) {
+		return prm, false, nil
+	}
+
+	sum, ok := payloadSum(obj)
+	if !ok || sha256.Sum256(obj.Payload()) != sum {
+		return prm, false, nil
+	}
+
+	l := &b.dedup.locks[sum[0]]
+	l.Lock()
+	defer l.Unlock()
+
+	// the reference is added before the payload is saved, so the payload
+	// is never removed while it is referenced, the reference is dropped
+	// if the object is not saved
+	added, err := b.dedup.refs.AddPayloadRef(prm.Address, sum)
+	if err != nil {
+		b.log.Debug("could not reference deduplicated payload, saving the object as is",
+			zap.Stringer("object", prm.Address),
+			zap.String("error", err.Error()))
+
+		return prm, false, nil
+	}
+
+	var existsPrm common.ExistsPrm
+	existsPrm.Address = payloadAddress(sum)
+
+	res, err := b.dedup.Storage.Exists(existsPrm)
+	if err != nil {
+		return prm, added, fmt.Errorf("could not check deduplicated payload: %w", err)
+	}
+
+	if !res.Exists {
+		payloadObj := objectSDK.New()
+		payloadObj.SetContainerID(cid.ID(sum))
+		payloadObj.SetID(oid.ID(sum))
+		payloadObj.SetPayload(obj.Payload())
+
+		data, err := payloadObj.Marshal()
+		if err != nil {
+			return prm, added, fmt.Errorf("could not marshal deduplicated payload: %w", err)
+		}
+
+		var putPrm common.PutPrm
+		putPrm.Address = existsPrm.Address
+		putPrm.RawData = data
+		putPrm.DontCompress = prm.DontCompress
+		putPrm.Codec = prm.Codec
+
+		if _, err := b.dedup.Storage.Put(putPrm); err != nil {
+			return prm, added, fmt.Errorf("could not save deduplicated payload: %w", err)
+		}
+	}
+
+	prm.Object = obj.CutPayload()
+	prm.RawData = nil
+
+	return prm, added, nil
+}
+
+// dropPayloadRef removes the reference of the object which has not been
+// saved to the deduplicated payload and the payload if it is not referenced
+// anymore.
+func (b *BlobStor) dropPayloadRef(addr oid.Address) {
+	sum, released, err := b.dedup.refs.RemovePayloadRef(addr)
+	if err == nil && released {
+		err = b.ReleasePayload(sum)
+	}
+
+	if err != nil {
+		b.log.Error("could not drop reference to deduplicated payload of unsaved object",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()))
+	}
+}
+
+// ReleasePayload removes the deduplicated payload with the given SHA256
+// checksum from the payload storage if it is not referenced anymore.
+func (b *BlobStor) ReleasePayload(sum [sha256.Size]byte) error {
+	if b.dedup == nil {
+		return nil
+	}
+
+	l := &b.dedup.locks[sum[0]]
+	l.Lock()
+	defer l.Unlock()
+
+	n, err := b.dedup.refs.PayloadRefs(sum)
+	if err != nil {
+		return fmt.Errorf("could not read payload references: %w", err)
+	} else if n != 0 {
+		return nil
+	}
+
+	var delPrm common.DeletePrm
+	delPrm.Address = payloadAddress(sum)
+
+	_, err = b.dedup.Storage.Delete(delPrm)
+	if err != nil && !errors.As(err, new(apistatus.ObjectNotFound)) {
+		return err
+	}
+
+	return nil
+}
+
+// restorePayload sets the deduplicated payload of the object.
+func (b *BlobStor) restorePayload(obj *objectSDK.Object) error {
+	if b.dedup == nil {
+		return nil
+	}
+
+	sum, ok := DeduplicatedPayload(obj)
+	if !ok {
+		return nil
+	}
+
+	var getPrm common.GetPrm
+	getPrm.Address = payloadAddress(sum)
+
+	res, err := b.dedup.Storage.Get(getPrm)
+	if err != nil {
+		return fmt.Errorf("could not read deduplicated payload: %w", err)
+	}
+
+	obj.SetPayload(res.Object.Payload())
+
+	return nil
+}
+
+// RestorePayload returns the binary object with the deduplicated payload
+// restored. Binary objects which payload is not deduplicated are returned
+// as is.
+func (b *BlobStor) RestorePayload(data []byte) ([]byte, error) {
+	if b.dedup == nil {
+		return data, nil
+	}
+
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+	}
+
+	if _, ok := DeduplicatedPayload(obj); !ok {
+		return data, nil
+	}
+
+	if err := b.restorePayload(obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Marshal()
+}
+
+// getPayloadRange reads the range of the deduplicated payload of the object.
+func (b *BlobStor) getPayloadRange(prm common.GetRangePrm) (common.GetRangeRes, bool, error) {
+	res, err := b.get(common.GetPrm{Address: prm.Address, StorageID: prm.StorageID})
+	if err != nil {
+		return common.GetRangeRes{}, false, nil
+	}
+
+	sum, ok := DeduplicatedPayload(res.Object)
+	if !ok {
+		return common.GetRangeRes{}, false, nil
+	}
+
+	prm.Address = payloadAddress(sum)
+	prm.StorageID = nil
+
+	rngRes, err := b.dedup.Storage.GetRange(prm)
+
+	return rngRes, true, err
+}
+
+// streamPayload replaces empty payload reader of the deduplicated object
+// with the reader of the deduplicated payload.
+func (b *BlobStor) streamPayload(res common.GetStreamRes) (common.GetStreamRes, error) {
+	if b.dedup == nil || res.Header.PayloadSize() == 0 {
+		return res, nil
+	}
+
+	br := bufio.NewReader(res.Payload)
+
+	if _, err := br.Peek(1); !errors.Is(err, io.EOF) {
+		res.Payload = bufferedReadCloser{Reader: br, Closer: res.Payload}
+		return res, nil
+	}
+
+	_ = res.Payload.Close()
+
+	sum, ok := payloadSum(res.Header)
+	if !ok {
+		return common.GetStreamRes{}, errors.New("missing payload of the object")
+	}
+
+	payloadRes, err := getStream(b.dedup.Storage, common.GetPrm{Address: payloadAddress(sum)})
+	if err != nil {
+		return common.GetStreamRes{}, fmt.Errorf("could not read deduplicated payload: %w", err)
+	}
+
+	res.Payload = payloadRes.Payload
+
+	return res, nil
+}
+
+type bufferedReadCloser struct {
+	*bufio.Reader
+	io.Closer
+}
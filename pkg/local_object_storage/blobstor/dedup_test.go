@@ -0,0 +1,238 @@
+package blobstor
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testPayloadRefs struct {
+	mtx  sync.Mutex
+	objs map[oid.Address][sha256.Size]byte
+}
+
+func (r *testPayloadRefs) AddPayloadRef(addr oid.Address, sum [sha256.Size]byte) (bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if _, ok := r.objs[addr]; ok {
+		return false, nil
+	}
+
+	r.objs[addr] = sum
+
+	return true, nil
+}
+
+func (r *testPayloadRefs) RemovePayloadRef(addr oid.Address) ([sha256.Size]byte, bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	sum, ok := r.objs[addr]
+	if !ok {
+		return sum, false, nil
+	}
+
+	delete(r.objs, addr)
+
+	for _, s := range r.objs {
+		if s == sum {
+			return sum, false, nil
+		}
+	}
+
+	return sum, true, nil
+}
+
+func (r *testPayloadRefs) PayloadRefs(sum [sha256.Size]byte) (uint64, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var n uint64
+	for _, s := range r.objs {
+		if s == sum {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+func (r *testPayloadRefs) remove(addr oid.Address) {
+	r.mtx.Lock()
+	delete(r.objs, addr)
+	r.mtx.Unlock()
+}
+
+func testObjectWithPayload(payload []byte) *objectSDK.Object {
+	obj := objectSDK.New()
+	obj.SetID(oidtest.ID())
+	obj.SetContainerID(cidtest.ID())
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	var cs checksum.Checksum
+	cs.SetSHA256(sha256.Sum256(payload))
+	obj.SetPayloadChecksum(cs)
+
+	return obj
+}
+
+func TestPayloadDedup(t *testing.T) {
+	dir := t.TempDir()
+
+	refs := &testPayloadRefs{objs: make(map[oid.Address][sha256.Size]byte)}
+	payloads := fstree.New(fstree.WithPath(dir + "/payloads"))
+
+	b := New(
+		WithStorages(defaultStorages(dir, 1024)),
+		WithPayloadDedup(DedupPrm{
+			Storage: payloads,
+			Filter: func(obj *objectSDK.Object) bool {
+				return len(obj.Attributes()) == 0
+			},
+		}),
+		WithPayloadRefs(refs),
+	)
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { _ = b.Close() })
+
+	payload := make([]byte, MinDedupPayloadSize)
+	_, _ = rand.Read(payload)
+
+	sum := sha256.Sum256(payload)
+
+	objs := []*objectSDK.Object{
+		testObjectWithPayload(payload),
+		testObjectWithPayload(payload),
+	}
+
+	for _, obj := range objs {
+		_, err := b.Put(common.PutPrm{Object: obj})
+		require.NoError(t, err)
+
+		res, err := b.get(common.GetPrm{Address: object.AddressOf(obj)})
+		require.NoError(t, err)
+		require.Empty(t, res.Object.Payload())
+
+		actualSum, ok := DeduplicatedPayload(res.Object)
+		require.True(t, ok)
+		require.Equal(t, sum, actualSum)
+	}
+
+	n, err := refs.PayloadRefs(sum)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+
+	t.Run("get", func(t *testing.T) {
+		for _, obj := range objs {
+			res, err := b.Get(common.GetPrm{Address: object.AddressOf(obj)})
+			require.NoError(t, err)
+			require.Equal(t, payload, res.Object.Payload())
+		}
+	})
+
+	t.Run("get range", func(t *testing.T) {
+		var prm common.GetRangePrm
+		prm.Address = object.AddressOf(objs[0])
+		prm.Range.SetOffset(10)
+		prm.Range.SetLength(100)
+
+		res, err := b.GetRange(prm)
+		require.NoError(t, err)
+		require.Equal(t, payload[10:110], res.Data)
+	})
+
+	t.Run("get stream", func(t *testing.T) {
+		res, err := b.GetStream(common.GetPrm{Address: object.AddressOf(objs[1])})
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(res.Payload)
+		require.NoError(t, err)
+		require.NoError(t, res.Payload.Close())
+		require.Equal(t, payload, data)
+	})
+
+	t.Run("not selected", func(t *testing.T) {
+		obj := testObjectWithPayload(payload)
+
+		var a objectSDK.Attribute
+		a.SetKey("key")
+		a.SetValue("value")
+		obj.SetAttributes(a)
+
+		_, err := b.Put(common.PutPrm{Object: obj})
+		require.NoError(t, err)
+
+		res, err := b.get(common.GetPrm{Address: object.AddressOf(obj)})
+		require.NoError(t, err)
+		require.Equal(t, payload, res.Object.Payload())
+	})
+
+	t.Run("release", func(t *testing.T) {
+		exists := func() bool {
+			res, err := payloads.Exists(common.ExistsPrm{Address: payloadAddress(sum)})
+			require.NoError(t, err)
+			return res.Exists
+		}
+
+		refs.remove(object.AddressOf(objs[0]))
+		require.NoError(t, b.ReleasePayload(sum))
+		require.True(t, exists())
+
+		refs.remove(object.AddressOf(objs[1]))
+		require.NoError(t, b.ReleasePayload(sum))
+		require.False(t, exists())
+	})
+}
+
+func TestPayloadDedup_Rollback(t *testing.T) {
+	dir := t.TempDir()
+
+	refs := &testPayloadRefs{objs: make(map[oid.Address][sha256.Size]byte)}
+	payloads := fstree.New(fstree.WithPath(dir + "/payloads"))
+
+	storages := defaultStorages(dir, 1024)
+	for i := range storages {
+		// object headers can't be saved
+		storages[i].Policy = func(*objectSDK.Object, []byte) bool { return false }
+	}
+
+	b := New(
+		WithStorages(storages),
+		WithPayloadDedup(DedupPrm{Storage: payloads}),
+		WithPayloadRefs(refs),
+	)
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { _ = b.Close() })
+
+	payload := make([]byte, MinDedupPayloadSize)
+	_, _ = rand.Read(payload)
+
+	sum := sha256.Sum256(payload)
+
+	_, err := b.Put(common.PutPrm{Object: testObjectWithPayload(payload)})
+	require.ErrorIs(t, err, ErrNoPlaceFound)
+
+	n, err := refs.PayloadRefs(sum)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	res, err := payloads.Exists(common.ExistsPrm{Address: payloadAddress(sum)})
+	require.NoError(t, err)
+	require.False(t, res.Exists)
+}
@@ -0,0 +1,31 @@
+package blobstor
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+// Delete removes the object from b.
+// If the storage ID is present, only one sub-storage is tried.
+// Otherwise, each sub-storage is tried in order.
+//
+// Returns an error of type apistatus.ObjectNotFound if there is no object to delete.
+func (b *BlobStor) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	if prm.StorageID == nil {
+		for i := range b.storage {
+			res, err := b.storage[i].Storage.Delete(prm)
+			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+				return res, err
+			}
+		}
+
+		var errNotFound apistatus.ObjectNotFound
+		return common.DeleteRes{}, errNotFound
+	}
+	if len(prm.StorageID) == 0 {
+		return b.storage[len(b.storage)-1].Storage.Delete(prm)
+	}
+	return b.storage[0].Storage.Delete(prm)
+}
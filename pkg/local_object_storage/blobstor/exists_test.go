@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
@@ -18,9 +19,7 @@ func TestExists(t *testing.T) {
 
 	const smallSizeLimit = 512
 
-	b := New(WithRootPath(dir),
-		WithSmallSizeLimit(smallSizeLimit),
-		WithBlobovniczaShallowWidth(1)) // default width is 16, slow init
+	b := New(WithStorages(defaultStorages(dir, smallSizeLimit)))
 	require.NoError(t, b.Open(false))
 	require.NoError(t, b.Init())
 
@@ -30,25 +29,23 @@ func TestExists(t *testing.T) {
 	}
 
 	for i := range objects {
-		var prm PutPrm
-		prm.SetObject(objects[i])
-		_, err = b.Put(prm)
+		_, err = b.Put(common.PutPrm{Object: objects[i]})
 		require.NoError(t, err)
 	}
 
-	var prm ExistsPrm
+	var prm common.ExistsPrm
 	for i := range objects {
-		prm.SetAddress(objectCore.AddressOf(objects[i]))
+		prm.Address = objectCore.AddressOf(objects[i])
 
 		res, err := b.Exists(prm)
 		require.NoError(t, err)
-		require.True(t, res.Exists())
+		require.True(t, res.Exists)
 	}
 
-	prm.SetAddress(oidtest.Address())
+	prm.Address = oidtest.Address()
 	res, err := b.Exists(prm)
 	require.NoError(t, err)
-	require.False(t, res.Exists())
+	require.False(t, res.Exists)
 
 	t.Run("corrupt direcrory", func(t *testing.T) {
 		var bigDir string
@@ -63,16 +60,16 @@ func TestExists(t *testing.T) {
 		require.NotEmpty(t, bigDir)
 
 		require.NoError(t, os.Chmod(dir, 0))
-		t.Cleanup(func() { require.NoError(t, os.Chmod(dir, b.fsTree.Permissions)) })
+		t.Cleanup(func() { require.NoError(t, os.Chmod(dir, 0700)) })
 
 		// Object exists, first error is logged.
-		prm.SetAddress(objectCore.AddressOf(objects[0]))
+		prm.Address = objectCore.AddressOf(objects[0])
 		res, err := b.Exists(prm)
 		require.NoError(t, err)
-		require.True(t, res.Exists())
+		require.True(t, res.Exists)
 
 		// Object doesn't exist, first error is returned.
-		prm.SetAddress(objectCore.AddressOf(objects[1]))
+		prm.Address = objectCore.AddressOf(objects[1])
 		_, err = b.Exists(prm)
 		require.Error(t, err)
 	})
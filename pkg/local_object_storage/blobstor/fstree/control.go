@@ -0,0 +1,26 @@
+package fstree
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+)
+
+// Open implements common.Storage.
+func (t *FSTree) Open(ro bool) error {
+	t.readOnly = ro
+	return nil
+}
+
+// Init implements common.Storage.
+func (t *FSTree) Init() error {
+	return util.MkdirAllX(t.RootPath, t.Permissions)
+}
+
+// SetMode implements common.Storage.
+func (t *FSTree) SetMode(m mode.Mode) error {
+	t.readOnly = m.ReadOnly()
+	return nil
+}
+
+// Close implements common.Storage.
+func (*FSTree) Close() error { return nil }
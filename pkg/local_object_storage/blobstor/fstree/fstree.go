@@ -2,15 +2,21 @@ package fstree
 
 import (
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
@@ -18,8 +24,11 @@ import (
 type FSTree struct {
 	Info
 
+	*compression.Config
 	Depth      int
 	DirNameLen int
+
+	readOnly bool
 }
 
 // Info groups the information about file storage.
@@ -38,8 +47,25 @@ const (
 	MaxDepth = (sha256.Size - 1) / DirNameLen
 )
 
-// ErrFileNotFound is returned when file is missing.
-var ErrFileNotFound = errors.New("file not found")
+var _ common.Storage = (*FSTree)(nil)
+
+// New creates, initializes and returns new FSTree instance.
+func New(opts ...Option) *FSTree {
+	f := &FSTree{
+		Info: Info{
+			Permissions: 0700,
+			RootPath:    "./",
+		},
+		Config:     &compression.Config{},
+		Depth:      4,
+		DirNameLen: hex.EncodedLen(DirNameLen),
+	}
+	for i := range opts {
+		opts[i](f)
+	}
+
+	return f
+}
 
 func stringifyAddress(addr oid.Address) string {
 	return addr.Object().EncodeToString() + "." + addr.Container().EncodeToString()
@@ -68,46 +94,16 @@ func addressFromString(s string) (*oid.Address, error) {
 	return &addr, nil
 }
 
-// IterationPrm contains iteraction parameters.
-type IterationPrm struct {
-	handler      func(addr oid.Address, data []byte) error
-	ignoreErrors bool
-	errorHandler func(oid.Address, error) error
-	lazyHandler  func(oid.Address, func() ([]byte, error)) error
-}
-
-// WithHandler sets a function to call on each object.
-func (p *IterationPrm) WithHandler(f func(addr oid.Address, data []byte) error) {
-	p.handler = f
-}
-
-// WithLazyHandler sets a function to call on each object.
-// Second callback parameter opens file and reads all data to a buffer.
-// File is not opened at all unless this callback is invoked.
-func (p *IterationPrm) WithLazyHandler(f func(oid.Address, func() ([]byte, error)) error) {
-	p.lazyHandler = f
-}
-
-// WithIgnoreErrors sets a flag indicating whether errors should be ignored.
-func (p *IterationPrm) WithIgnoreErrors(ignore bool) {
-	p.ignoreErrors = ignore
-}
-
-// WithErrorHandler sets error handler for objects that cannot be read or unmarshaled.
-func (p *IterationPrm) WithErrorHandler(f func(oid.Address, error) error) {
-	p.errorHandler = f
-}
-
 // Iterate iterates over all stored objects.
-func (t *FSTree) Iterate(prm IterationPrm) error {
-	return t.iterate(0, []string{t.RootPath}, prm)
+func (t *FSTree) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
+	return common.IterateRes{}, t.iterate(0, []string{t.RootPath}, prm)
 }
 
-func (t *FSTree) iterate(depth int, curPath []string, prm IterationPrm) error {
+func (t *FSTree) iterate(depth int, curPath []string, prm common.IteratePrm) error {
 	curName := strings.Join(curPath[1:], "")
 	des, err := os.ReadDir(filepath.Join(curPath...))
 	if err != nil {
-		if prm.ignoreErrors {
+		if prm.IgnoreErrors {
 			return nil
 		}
 		return err
@@ -138,23 +134,37 @@ func (t *FSTree) iterate(depth int, curPath []string, prm IterationPrm) error {
 			continue
 		}
 
-		if prm.lazyHandler != nil {
-			err = prm.lazyHandler(*addr, func() ([]byte, error) {
-				return os.ReadFile(filepath.Join(curPath...))
+		if prm.LazyHandler != nil {
+			err = prm.LazyHandler(*addr, func() ([]byte, error) {
+				data, err := os.ReadFile(filepath.Join(curPath...))
+				if err != nil {
+					return nil, err
+				}
+				return t.Decompress(data)
 			})
 		} else {
 			var data []byte
 			data, err = os.ReadFile(filepath.Join(curPath...))
+			if err == nil {
+				data, err = t.Decompress(data)
+			}
 			if err != nil {
-				if prm.ignoreErrors {
-					if prm.errorHandler != nil {
-						return prm.errorHandler(*addr, err)
+				if prm.IgnoreErrors {
+					if prm.ErrorHandler != nil {
+						if err := prm.ErrorHandler(*addr, err); err != nil {
+							return err
+						}
 					}
 					continue
 				}
 				return err
 			}
-			err = prm.handler(*addr, data)
+
+			err = prm.Handler(common.IterationElement{
+				Address:    *addr,
+				ObjectData: data,
+				StorageID:  []byte{},
+			})
 		}
 
 		if err != nil {
@@ -182,65 +192,147 @@ func (t *FSTree) treePath(addr oid.Address) string {
 }
 
 // Delete removes the object with the specified address from the storage.
-func (t *FSTree) Delete(addr oid.Address) error {
-	p, err := t.Exists(addr)
-	if err != nil {
-		return err
+func (t *FSTree) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	if t.readOnly {
+		return common.DeleteRes{}, common.ErrReadOnly
 	}
 
-	return os.Remove(p)
+	err := os.Remove(t.treePath(prm.Address))
+	if os.IsNotExist(err) {
+		var errNotFound apistatus.ObjectNotFound
+		err = errNotFound
+	}
+	return common.DeleteRes{}, err
 }
 
-// Exists returns the path to the file with object contents if it exists in the storage
-// and an error otherwise.
-func (t *FSTree) Exists(addr oid.Address) (string, error) {
-	p := t.treePath(addr)
-
-	_, err := os.Stat(p)
+// Exists checks whether the object with the specified address is present in the storage.
+func (t *FSTree) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
+	_, err := os.Stat(t.treePath(prm.Address))
+	found := err == nil
 	if os.IsNotExist(err) {
-		err = ErrFileNotFound
+		err = nil
 	}
-
-	return p, err
+	return common.ExistsRes{Exists: found}, err
 }
 
 // Put puts an object in the storage.
-func (t *FSTree) Put(addr oid.Address, data []byte) error {
-	p := t.treePath(addr)
+func (t *FSTree) Put(prm common.PutPrm) (common.PutRes, error) {
+	if t.readOnly {
+		return common.PutRes{}, common.ErrReadOnly
+	}
+
+	p := t.treePath(prm.Address)
 
 	if err := util.MkdirAllX(filepath.Dir(p), t.Permissions); err != nil {
-		return err
+		return common.PutRes{}, err
+	}
+	if !prm.DontCompress {
+		prm.RawData = t.CompressWith(prm.Codec, prm.RawData)
 	}
 
-	return os.WriteFile(p, data, t.Permissions)
+	return common.PutRes{StorageID: []byte{}}, os.WriteFile(p, prm.RawData, t.Permissions)
 }
 
-// PutStream puts executes handler on a file opened for write.
-func (t *FSTree) PutStream(addr oid.Address, handler func(*os.File) error) error {
-	p := t.treePath(addr)
+// Get returns an object from the storage by address.
+func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
+	p := t.treePath(prm.Address)
 
-	if err := util.MkdirAllX(filepath.Dir(p), t.Permissions); err != nil {
-		return err
+	if _, err := os.Stat(p); os.IsNotExist(err) {
+		var errNotFound apistatus.ObjectNotFound
+		return common.GetRes{}, errNotFound
 	}
 
-	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, t.Permissions)
+	data, err := os.ReadFile(p)
 	if err != nil {
-		return err
+		return common.GetRes{}, err
+	}
+
+	data, err = t.Decompress(data)
+	if err != nil {
+		return common.GetRes{}, fmt.Errorf("could not decompress object data: %w", err)
 	}
-	defer f.Close()
 
-	return handler(f)
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return common.GetRes{}, fmt.Errorf("could not unmarshal the object: %w", err)
+	}
+
+	return common.GetRes{Object: obj, RawData: data}, nil
 }
 
-// Get returns an object from the storage by address.
-func (t *FSTree) Get(addr oid.Address) ([]byte, error) {
-	p := t.treePath(addr)
+// GetStream implements common.Streamer.
+func (t *FSTree) GetStream(prm common.GetPrm) (common.GetStreamRes, error) {
+	f, err := os.Open(t.treePath(prm.Address))
+	if err != nil {
+		if os.IsNotExist(err) {
+			var errNotFound apistatus.ObjectNotFound
+			return common.GetStreamRes{}, errNotFound
+		}
 
-	if _, err := os.Stat(p); os.IsNotExist(err) {
-		return nil, ErrFileNotFound
+		return common.GetStreamRes{}, err
+	}
+
+	r, err := t.DecompressReader(f)
+	if err != nil {
+		_ = f.Close()
+		return common.GetStreamRes{}, fmt.Errorf("could not decompress object data: %w", err)
+	}
+
+	hdr, payload, err := common.ReadObjectStream(r)
+	if err != nil {
+		_ = r.Close()
+		_ = f.Close()
+		return common.GetStreamRes{}, err
+	}
+
+	return common.GetStreamRes{
+		Header: hdr,
+		Payload: payloadReader{
+			Reader:  payload,
+			closers: []io.Closer{r, f},
+		},
+	}, nil
+}
+
+// payloadReader reads the payload from the object file.
+type payloadReader struct {
+	io.Reader
+
+	closers []io.Closer
+}
+
+func (r payloadReader) Close() error {
+	var firstErr error
+
+	for i := range r.closers {
+		if err := r.closers[i].Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
 	}
 
-	return os.ReadFile(p)
+	return firstErr
+}
+
+// GetRange implements common.Storage.
+func (t *FSTree) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	res, err := t.Get(common.GetPrm{Address: prm.Address})
+	if err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	payload := res.Object.Payload()
+	from := prm.Range.GetOffset()
+	to := from + prm.Range.GetLength()
+
+	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+		var errOutOfRange apistatus.ObjectOutOfRange
+
+		return common.GetRangeRes{}, errOutOfRange
+	}
+
+	return common.GetRangeRes{
+		Data: payload[from:to],
+	}, nil
 }
 
 // NumberOfObjects walks the file tree rooted at FSTree's root
@@ -265,3 +357,21 @@ func (t *FSTree) NumberOfObjects() (uint64, error) {
 
 	return counter, nil
 }
+
+// Type is fstree storage type used in logs and configuration.
+const Type = "fstree"
+
+// Type implements common.Storage.
+func (*FSTree) Type() string {
+	return Type
+}
+
+// Path implements common.Storage.
+func (t *FSTree) Path() string {
+	return t.RootPath
+}
+
+// SetCompressor implements common.Storage.
+func (t *FSTree) SetCompressor(cc *compression.Config) {
+	t.Config = cc
+}
@@ -1,15 +1,20 @@
 package fstree
 
 import (
-	"crypto/rand"
+	"bytes"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,14 +31,13 @@ func TestFSTree(t *testing.T) {
 	require.NoError(t, os.Mkdir(tmpDir, os.ModePerm))
 	t.Cleanup(func() { require.NoError(t, os.RemoveAll(tmpDir)) })
 
-	fs := FSTree{
-		Info: Info{
-			Permissions: os.ModePerm,
-			RootPath:    tmpDir,
-		},
-		Depth:      2,
-		DirNameLen: 2,
-	}
+	fs := New(
+		WithPath(tmpDir),
+		WithPerm(os.ModePerm),
+		WithDepth(2),
+		WithDirNameLen(2))
+	require.NoError(t, fs.Open(false))
+	require.NoError(t, fs.Init())
 
 	const count = 3
 	var addrs []oid.Address
@@ -41,48 +45,54 @@ func TestFSTree(t *testing.T) {
 	store := map[string][]byte{}
 
 	for i := 0; i < count; i++ {
+		obj := objecttest.Object()
 		a := oidtest.Address()
 		addrs = append(addrs, a)
 
-		data := make([]byte, 10)
-		_, _ = rand.Read(data[:])
-		require.NoError(t, fs.Put(a, data))
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		_, err = fs.Put(common.PutPrm{Address: a, RawData: data})
+		require.NoError(t, err)
 		store[a.EncodeToString()] = data
 	}
 
 	t.Run("get", func(t *testing.T) {
 		for _, a := range addrs {
-			actual, err := fs.Get(a)
+			res, err := fs.Get(common.GetPrm{Address: a})
 			require.NoError(t, err)
-			require.Equal(t, store[a.EncodeToString()], actual)
+			require.Equal(t, store[a.EncodeToString()], res.RawData)
 		}
 
-		_, err := fs.Get(oidtest.Address())
-		require.Error(t, err)
+		_, err := fs.Get(common.GetPrm{Address: oidtest.Address()})
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 
 	t.Run("exists", func(t *testing.T) {
 		for _, a := range addrs {
-			_, err := fs.Exists(a)
+			res, err := fs.Exists(common.ExistsPrm{Address: a})
 			require.NoError(t, err)
+			require.True(t, res.Exists)
 		}
 
-		_, err := fs.Exists(oidtest.Address())
-		require.Error(t, err)
+		res, err := fs.Exists(common.ExistsPrm{Address: oidtest.Address()})
+		require.NoError(t, err)
+		require.False(t, res.Exists)
 	})
 
 	t.Run("iterate", func(t *testing.T) {
 		n := 0
-		var iterationPrm IterationPrm
-		iterationPrm.WithHandler(func(addr oid.Address, data []byte) error {
+		var iterationPrm common.IteratePrm
+		iterationPrm.Handler = func(elem common.IterationElement) error {
 			n++
-			expected, ok := store[addr.EncodeToString()]
-			require.True(t, ok, "object %s was not found", addr.EncodeToString())
-			require.Equal(t, data, expected)
+			addr := elem.Address.EncodeToString()
+			expected, ok := store[addr]
+			require.True(t, ok, "object %s was not found", addr)
+			require.Equal(t, elem.ObjectData, expected)
 			return nil
-		})
+		}
 
-		err := fs.Iterate(iterationPrm)
+		_, err := fs.Iterate(iterationPrm)
 
 		require.NoError(t, err)
 		require.Equal(t, count, n)
@@ -91,14 +101,14 @@ func TestFSTree(t *testing.T) {
 			n := 0
 			errStop := errors.New("stop")
 
-			iterationPrm.WithHandler(func(addr oid.Address, data []byte) error {
+			iterationPrm.Handler = func(_ common.IterationElement) error {
 				if n++; n == count-1 {
 					return errStop
 				}
 				return nil
-			})
+			}
 
-			err := fs.Iterate(iterationPrm)
+			_, err := fs.Iterate(iterationPrm)
 
 			require.ErrorIs(t, err, errStop)
 			require.Equal(t, count-1, n)
@@ -120,13 +130,13 @@ func TestFSTree(t *testing.T) {
 			require.NoError(t, util.MkdirAllX(filepath.Dir(p), fs.Permissions))
 			require.NoError(t, os.WriteFile(p, []byte{1, 2, 3}, fs.Permissions))
 
-			iterationPrm.WithIgnoreErrors(true)
-			iterationPrm.WithHandler(func(addr oid.Address, data []byte) error {
+			iterationPrm.IgnoreErrors = true
+			iterationPrm.Handler = func(_ common.IterationElement) error {
 				n++
 				return nil
-			})
+			}
 
-			err := fs.Iterate(iterationPrm)
+			_, err := fs.Iterate(iterationPrm)
 			require.NoError(t, err)
 			require.Equal(t, count, n)
 
@@ -134,15 +144,15 @@ func TestFSTree(t *testing.T) {
 				expectedErr := errors.New("expected error")
 				n := 0
 
-				iterationPrm.WithHandler(func(addr oid.Address, data []byte) error {
+				iterationPrm.Handler = func(_ common.IterationElement) error {
 					n++
 					if n == count/2 { // process some iterations
 						return expectedErr
 					}
 					return nil
-				})
+				}
 
-				err := fs.Iterate(iterationPrm)
+				_, err := fs.Iterate(iterationPrm)
 				require.ErrorIs(t, err, expectedErr)
 				require.Equal(t, count/2, n)
 			})
@@ -150,14 +160,62 @@ func TestFSTree(t *testing.T) {
 	})
 
 	t.Run("delete", func(t *testing.T) {
-		require.NoError(t, fs.Delete(addrs[0]))
+		_, err := fs.Delete(common.DeletePrm{Address: addrs[0]})
+		require.NoError(t, err)
 
-		_, err := fs.Exists(addrs[0])
-		require.Error(t, err)
+		res, err := fs.Exists(common.ExistsPrm{Address: addrs[0]})
+		require.NoError(t, err)
+		require.False(t, res.Exists)
 
-		_, err = fs.Exists(addrs[1])
+		res, err = fs.Exists(common.ExistsPrm{Address: addrs[1]})
 		require.NoError(t, err)
+		require.True(t, res.Exists)
 
-		require.Error(t, fs.Delete(oidtest.Address()))
+		_, err = fs.Delete(common.DeletePrm{Address: oidtest.Address()})
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 	})
 }
+
+func TestFSTree_GetStream(t *testing.T) {
+	for _, cc := range []*compression.Config{
+		{},
+		{Enabled: true, Codec: compression.TypeZstd},
+		{Enabled: true, Codec: compression.TypeS2},
+	} {
+		t.Run(string(cc.Codec), func(t *testing.T) {
+			require.NoError(t, cc.Init())
+			t.Cleanup(func() { require.NoError(t, cc.Close()) })
+
+			fs := New(
+				WithPath(t.TempDir()),
+				WithDepth(2),
+				WithDirNameLen(2))
+			fs.SetCompressor(cc)
+			require.NoError(t, fs.Open(false))
+			require.NoError(t, fs.Init())
+
+			obj := objecttest.Object()
+			obj.SetPayload(bytes.Repeat([]byte{1, 2, 3}, 100_000))
+
+			a := oidtest.Address()
+
+			data, err := obj.Marshal()
+			require.NoError(t, err)
+
+			_, err = fs.Put(common.PutPrm{Address: a, RawData: data})
+			require.NoError(t, err)
+
+			res, err := fs.GetStream(common.GetPrm{Address: a})
+			require.NoError(t, err)
+			require.Equal(t, obj.CutPayload(), res.Header)
+
+			payload, err := io.ReadAll(res.Payload)
+			require.NoError(t, err)
+			require.NoError(t, res.Payload.Close())
+			require.Equal(t, obj.Payload(), payload)
+
+			_, err = fs.GetStream(common.GetPrm{Address: oidtest.Address()})
+			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		})
+	}
+}
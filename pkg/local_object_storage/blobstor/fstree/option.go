@@ -0,0 +1,38 @@
+package fstree
+
+import (
+	"io/fs"
+)
+
+// Option represents FSTree's constructor option.
+type Option func(*FSTree)
+
+// WithDepth returns option to set the depth
+// of the object file subdirectory tree.
+func WithDepth(d int) Option {
+	return func(f *FSTree) {
+		f.Depth = d
+	}
+}
+
+// WithDirNameLen returns option to set the length
+// of subdirectory names.
+func WithDirNameLen(l int) Option {
+	return func(f *FSTree) {
+		f.DirNameLen = l
+	}
+}
+
+// WithPerm returns option to set permission bits of the tree.
+func WithPerm(p fs.FileMode) Option {
+	return func(f *FSTree) {
+		f.Permissions = p
+	}
+}
+
+// WithPath returns option to set the path to the root directory.
+func WithPath(p string) Option {
+	return func(f *FSTree) {
+		f.RootPath = p
+	}
+}
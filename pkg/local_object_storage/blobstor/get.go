@@ -0,0 +1,45 @@
+package blobstor
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+// Get reads the object from b.
+// If the storage ID is present, only one sub-storage is tried.
+// Otherwise, each sub-storage is tried in order.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object
+// is missing in all sub-storages.
+func (b *BlobStor) Get(prm common.GetPrm) (common.GetRes, error) {
+	res, err := b.get(prm)
+	if err != nil {
+		return res, err
+	}
+
+	if err := b.restorePayload(res.Object); err != nil {
+		return common.GetRes{}, err
+	}
+
+	return res, nil
+}
+
+func (b *BlobStor) get(prm common.GetPrm) (common.GetRes, error) {
+	if prm.StorageID == nil {
+		for i := range b.storage {
+			res, err := b.storage[i].Storage.Get(prm)
+			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+				return res, err
+			}
+		}
+
+		var errNotFound apistatus.ObjectNotFound
+		return common.GetRes{}, errNotFound
+	}
+	if len(prm.StorageID) == 0 {
+		return b.storage[len(b.storage)-1].Storage.Get(prm)
+	}
+	return b.storage[0].Storage.Get(prm)
+}
@@ -0,0 +1,46 @@
+package blobstor
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+// GetRange reads object payload data from b.
+// If the storage ID is present, only one sub-storage is tried.
+// Otherwise, each sub-storage is tried in order.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object
+// is missing in all sub-storages.
+// Returns an error of type apistatus.ObjectOutOfRange if the requested range
+// is out of the object payload bounds.
+func (b *BlobStor) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	res, err := b.getRange(prm)
+	if err != nil && b.dedup != nil && errors.As(err, new(apistatus.ObjectOutOfRange)) {
+		// payload of the object may be deduplicated
+		if dedupRes, ok, dedupErr := b.getPayloadRange(prm); ok {
+			return dedupRes, dedupErr
+		}
+	}
+
+	return res, err
+}
+
+func (b *BlobStor) getRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	if prm.StorageID == nil {
+		for i := range b.storage {
+			res, err := b.storage[i].Storage.GetRange(prm)
+			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+				return res, err
+			}
+		}
+
+		var errNotFound apistatus.ObjectNotFound
+		return common.GetRangeRes{}, errNotFound
+	}
+	if len(prm.StorageID) == 0 {
+		return b.storage[len(b.storage)-1].Storage.GetRange(prm)
+	}
+	return b.storage[0].Storage.GetRange(prm)
+}
@@ -0,0 +1,57 @@
+package blobstor
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+// GetStream reads the object header from b and returns the reader of the
+// object payload which must be closed by the caller. Payload is read on
+// the fly from the sub-storages implementing common.Streamer, the other
+// sub-storages read the whole object.
+// If the storage ID is present, only one sub-storage is tried.
+// Otherwise, each sub-storage is tried in order.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object
+// is missing in all sub-storages.
+func (b *BlobStor) GetStream(prm common.GetPrm) (common.GetStreamRes, error) {
+	res, err := b.getObjectStream(prm)
+	if err != nil {
+		return res, err
+	}
+
+	return b.streamPayload(res)
+}
+
+func (b *BlobStor) getObjectStream(prm common.GetPrm) (common.GetStreamRes, error) {
+	if prm.StorageID == nil {
+		for i := range b.storage {
+			res, err := getStream(b.storage[i].Storage, prm)
+			if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+				return res, err
+			}
+		}
+
+		var errNotFound apistatus.ObjectNotFound
+		return common.GetStreamRes{}, errNotFound
+	}
+	if len(prm.StorageID) == 0 {
+		return getStream(b.storage[len(b.storage)-1].Storage, prm)
+	}
+	return getStream(b.storage[0].Storage, prm)
+}
+
+func getStream(s common.Storage, prm common.GetPrm) (common.GetStreamRes, error) {
+	if streamer, ok := s.(common.Streamer); ok {
+		return streamer.GetStream(prm)
+	}
+
+	res, err := s.Get(prm)
+	if err != nil {
+		return common.GetStreamRes{}, err
+	}
+
+	return common.StreamObject(res.Object), nil
+}
@@ -1,8 +1,14 @@
 package blobstor
 
-import "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
-
 // DumpInfo returns information about blob stor.
-func (b *BlobStor) DumpInfo() fstree.Info {
-	return b.fsTree.Info
+func (b *BlobStor) DumpInfo() Info {
+	sub := make([]SubStorageInfo, len(b.storage))
+	for i := range b.storage {
+		sub[i].Path = b.storage[i].Storage.Path()
+		sub[i].Type = b.storage[i].Storage.Type()
+	}
+
+	return Info{
+		SubStorages: sub,
+	}
 }
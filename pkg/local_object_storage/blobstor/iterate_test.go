@@ -6,11 +6,11 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
-	"strconv"
 	"testing"
 
 	"github.com/klauspost/compress/zstd"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
@@ -24,11 +24,9 @@ func TestIterateObjects(t *testing.T) {
 
 	// create BlobStor instance
 	blobStor := New(
+		WithStorages(defaultStorages(p, smalSz,
+			blobovniczatree.WithBlobovniczaShallowDepth(1))),
 		WithCompressObjects(true),
-		WithRootPath(p),
-		WithSmallSizeLimit(smalSz),
-		WithBlobovniczaShallowWidth(1),
-		WithBlobovniczaShallowDepth(1),
 	)
 
 	defer os.RemoveAll(p)
@@ -72,20 +70,20 @@ func TestIterateObjects(t *testing.T) {
 	}
 
 	for _, v := range mObjs {
-		_, err := blobStor.PutRaw(v.addr, v.data, true)
+		_, err := blobStor.Put(common.PutPrm{Address: v.addr, RawData: v.data})
 		require.NoError(t, err)
 	}
 
-	err := IterateBinaryObjects(blobStor, func(_ oid.Address, data []byte, blzID *blobovnicza.ID) error {
+	err := IterateBinaryObjects(blobStor, func(_ oid.Address, data []byte, storageID []byte) error {
 		v, ok := mObjs[string(data)]
 		require.True(t, ok)
 
 		require.Equal(t, v.data, data)
 
 		if v.big {
-			require.Nil(t, blzID)
+			require.Empty(t, storageID)
 		} else {
-			require.NotNil(t, blzID)
+			require.NotEmpty(t, storageID)
 		}
 
 		delete(mObjs, string(data))
@@ -103,14 +101,13 @@ func TestIterate_IgnoreErrors(t *testing.T) {
 		smallSize = 512
 		objCount  = 5
 	)
-	bsOpts := []Option{
-		WithCompressObjects(true),
-		WithRootPath(dir),
-		WithSmallSizeLimit(smallSize * 2), // + header
-		WithBlobovniczaOpenedCacheSize(1),
-		WithBlobovniczaShallowWidth(1),
-		WithBlobovniczaShallowDepth(1)}
-	bs := New(bsOpts...)
+
+	bs := New(
+		WithStorages(defaultStorages(dir, smallSize*2, // + header
+			blobovniczatree.WithOpenedCacheSize(1),
+			blobovniczatree.WithBlobovniczaShallowWidth(2),
+			blobovniczatree.WithBlobovniczaShallowDepth(1))),
+		WithCompressObjects(true))
 	require.NoError(t, bs.Open(false))
 	require.NoError(t, bs.Init())
 
@@ -126,7 +123,7 @@ func TestIterate_IgnoreErrors(t *testing.T) {
 		objData, err := obj.Marshal()
 		require.NoError(t, err)
 
-		_, err = bs.PutRaw(addrs[i], objData, true)
+		_, err = bs.Put(common.PutPrm{Address: addrs[i], RawData: objData, DontCompress: true})
 		require.NoError(t, err)
 	}
 
@@ -140,46 +137,33 @@ func TestIterate_IgnoreErrors(t *testing.T) {
 		rawData[i] ^= 0xFF
 	}
 	// Will be put uncompressed but fetched as compressed because of magic.
-	_, err = bs.PutRaw(oidtest.Address(), rawData, false)
+	_, err = bs.Put(common.PutPrm{Address: oidtest.Address(), RawData: rawData, DontCompress: true})
+	require.NoError(t, err)
+	_, err = bs.storage[1].Storage.Put(common.PutPrm{Address: oidtest.Address(), RawData: rawData, DontCompress: true})
 	require.NoError(t, err)
-	require.NoError(t, bs.fsTree.Put(oidtest.Address(), rawData))
 
 	require.NoError(t, bs.Close())
 
-	// Increase width to have blobovnicza which is definitely empty.
-	b := New(append(bsOpts, WithBlobovniczaShallowWidth(2))...)
-	require.NoError(t, b.Open(false))
-	require.NoError(t, b.Init())
-
-	var p string
-	for i := 0; i < 2; i++ {
-		bp := filepath.Join(bs.blzRootPath, "1", strconv.FormatUint(uint64(i), 10))
-		if _, ok := bs.blobovniczas.opened.Get(bp); !ok {
-			p = bp
-			break
-		}
-	}
-	require.NotEqual(t, "", p, "expected to not have at least 1 blobovnicza in cache")
-	require.NoError(t, os.Chmod(p, 0))
+	// Blobovniczas with index 1 are never active, so they are definitely empty.
+	require.NoError(t, os.Chmod(filepath.Join(dir, blobovniczaDir, "1", "1"), 0))
 
-	require.NoError(t, b.Close())
 	require.NoError(t, bs.Open(false))
-	require.NoError(t, bs.Init())
 
-	var prm IteratePrm
-	prm.SetIterationHandler(func(e IterationElement) error {
-		return nil
-	})
+	prm := common.IteratePrm{
+		Handler: func(e common.IterationElement) error {
+			return nil
+		},
+	}
 	_, err = bs.Iterate(prm)
 	require.Error(t, err)
 
-	prm.IgnoreErrors()
+	prm.IgnoreErrors = true
 
 	t.Run("skip invalid objects", func(t *testing.T) {
 		actual := make([]oid.Address, 0, len(addrs))
-		prm.SetIterationHandler(func(e IterationElement) error {
+		prm.Handler = func(e common.IterationElement) error {
 			obj := object.New()
-			err := obj.Unmarshal(e.data)
+			err := obj.Unmarshal(e.ObjectData)
 			if err != nil {
 				return err
 			}
@@ -191,7 +175,7 @@ func TestIterate_IgnoreErrors(t *testing.T) {
 			addr.SetObject(id)
 			actual = append(actual, addr)
 			return nil
-		})
+		}
 
 		_, err := bs.Iterate(prm)
 		require.NoError(t, err)
@@ -200,12 +184,12 @@ func TestIterate_IgnoreErrors(t *testing.T) {
 	t.Run("return errors from handler", func(t *testing.T) {
 		n := 0
 		expectedErr := errors.New("expected error")
-		prm.SetIterationHandler(func(e IterationElement) error {
+		prm.Handler = func(e common.IterationElement) error {
 			if n++; n == objCount/2 {
 				return expectedErr
 			}
 			return nil
-		})
+		}
 		_, err := bs.Iterate(prm)
 		require.ErrorIs(t, err, expectedErr)
 	})
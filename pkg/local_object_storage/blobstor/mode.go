@@ -19,17 +19,20 @@ func (b *BlobStor) SetMode(m mode.Mode) error {
 		return nil
 	}
 
-	err := b.Close()
-	if err == nil {
-		if err = b.Open(m.ReadOnly()); err == nil {
-			err = b.Init()
+	for i := range b.storage {
+		err := b.storage[i].Storage.SetMode(m)
+		if err != nil {
+			return fmt.Errorf("can't set blobstor mode (old=%s, new=%s): %w", b.mode, m, err)
 		}
 	}
-	if err != nil {
-		return fmt.Errorf("can't set blobstor mode (old=%s, new=%s): %w", b.mode, m, err)
+
+	if b.dedup != nil {
+		err := b.dedup.Storage.SetMode(m)
+		if err != nil {
+			return fmt.Errorf("can't set payload storage mode (old=%s, new=%s): %w", b.mode, m, err)
+		}
 	}
 
-	b.blobovniczas.readOnly = m.ReadOnly()
 	b.mode = m
 	return nil
 }
@@ -1,120 +1,92 @@
 package blobstor
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/klauspost/compress/zstd"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
-	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
-	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
-// PutPrm groups the parameters of Put operation.
-type PutPrm struct {
-	rwObject
-}
-
-// PutRes groups the resulting values of Put operation.
-type PutRes struct {
-	roBlobovniczaID
-}
+// ErrNoPlaceFound is returned when object can't be saved to any sub-storage component
+// because of the policy.
+var ErrNoPlaceFound = errors.New("couldn't find a place to store an object")
 
 // Put saves the object in BLOB storage.
 //
-// If object is "big", BlobStor saves the object in shallow dir.
-// Otherwise, BlobStor saves the object in blobonicza. In this
-// case the identifier of blobovnicza is returned.
+// The object is saved in the first sub-storage whose policy
+// accepts it. Sub-storage specific identifier of the object
+// location (e.g. blobovnicza ID) is returned.
+//
+// If the object is provided, it is compressed according to the
+// compression settings, raw data is compressed with the specified
+// codec unless DontCompress is set.
+//
+// If payload deduplication is enabled, the payload of the object is saved
+// in the payload storage once and the object is saved without payload.
 //
 // Returns any error encountered that
 // did not allow to completely save the object.
-func (b *BlobStor) Put(prm PutPrm) (PutRes, error) {
-	// marshal object
-	data, err := prm.obj.Marshal()
-	if err != nil {
-		return PutRes{}, fmt.Errorf("could not marshal the object: %w", err)
-	}
-
-	return b.PutRaw(object.AddressOf(prm.obj), data, b.NeedsCompression(prm.obj))
-}
-
-// NeedsCompression returns true if the object should be compressed.
-// For an object to be compressed 2 conditions must hold:
-// 1. Compression is enabled in settings.
-// 2. Object MIME Content-Type is allowed for compression.
-func (b *BlobStor) NeedsCompression(obj *objectSDK.Object) bool {
-	if !b.compressionEnabled || len(b.uncompressableContentTypes) == 0 {
-		return b.compressionEnabled
-	}
-
-	for _, attr := range obj.Attributes() {
-		if attr.Key() == objectSDK.AttributeContentType {
-			for _, value := range b.uncompressableContentTypes {
-				match := false
-				switch {
-				case len(value) > 0 && value[len(value)-1] == '*':
-					match = strings.HasPrefix(attr.Value(), value[:len(value)-1])
-				case len(value) > 0 && value[0] == '*':
-					match = strings.HasSuffix(attr.Value(), value[1:])
-				default:
-					match = attr.Value() == value
-				}
-				if match {
-					return false
-				}
-			}
+// Returns ErrNoPlaceFound if no sub-storage accepts the object.
+func (b *BlobStor) Put(prm common.PutPrm) (_ common.PutRes, resErr error) {
+	if prm.Object != nil {
+		prm.Address = object.AddressOf(prm.Object)
+		if !prm.DontCompress && prm.Codec == "" {
+			prm.Codec = b.compression.SelectCodec(prm.Object)
+			prm.DontCompress = prm.Codec == compression.TypeNone
 		}
 	}
-
-	return b.compressionEnabled
-}
-
-// PutRaw saves an already marshaled object in BLOB storage.
-func (b *BlobStor) PutRaw(addr oid.Address, data []byte, compress bool) (PutRes, error) {
-	big := b.isBig(data)
-
-	if big {
-		var err error
-		if compress {
-			err = b.fsTree.PutStream(addr, func(f *os.File) error {
-				enc, _ := zstd.NewWriter(f) // nil error if no options are provided
-				if _, err := enc.Write(data); err != nil {
-					return err
+	if b.dedup != nil && b.dedup.refs != nil {
+		var (
+			referenced bool
+			err        error
+		)
+
+		prm, referenced, err = b.putDeduplicated(prm)
+		if referenced {
+			defer func() {
+				if resErr != nil {
+					b.dropPayloadRef(prm.Address)
 				}
-				return enc.Close()
-			})
-		} else {
-			err = b.fsTree.Put(addr, data)
+			}()
 		}
+
 		if err != nil {
-			return PutRes{}, err
+			return common.PutRes{}, err
 		}
-
-		storagelog.Write(b.log, storagelog.AddressField(addr), storagelog.OpField("fstree PUT"))
-
-		return PutRes{}, nil
 	}
 
-	if compress {
-		data = b.compressor(data)
+	if prm.RawData == nil {
+		// marshal object
+		data, err := prm.Object.Marshal()
+		if err != nil {
+			return common.PutRes{}, fmt.Errorf("could not marshal the object: %w", err)
+		}
+		prm.RawData = data
 	}
 
-	// save object in blobovnicza
-	res, err := b.blobovniczas.put(addr, data)
-	if err != nil {
-		return PutRes{}, err
+	for i := range b.storage {
+		if b.storage[i].Policy == nil || b.storage[i].Policy(prm.Object, prm.RawData) {
+			return b.storage[i].Storage.Put(prm)
+		}
 	}
 
-	return PutRes{
-		roBlobovniczaID: roBlobovniczaID{
-			blobovniczaID: res,
-		},
-	}, nil
+	return common.PutRes{}, ErrNoPlaceFound
+}
+
+// NeedsCompression returns true if the object should be compressed.
+// For an object to be compressed 2 conditions must hold:
+// 1. Compression is enabled in settings.
+// 2. Codec selected for the object is not compression.TypeNone.
+func (b *BlobStor) NeedsCompression(obj *objectSDK.Object) bool {
+	return b.compression.NeedsCompression(obj)
 }
 
-// checks if object is "big".
-func (b *BlobStor) isBig(data []byte) bool {
-	return uint64(len(data)) > b.smallSizeLimit
+// CompressionCodec returns the type of the codec the object
+// should be compressed with. compression.TypeNone is returned if
+// the object should not be compressed.
+func (b *BlobStor) CompressionCodec(obj *objectSDK.Object) compression.Type {
+	return b.compression.SelectCodec(obj)
 }
@@ -67,6 +67,8 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 	}
 
 	for i := range prm.addr {
+		var deleted bool
+
 		e.iterateOverSortedShards(prm.addr[i], func(_ int, sh hashedShard) (stop bool) {
 			var existsPrm shard.ExistsPrm
 			existsPrm.SetAddress(prm.addr[i])
@@ -100,8 +102,17 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 				return locked.is
 			}
 
+			deleted = true
+
 			return true
 		})
+
+		if deleted {
+			e.emitEvent(ObjectEvent{
+				Type:    EventDelete,
+				Address: prm.addr[i],
+			})
+		}
 	}
 
 	if locked.is {
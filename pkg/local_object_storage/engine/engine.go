@@ -74,6 +74,8 @@ type cfg struct {
 	metrics MetricRegister
 
 	shardPoolSize uint32
+
+	eventHandler func(ObjectEvent)
 }
 
 func defaultCfg() *cfg {
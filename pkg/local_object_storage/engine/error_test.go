@@ -43,12 +43,7 @@ func newEngineWithErrorThreshold(t testing.TB, dir string, errThreshold uint32)
 		ids[i], err = e.AddShard(
 			shard.WithLogger(zaptest.NewLogger(t)),
 			shard.WithBlobStorOptions(
-				blobstor.WithRootPath(filepath.Join(dir, strconv.Itoa(i))),
-				blobstor.WithShallowDepth(1),
-				blobstor.WithBlobovniczaShallowWidth(1),
-				blobstor.WithBlobovniczaShallowDepth(1),
-				blobstor.WithSmallSizeLimit(errSmallSize),
-				blobstor.WithRootPerm(0700)),
+				blobstor.WithStorages(newStorages(filepath.Join(dir, strconv.Itoa(i)), errSmallSize))),
 			shard.WithMetaBaseOptions(
 				meta.WithPath(filepath.Join(dir, fmt.Sprintf("%d.metabase", i))),
 				meta.WithPermissions(0700),
@@ -172,8 +167,8 @@ func TestBlobstorFailback(t *testing.T) {
 	checkShardState(t, e, id[0], 0, mode.ReadWrite)
 	require.NoError(t, e.Close())
 
-	p1 := e.shards[id[0].String()].Shard.DumpInfo().BlobStorInfo.RootPath
-	p2 := e.shards[id[1].String()].Shard.DumpInfo().BlobStorInfo.RootPath
+	p1 := e.shards[id[0].String()].Shard.DumpInfo().BlobStorInfo.SubStorages[1].Path
+	p2 := e.shards[id[1].String()].Shard.DumpInfo().BlobStorInfo.SubStorages[1].Path
 	tmp := filepath.Join(dir, "tmp")
 	require.NoError(t, os.Rename(p1, tmp))
 	require.NoError(t, os.Rename(p2, p1))
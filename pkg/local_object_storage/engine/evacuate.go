@@ -0,0 +1,333 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/hrw"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// EvacuateShardPrm represents parameters for the EvacuateShard operation.
+type EvacuateShardPrm struct {
+	shardID      []*shard.ID
+	handler      func(oid.Address, *objectSDK.Object) error
+	ignoreErrors bool
+}
+
+// EvacuateShardRes represents result of the EvacuateShard operation.
+type EvacuateShardRes struct {
+	count  int
+	failed int
+}
+
+// WithShardIDList sets the list of shards to evacuate.
+func (p *EvacuateShardPrm) WithShardIDList(id []*shard.ID) {
+	p.shardID = id
+}
+
+// WithIgnoreErrors sets flag to skip objects which cannot be read from the evacuated shard.
+func (p *EvacuateShardPrm) WithIgnoreErrors(ignore bool) {
+	p.ignoreErrors = ignore
+}
+
+// WithFaultHandler sets handler to call for objects which cannot be saved on other shards.
+func (p *EvacuateShardPrm) WithFaultHandler(f func(oid.Address, *objectSDK.Object) error) {
+	p.handler = f
+}
+
+// Count returns amount of evacuated objects.
+func (p EvacuateShardRes) Count() int {
+	return p.count
+}
+
+// Failed returns amount of objects which were skipped because of read errors.
+func (p EvacuateShardRes) Failed() int {
+	return p.failed
+}
+
+const defaultEvacuateBatchSize = 100
+
+var errMustHaveTwoShards = errors.New("must have at least 1 spare shard")
+
+type pooledShard struct {
+	hashedShard
+	pool util.WorkerPool
+}
+
+// lockInfo describes LOCK object which must be applied after all objects are evacuated.
+type lockInfo struct {
+	cnr    cid.ID
+	locker oid.ID
+	locked []oid.ID
+}
+
+// Evacuate moves data from one shard to the others.
+// The shard being moved must be in read-only mode.
+//
+// Tombstones and locks are moved too, their effect is restored
+// in the metabase of the shards they are put to.
+// Objects which cannot be put to any of the remaining shards are
+// passed to the fault handler if it is set.
+func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error) {
+	sidList := make([]string, len(prm.shardID))
+	for i := range prm.shardID {
+		sidList[i] = prm.shardID[i].String()
+	}
+
+	e.mtx.RLock()
+	for i := range sidList {
+		sh, ok := e.shards[sidList[i]]
+		if !ok {
+			e.mtx.RUnlock()
+			return EvacuateShardRes{}, errShardNotFound
+		}
+
+		if !sh.GetMode().ReadOnly() {
+			e.mtx.RUnlock()
+			return EvacuateShardRes{}, shard.ErrMustBeReadOnly
+		}
+	}
+
+	evacuated := make(map[string]*shard.Shard, len(sidList))
+	for i := range sidList {
+		evacuated[sidList[i]] = e.shards[sidList[i]].Shard
+	}
+
+	if len(e.shards)-len(evacuated) < 1 && prm.handler == nil {
+		e.mtx.RUnlock()
+		return EvacuateShardRes{}, errMustHaveTwoShards
+	}
+
+	shards := make([]pooledShard, 0, len(e.shards)-len(evacuated))
+	for id := range e.shards {
+		if _, ok := evacuated[id]; ok {
+			continue
+		}
+
+		shards = append(shards, pooledShard{
+			hashedShard: hashedShard(e.shards[id]),
+			pool:        e.shardPools[id],
+		})
+	}
+	e.mtx.RUnlock()
+
+	weights := make([]float64, 0, len(shards))
+	for i := range shards {
+		weights = append(weights, shardWeight(shards[i].Shard))
+	}
+
+	var (
+		res   EvacuateShardRes
+		locks []lockInfo
+	)
+
+	for _, sid := range sidList {
+		sh := evacuated[sid]
+
+		e.log.Info("started shard evacuation", zap.String("shard_id", sid))
+
+		var listPrm shard.ListWithCursorPrm
+		listPrm.WithCount(defaultEvacuateBatchSize)
+
+		var c *shard.Cursor
+		for {
+			listPrm.WithCursor(c)
+
+			listRes, err := sh.ListWithCursor(listPrm)
+			if err != nil {
+				if errors.Is(err, shard.ErrEndOfListing) {
+					break
+				}
+				return res, err
+			}
+
+			lst := listRes.AddressList()
+			for i := range lst {
+				var getPrm shard.GetPrm
+				getPrm.SetAddress(lst[i])
+
+				getRes, err := sh.Get(getPrm)
+				if err != nil {
+					if prm.ignoreErrors {
+						e.log.Warn("could not get object from the evacuated shard",
+							zap.String("shard_id", sid),
+							zap.Stringer("addr", lst[i]),
+							zap.String("error", err.Error()))
+
+						res.failed++
+						continue
+					}
+					return res, err
+				}
+
+				obj := getRes.Object()
+
+				err = e.evacuateObject(shards, weights, sid, lst[i], obj, prm.handler)
+				if err != nil {
+					return res, err
+				}
+
+				res.count++
+
+				if obj.Type() == objectSDK.TypeLock {
+					lock, err := lockFromObject(obj)
+					if err != nil {
+						return res, err
+					}
+
+					locks = append(locks, lock)
+				}
+			}
+
+			e.log.Info("shard evacuation in progress",
+				zap.String("shard_id", sid),
+				zap.Int("evacuated", res.count),
+				zap.Int("failed", res.failed))
+
+			c = listRes.Cursor()
+		}
+	}
+
+	for i := range locks {
+		e.restoreLock(shards, locks[i])
+	}
+
+	e.log.Info("shard evacuation finished",
+		zap.Strings("shard_ids", sidList),
+		zap.Int("evacuated", res.count),
+		zap.Int("failed", res.failed))
+
+	return res, nil
+}
+
+// evacuateObject puts obj to the first shard from the list which can accept it.
+// The list is sorted by HRW weight of the object address.
+// If no shard can accept the object, it is passed to the handler.
+func (e *StorageEngine) evacuateObject(shards []pooledShard, weights []float64, from string,
+	addr oid.Address, obj *objectSDK.Object, handler func(oid.Address, *objectSDK.Object) error) error {
+	// weights correspond to the original order of the shards, so the shared
+	// list is not sorted in place
+	shards = append([]pooledShard(nil), shards...)
+
+	hrw.SortSliceByWeightValue(shards, weights, hrw.Hash([]byte(addr.EncodeToString())))
+	for j := range shards {
+		putDone, exists := e.putToShard(shards[j].hashedShard, j, shards[j].pool, addr, obj)
+		if !putDone && !exists {
+			continue
+		}
+
+		if putDone {
+			e.log.Debug("object is moved to another shard",
+				zap.String("from", from),
+				zap.Stringer("to", shards[j].ID()),
+				zap.Stringer("addr", addr))
+		}
+
+		if obj.Type() == objectSDK.TypeTombstone {
+			e.restoreTombstone(shards[j].hashedShard, obj)
+		}
+
+		return nil
+	}
+
+	if handler == nil {
+		return fmt.Errorf("%w: %s", errPutShard, addr)
+	}
+
+	return handler(addr, obj)
+}
+
+// restoreTombstone marks members of the tombstone as removed in the shard where the tombstone is stored.
+func (e *StorageEngine) restoreTombstone(sh hashedShard, obj *objectSDK.Object) {
+	tombstone := objectSDK.NewTombstone()
+	if err := tombstone.Unmarshal(obj.Payload()); err != nil {
+		e.log.Warn("could not unmarshal tombstone content",
+			zap.Stringer("addr", object.AddressOf(obj)),
+			zap.String("error", err.Error()))
+		return
+	}
+
+	tombAddr := object.AddressOf(obj)
+	memberIDs := tombstone.Members()
+	if len(memberIDs) == 0 {
+		return
+	}
+
+	tombMembers := make([]oid.Address, 0, len(memberIDs))
+	for i := range memberIDs {
+		a := tombAddr
+		a.SetObject(memberIDs[i])
+
+		tombMembers = append(tombMembers, a)
+	}
+
+	var inhumePrm shard.InhumePrm
+	inhumePrm.SetTarget(tombAddr, tombMembers...)
+
+	_, err := sh.Inhume(inhumePrm)
+	if err != nil {
+		e.log.Warn("could not restore tombstone in the shard",
+			zap.Stringer("shard_id", sh.ID()),
+			zap.Stringer("addr", tombAddr),
+			zap.String("error", err.Error()))
+	}
+}
+
+// restoreLock locks evacuated objects in the shards where they are stored now.
+func (e *StorageEngine) restoreLock(shards []pooledShard, lock lockInfo) {
+	var addr oid.Address
+	addr.SetContainer(lock.cnr)
+
+	for i := range lock.locked {
+		addr.SetObject(lock.locked[i])
+
+		var existsPrm shard.ExistsPrm
+		existsPrm.SetAddress(addr)
+
+		for j := range shards {
+			exRes, err := shards[j].Exists(existsPrm)
+			if err != nil {
+				var siErr *objectSDK.SplitInfoError
+				if !errors.As(err, &siErr) {
+					continue
+				}
+			} else if !exRes.Exists() {
+				continue
+			}
+
+			err = shards[j].Lock(lock.cnr, lock.locker, []oid.ID{lock.locked[i]})
+			if err != nil {
+				e.log.Warn("could not restore lock in the shard",
+					zap.Stringer("shard_id", shards[j].ID()),
+					zap.Stringer("addr", addr),
+					zap.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+func lockFromObject(obj *objectSDK.Object) (lockInfo, error) {
+	var lock objectSDK.Lock
+	if err := lock.Unmarshal(obj.Payload()); err != nil {
+		return lockInfo{}, fmt.Errorf("could not unmarshal lock content: %w", err)
+	}
+
+	locked := make([]oid.ID, lock.NumberOfMembers())
+	lock.ReadMembers(locked)
+
+	cnr, _ := obj.ContainerID()
+	id, _ := obj.ID()
+
+	return lockInfo{
+		cnr:    cnr,
+		locker: id,
+		locked: locked,
+	}, nil
+}
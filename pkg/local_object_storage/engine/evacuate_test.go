@@ -0,0 +1,311 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/hrw"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEngine, []*shard.ID, []*objectSDK.Object) {
+	dir, err := os.MkdirTemp("", "*")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	e := New(
+		WithLogger(zaptest.NewLogger(t)),
+		WithShardPoolSize(1))
+
+	ids := make([]*shard.ID, shardNum)
+
+	for i := range ids {
+		ids[i], err = e.AddShard(
+			shard.WithLogger(zaptest.NewLogger(t)),
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages(newStorages(filepath.Join(dir, strconv.Itoa(i)), errSmallSize))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, fmt.Sprintf("%d.metabase", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			),
+			shard.WithPiloramaOptions(
+				pilorama.WithPath(filepath.Join(dir, fmt.Sprintf("%d.pilorama", i))),
+				pilorama.WithPerm(0700)))
+		require.NoError(t, err)
+	}
+	require.NoError(t, e.Open())
+	require.NoError(t, e.Init())
+
+	if objPerShard == 0 {
+		return e, ids, nil
+	}
+
+	objects := make([]*objectSDK.Object, 0, objPerShard*len(ids))
+	for i := 0; ; i++ {
+		objects = append(objects, generateObjectWithCID(t, cidtest.ID()))
+
+		var putPrm PutPrm
+		putPrm.WithObject(objects[i])
+
+		_, err := e.Put(putPrm)
+		require.NoError(t, err)
+
+		res, err := e.shards[ids[len(ids)-1].String()].List()
+		require.NoError(t, err)
+		if len(res.AddressList()) == objPerShard {
+			break
+		}
+	}
+	return e, ids, objects
+}
+
+func TestEvacuateShard(t *testing.T) {
+	const objPerShard = 3
+
+	e, ids, objects := newEngineEvacuate(t, 3, objPerShard)
+
+	evacuateShardID := ids[2].String()
+
+	checkHasObjects := func(t *testing.T) {
+		for i := range objects {
+			var prm GetPrm
+			prm.WithAddress(object.AddressOf(objects[i]))
+
+			_, err := e.Get(prm)
+			require.NoError(t, err)
+		}
+	}
+
+	checkHasObjects(t)
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids[2:3])
+
+	t.Run("must be read-only", func(t *testing.T) {
+		res, err := e.Evacuate(prm)
+		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
+		require.Equal(t, 0, res.Count())
+	})
+
+	require.NoError(t, e.shards[evacuateShardID].SetMode(mode.ReadOnly))
+
+	res, err := e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Equal(t, objPerShard, res.Count())
+	require.Equal(t, 0, res.Failed())
+
+	// We check that all objects are available both before and after shard removal.
+	// First case is a real-world use-case. It ensures that an object can be put in presense
+	// of all metabase checks/marks.
+	// Second case ensures that all objects are indeed moved and available.
+	checkHasObjects(t)
+
+	// Calling it again is OK, but all objects are already moved, so no new PUTs should be done.
+	res, err = e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Equal(t, objPerShard, res.Count())
+
+	checkHasObjects(t)
+
+	e.mtx.Lock()
+	delete(e.shards, evacuateShardID)
+	delete(e.shardPools, evacuateShardID)
+	e.mtx.Unlock()
+
+	checkHasObjects(t)
+}
+
+func TestEvacuateShardWeights(t *testing.T) {
+	const objPerShard = 20
+
+	e, ids, _ := newEngineEvacuate(t, 4, objPerShard)
+
+	evacuated := e.shards[ids[3].String()]
+
+	listRes, err := evacuated.List()
+	require.NoError(t, err)
+
+	addrs := listRes.AddressList()
+	require.Len(t, addrs, objPerShard)
+
+	require.NoError(t, evacuated.SetMode(mode.ReadOnly))
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids[3:])
+
+	// weights don't change until the next I/O operation of the shards,
+	// so Evacuate sees the same values
+	weights := make(map[string]float64, len(ids)-1)
+	for _, id := range ids[:3] {
+		weights[id.String()] = shardWeight(e.shards[id.String()].Shard)
+	}
+
+	res, err := e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Equal(t, objPerShard, res.Count())
+
+	for i := range addrs {
+		shards := make([]hashedShard, 0, len(weights))
+		ws := make([]float64, 0, len(weights))
+		for _, id := range ids[:3] {
+			shards = append(shards, hashedShard(e.shards[id.String()]))
+			ws = append(ws, weights[id.String()])
+		}
+
+		hrw.SortSliceByWeightValue(shards, ws, hrw.Hash([]byte(addrs[i].EncodeToString())))
+
+		var existsPrm shard.ExistsPrm
+		existsPrm.SetAddress(addrs[i])
+
+		existsRes, err := shards[0].Exists(existsPrm)
+		require.NoError(t, err)
+		require.True(t, existsRes.Exists(), "object %s is not in the heaviest shard", addrs[i])
+	}
+}
+
+func TestEvacuateNetwork(t *testing.T) {
+	var errReplication = errors.New("handler error")
+
+	acceptOneOf := func(objects []*objectSDK.Object, max int) func(oid.Address, *objectSDK.Object) error {
+		var n int
+		return func(addr oid.Address, obj *objectSDK.Object) error {
+			if n == max {
+				return errReplication
+			}
+
+			n++
+			for i := range objects {
+				if addr == object.AddressOf(objects[i]) {
+					require.Equal(t, objects[i], obj)
+					return nil
+				}
+			}
+			require.FailNow(t, "handler was called with an unexpected object: %s", addr)
+			panic("unreachable")
+		}
+	}
+
+	t.Run("single shard", func(t *testing.T) {
+		e, ids, objects := newEngineEvacuate(t, 1, 3)
+		evacuateShardID := ids[0].String()
+
+		require.NoError(t, e.shards[evacuateShardID].SetMode(mode.ReadOnly))
+
+		var prm EvacuateShardPrm
+		prm.WithShardIDList(ids[0:1])
+
+		res, err := e.Evacuate(prm)
+		require.ErrorIs(t, err, errMustHaveTwoShards)
+		require.Equal(t, 0, res.Count())
+
+		prm.WithFaultHandler(acceptOneOf(objects, 2))
+
+		res, err = e.Evacuate(prm)
+		require.ErrorIs(t, err, errReplication)
+		require.Equal(t, 2, res.Count())
+	})
+	t.Run("multiple shards, evacuate one", func(t *testing.T) {
+		e, ids, objects := newEngineEvacuate(t, 2, 3)
+
+		require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+		require.NoError(t, e.shards[ids[1].String()].SetMode(mode.ReadOnly))
+
+		var prm EvacuateShardPrm
+		prm.WithShardIDList(ids[1:2])
+		prm.WithFaultHandler(acceptOneOf(objects, 2))
+
+		res, err := e.Evacuate(prm)
+		require.ErrorIs(t, err, errReplication)
+		require.Equal(t, 2, res.Count())
+
+		t.Run("no errors", func(t *testing.T) {
+			prm.WithFaultHandler(acceptOneOf(objects, 3))
+
+			res, err := e.Evacuate(prm)
+			require.NoError(t, err)
+			require.Equal(t, 3, res.Count())
+		})
+	})
+}
+
+func TestEvacuateTombstonesAndLocks(t *testing.T) {
+	e, ids, _ := newEngineEvacuate(t, 2, 0)
+
+	cnr := cidtest.ID()
+	sh := e.shards[ids[0].String()]
+
+	obj := generateObjectWithCID(t, cnr)
+	objAddr := object.AddressOf(obj)
+
+	lockObj := generateObjectWithCID(t, cnr)
+
+	var lock objectSDK.Lock
+	lock.WriteMembers([]oid.ID{objAddr.Object()})
+	objectSDK.WriteLock(lockObj, lock)
+
+	removed := oidtest.Address()
+	removed.SetContainer(cnr)
+
+	tombObj := generateObjectWithCID(t, cnr)
+	tomb := objectSDK.NewTombstone()
+	tomb.SetMembers([]oid.ID{removed.Object()})
+	data, err := tomb.Marshal()
+	require.NoError(t, err)
+	tombObj.SetType(objectSDK.TypeTombstone)
+	tombObj.SetPayload(data)
+
+	for _, o := range []*objectSDK.Object{obj, lockObj, tombObj} {
+		var putPrm shard.PutPrm
+		putPrm.SetObject(o)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, sh.Lock(cnr, object.AddressOf(lockObj).Object(), []oid.ID{objAddr.Object()}))
+
+	var inhumePrm shard.InhumePrm
+	inhumePrm.SetTarget(object.AddressOf(tombObj), removed)
+
+	_, err = sh.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	var prm EvacuateShardPrm
+	prm.WithShardIDList(ids[0:1])
+
+	res, err := e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Equal(t, 3, res.Count())
+
+	target := e.shards[ids[1].String()]
+
+	var existsPrm shard.ExistsPrm
+	existsPrm.SetAddress(removed)
+
+	_, err = target.Exists(existsPrm)
+	require.True(t, shard.IsErrRemoved(err), "tombstone is not restored: %v", err)
+
+	inhumePrm.SetTarget(oidtest.Address(), objAddr)
+
+	_, err = target.Inhume(inhumePrm)
+	require.ErrorAs(t, err, new(apistatus.ObjectLocked))
+}
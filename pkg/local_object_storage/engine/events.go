@@ -0,0 +1,78 @@
+package engine
+
+import (
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ObjectEventType is a type of the ObjectEvent.
+type ObjectEventType uint8
+
+const (
+	_ ObjectEventType = iota
+
+	// EventPut is emitted when the object is saved in the local storage.
+	EventPut
+
+	// EventInhume is emitted when the object is marked as removed
+	// with the tombstone.
+	EventInhume
+
+	// EventDelete is emitted when the object is marked to be physically
+	// removed from the local storage.
+	EventDelete
+
+	// EventLock is emitted when the object is locked.
+	EventLock
+)
+
+// String returns string representation of the event type.
+func (t ObjectEventType) String() string {
+	switch t {
+	case EventPut:
+		return "put"
+	case EventInhume:
+		return "inhume"
+	case EventDelete:
+		return "delete"
+	case EventLock:
+		return "lock"
+	default:
+		return "unknown"
+	}
+}
+
+// ObjectEvent describes the change of the object in the local storage.
+type ObjectEvent struct {
+	// Type of the event.
+	Type ObjectEventType
+
+	// Address of the object.
+	Address oid.Address
+
+	// Header of the object without payload, set for EventPut only.
+	Header *objectSDK.Object
+
+	// Address of the tombstone, set for EventInhume only.
+	Tombstone oid.Address
+
+	// Address of the locker, set for EventLock only.
+	Locker oid.Address
+}
+
+// WithObjectEventHandler returns an option to set the handler of the object
+// events. Handler is called synchronously after the operation has succeeded
+// and the operation is not finished until the handler returns, so the handler
+// may slow down the operations. Events of the failed operations are not
+// emitted, some events may be repeated.
+func WithObjectEventHandler(h func(ObjectEvent)) Option {
+	return func(c *cfg) {
+		c.eventHandler = h
+	}
+}
+
+func (e *StorageEngine) emitEvent(ev ObjectEvent) {
+	if e.eventHandler != nil {
+		e.eventHandler(ev)
+	}
+}
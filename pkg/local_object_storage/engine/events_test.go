@@ -0,0 +1,144 @@
+package engine
+
+import (
+	"os"
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_ObjectEvents(t *testing.T) {
+	e := testNewEngineWithShardNum(t, 2)
+	t.Cleanup(func() {
+		_ = e.Close()
+		_ = os.RemoveAll(t.Name())
+	})
+
+	var events []ObjectEvent
+	e.eventHandler = func(ev ObjectEvent) {
+		events = append(events, ev)
+	}
+
+	cnr := cidtest.ID()
+
+	obj := generateObjectWithCID(t, cnr)
+	addr := objectcore.AddressOf(obj)
+
+	var putPrm PutPrm
+	putPrm.WithObject(obj)
+
+	_, err := e.Put(putPrm)
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	require.Equal(t, EventPut, events[0].Type)
+	require.Equal(t, addr, events[0].Address)
+	require.Equal(t, obj.CutPayload(), events[0].Header)
+	require.Empty(t, events[0].Header.Payload())
+	require.Equal(t, obj.Payload(), []byte{1, 2, 3, 4, 5}, "original object must not be changed")
+
+	t.Run("repeated put", func(t *testing.T) {
+		events = events[:0]
+
+		_, err := e.Put(putPrm)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("lock", func(t *testing.T) {
+		events = events[:0]
+
+		locker := oidtest.ID()
+
+		require.NoError(t, e.Lock(cnr, locker, []oid.ID{addr.Object()}))
+
+		var lockerAddr oid.Address
+		lockerAddr.SetContainer(cnr)
+		lockerAddr.SetObject(locker)
+
+		require.Equal(t, []ObjectEvent{{
+			Type:    EventLock,
+			Address: addr,
+			Locker:  lockerAddr,
+		}}, events)
+
+		events = events[:0]
+
+		var inhumePrm InhumePrm
+		inhumePrm.WithTarget(oidtest.Address(), addr)
+
+		_, err := e.Inhume(inhumePrm)
+		require.ErrorAs(t, err, new(apistatus.ObjectLocked))
+		require.Empty(t, events, "events of the failed operations must not be emitted")
+	})
+
+	t.Run("inhume", func(t *testing.T) {
+		obj := generateObjectWithCID(t, cnr)
+		putPrm.WithObject(obj)
+
+		_, err := e.Put(putPrm)
+		require.NoError(t, err)
+
+		events = events[:0]
+
+		addr := objectcore.AddressOf(obj)
+		tomb := oidtest.Address()
+
+		var inhumePrm InhumePrm
+		inhumePrm.WithTarget(tomb, addr)
+
+		_, err = e.Inhume(inhumePrm)
+		require.NoError(t, err)
+
+		require.Equal(t, []ObjectEvent{{
+			Type:      EventInhume,
+			Address:   addr,
+			Tombstone: tomb,
+		}}, events)
+	})
+
+	t.Run("garbage mark", func(t *testing.T) {
+		obj := generateObjectWithCID(t, cnr)
+		putPrm.WithObject(obj)
+
+		_, err := e.Put(putPrm)
+		require.NoError(t, err)
+
+		events = events[:0]
+
+		var inhumePrm InhumePrm
+		inhumePrm.MarkAsGarbage(objectcore.AddressOf(obj))
+
+		_, err = e.Inhume(inhumePrm)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		obj := generateObjectWithCID(t, cnr)
+		putPrm.WithObject(obj)
+
+		_, err := e.Put(putPrm)
+		require.NoError(t, err)
+
+		events = events[:0]
+
+		addr := objectcore.AddressOf(obj)
+
+		var deletePrm DeletePrm
+		deletePrm.WithAddresses(addr)
+
+		_, err = e.Delete(deletePrm)
+		require.NoError(t, err)
+
+		require.Equal(t, []ObjectEvent{{
+			Type:    EventDelete,
+			Address: addr,
+		}}, events)
+	})
+}
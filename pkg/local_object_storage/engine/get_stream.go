@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"errors"
+	"io"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// GetStreamRes groups the resulting values of GetStream operation.
+type GetStreamRes struct {
+	hdr     *objectSDK.Object
+	payload io.ReadCloser
+}
+
+// Header returns the header of the requested object.
+func (r GetStreamRes) Header() *objectSDK.Object {
+	return r.hdr
+}
+
+// Payload returns the reader of the requested object payload.
+// It must be closed by the caller.
+func (r GetStreamRes) Payload() io.ReadCloser {
+	return r.payload
+}
+
+// GetStream reads an object header from local storage and returns the
+// reader of the object payload. Payload of the big objects is read on
+// the fly without loading the whole object into memory. Only physically
+// stored objects can be read, split info of the virtual objects is
+// returned as an error.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object is missing in local storage.
+// Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) GetStream(prm GetPrm) (res GetStreamRes, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res, err = e.getStream(prm)
+		return err
+	})
+
+	return
+}
+
+func (e *StorageEngine) getStream(prm GetPrm) (GetStreamRes, error) {
+	if e.metrics != nil {
+		defer elapsed(e.metrics.AddGetDuration)()
+	}
+
+	var (
+		res GetStreamRes
+
+		errNotFound apistatus.ObjectNotFound
+		outError    error = errNotFound
+	)
+
+	var shPrm shard.GetPrm
+	shPrm.SetAddress(prm.addr)
+
+	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		shPrm.SetIgnoreMeta(sh.GetMode().NoMetabase())
+
+		shRes, err := sh.GetStream(shPrm)
+		if err != nil {
+			switch {
+			case shard.IsErrNotFound(err):
+				return false // ignore, go to next shard
+			case errors.As(err, new(*objectSDK.SplitInfoError)),
+				shard.IsErrRemoved(err):
+				outError = err
+
+				return true // stop, return it back
+			case shard.IsErrObjectExpired(err):
+				// object is found but should not
+				// be returned
+				return true
+			default:
+				e.reportShardError(sh, "could not get object stream from shard", err)
+				return false
+			}
+		}
+
+		res.hdr = shRes.Header()
+		res.payload = shRes.Payload()
+
+		return true
+	})
+
+	if res.hdr == nil {
+		return GetStreamRes{}, outError
+	}
+
+	return res, nil
+}
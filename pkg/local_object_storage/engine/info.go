@@ -19,6 +19,7 @@ func (e *StorageEngine) DumpInfo() (i Info) {
 	for _, sh := range e.shards {
 		info := sh.DumpInfo()
 		info.ErrorCount = sh.errorCount.Load()
+		info.ObjectCounters, _ = sh.ObjectCounters()
 		i.Shards = append(i.Shards, info)
 	}
 
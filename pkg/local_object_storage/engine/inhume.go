@@ -104,6 +104,16 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 				return InhumeRes{}, errInhumeFailure
 			}
 		}
+
+		// objects marked as garbage without the tombstone are reported
+		// by Delete or are removed by GC without an event
+		if prm.tombstone != nil {
+			e.emitEvent(ObjectEvent{
+				Type:      EventInhume,
+				Address:   prm.addrs[i],
+				Tombstone: *prm.tombstone,
+			})
+		}
 	}
 
 	return InhumeRes{}, nil
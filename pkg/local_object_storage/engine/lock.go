@@ -25,6 +25,12 @@ func (e *StorageEngine) Lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error
 }
 
 func (e *StorageEngine) lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error {
+	var ev ObjectEvent
+	ev.Type = EventLock
+	ev.Address.SetContainer(idCnr)
+	ev.Locker.SetContainer(idCnr)
+	ev.Locker.SetObject(locker)
+
 	for i := range locked {
 		switch e.lockSingle(idCnr, locker, locked[i], true) {
 		case 1:
@@ -37,6 +43,9 @@ func (e *StorageEngine) lock(idCnr cid.ID, locker oid.ID, locked []oid.ID) error
 				return errLockFailed
 			}
 		}
+
+		ev.Address.SetObject(locked[i])
+		e.emitEvent(ev)
 	}
 
 	return nil
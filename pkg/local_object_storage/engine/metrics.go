@@ -16,6 +16,9 @@ type MetricRegister interface {
 	AddRangeDuration(d time.Duration)
 	AddSearchDuration(d time.Duration)
 	AddListObjectsDuration(d time.Duration)
+
+	SetObjectCounter(shardID, objectType string, v uint64)
+	DeleteObjectCounter(shardID, objectType string)
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
@@ -25,3 +28,18 @@ func elapsed(addFunc func(d time.Duration)) func() {
 		addFunc(time.Since(t))
 	}
 }
+
+// metricsWithID passes the metrics of the shard to the engine's
+// MetricRegister along with the shard ID.
+type metricsWithID struct {
+	id string
+	mw MetricRegister
+}
+
+func (m *metricsWithID) SetShardID(id string) {
+	m.id = id
+}
+
+func (m *metricsWithID) SetObjectCounter(objectType string, v uint64) {
+	m.mw.SetObjectCounter(m.id, objectType, v)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
@@ -54,84 +56,108 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 
 	// In #1146 this check was parallelized, however, it became
 	// much slower on fast machines for 4 shards.
-	_, err := e.exists(addr)
+	exists, err := e.exists(addr)
 	if err != nil {
 		return PutRes{}, err
 	}
 
-	var existPrm shard.ExistsPrm
-	existPrm.SetAddress(addr)
-
-	finished := false
+	var finished, stored bool
 
 	e.iterateOverSortedShards(addr, func(ind int, sh hashedShard) (stop bool) {
 		e.mtx.RLock()
 		pool := e.shardPools[sh.ID().String()]
 		e.mtx.RUnlock()
 
-		exitCh := make(chan struct{})
+		putDone, exists := e.putToShard(sh, ind, pool, addr, prm.obj)
+		finished = putDone || exists
+		stored = putDone
+		return finished
+	})
 
-		if err := pool.Submit(func() {
-			defer close(exitCh)
+	if !finished {
+		err = errPutShard
+	} else if stored && !exists {
+		e.emitEvent(ObjectEvent{
+			Type:    EventPut,
+			Address: addr,
+			Header:  prm.obj.CutPayload(),
+		})
+	}
 
-			exists, err := sh.Exists(existPrm)
-			if err != nil {
-				if shard.IsErrObjectExpired(err) {
-					// object is already found but
-					// expired => do nothing with it
-					finished = true
-				}
+	return PutRes{}, err
+}
 
-				return // this is not ErrAlreadyRemoved error so we can go to the next shard
-			}
+// putToShard puts object to sh.
+// First return value is true iff put has been successfully done.
+// Second return value is true iff object already exists.
+func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
+	var putSuccess, alreadyExists bool
 
-			if exists.Exists() {
-				if ind != 0 {
-					var toMoveItPrm shard.ToMoveItPrm
-					toMoveItPrm.SetAddress(addr)
-
-					_, err = sh.ToMoveIt(toMoveItPrm)
-					if err != nil {
-						e.log.Warn("could not mark object for shard relocation",
-							zap.Stringer("shard", sh.ID()),
-							zap.String("error", err.Error()),
-						)
-					}
-				}
+	exitCh := make(chan struct{})
 
-				finished = true
+	if err := pool.Submit(func() {
+		defer close(exitCh)
 
-				return
-			}
+		var existPrm shard.ExistsPrm
+		existPrm.SetAddress(addr)
 
-			var putPrm shard.PutPrm
-			putPrm.SetObject(prm.obj)
+		exists, err := sh.Exists(existPrm)
+		if err != nil {
+			if shard.IsErrObjectExpired(err) {
+				// object is already found but
+				// expired => do nothing with it
+				alreadyExists = true
+			}
 
-			_, err = sh.Put(putPrm)
-			if err != nil {
-				e.log.Warn("could not put object in shard",
-					zap.Stringer("shard", sh.ID()),
-					zap.String("error", err.Error()),
-				)
+			return // this is not ErrAlreadyRemoved error so we can go to the next shard
+		}
 
-				return
+		alreadyExists = exists.Exists()
+		if alreadyExists {
+			if ind != 0 {
+				var toMoveItPrm shard.ToMoveItPrm
+				toMoveItPrm.SetAddress(addr)
+
+				_, err = sh.ToMoveIt(toMoveItPrm)
+				if err != nil {
+					e.log.Warn("could not mark object for shard relocation",
+						zap.Stringer("shard", sh.ID()),
+						zap.String("error", err.Error()),
+					)
+				}
 			}
 
-			finished = true
-		}); err != nil {
-			close(exitCh)
+			return
 		}
 
-		<-exitCh
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
 
-		return finished
-	})
+		_, err = sh.Put(putPrm)
+		if err != nil {
+			if errors.Is(err, shard.ErrShardFull) {
+				e.log.Debug("shard is full, trying the next one",
+					zap.Stringer("shard", sh.ID()))
 
-	if !finished {
-		err = errPutShard
+				return
+			}
+
+			e.log.Warn("could not put object in shard",
+				zap.Stringer("shard", sh.ID()),
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		putSuccess = true
+	}); err != nil {
+		close(exitCh)
 	}
 
-	return PutRes{}, err
+	<-exitCh
+
+	return putSuccess, alreadyExists
 }
 
 // Put writes provided object to local storage.
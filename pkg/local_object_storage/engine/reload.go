@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"go.uber.org/zap"
+)
+
+// ReConfiguration groups the parameters of the StorageEngine
+// which can be changed at runtime.
+type ReConfiguration struct {
+	shardPoolSize uint32
+
+	shards map[string]shardReConfig
+
+	// metabase path of the first duplicated shard
+	duplicate string
+}
+
+// shardReConfig groups the parameters of the shard from the configuration.
+type shardReConfig struct {
+	mode mode.Mode
+	opts []shard.Option
+}
+
+// SetShardPoolSize sets the size of the worker pool of each shard.
+// Zero value leaves the size unchanged.
+func (r *ReConfiguration) SetShardPoolSize(sz uint32) {
+	r.shardPoolSize = sz
+}
+
+// AddShard adds the shard which must be present in the engine. The shard is
+// identified by the metabase path, the options are used to create the shard
+// only if the engine has no shard with such metabase.
+func (r *ReConfiguration) AddShard(metaPath string, m mode.Mode, opts []shard.Option) {
+	if r.shards == nil {
+		r.shards = make(map[string]shardReConfig)
+	}
+
+	if _, ok := r.shards[metaPath]; ok {
+		r.duplicate = metaPath
+		return
+	}
+
+	r.shards[metaPath] = shardReConfig{
+		mode: m,
+		opts: opts,
+	}
+}
+
+type shardModeUpdate struct {
+	id *shard.ID
+	m  mode.Mode
+}
+
+// Reload applies the new configuration to the working storage engine:
+//  * worker pools of the shards are resized;
+//  * shards missing in the configuration are detached;
+//  * new shards are attached;
+//  * modes of the remaining shards are set according to the configuration.
+//
+// Shards are matched by the metabase path. Other parameters of the
+// already attached shards can't be changed at runtime and are ignored.
+func (e *StorageEngine) Reload(rcfg ReConfiguration) error {
+	if rcfg.duplicate != "" {
+		return fmt.Errorf("metabase %s is used by multiple shards", rcfg.duplicate)
+	}
+
+	if rcfg.shardPoolSize != 0 {
+		e.setShardPoolSize(rcfg.shardPoolSize)
+	}
+
+	var (
+		detach []*shard.ID
+		modes  []shardModeUpdate
+	)
+
+	added := make(map[string]shardReConfig, len(rcfg.shards))
+	for metaPath, sc := range rcfg.shards {
+		added[metaPath] = sc
+	}
+
+	e.mtx.RLock()
+	for _, sh := range e.shards {
+		metaPath := sh.DumpInfo().MetaBaseInfo.Path
+
+		sc, ok := added[metaPath]
+		if !ok {
+			detach = append(detach, sh.ID())
+			continue
+		}
+
+		delete(added, metaPath)
+
+		if sc.mode != sh.GetMode() {
+			modes = append(modes, shardModeUpdate{id: sh.ID(), m: sc.mode})
+		}
+	}
+	e.mtx.RUnlock()
+
+	// New shards are attached first, so that the engine
+	// is never left without shards.
+	for metaPath, sc := range added {
+		sh, err := e.createShard(sc.opts)
+		if err != nil {
+			return fmt.Errorf("could not create shard with metabase %s: %w", metaPath, err)
+		}
+
+		if err := e.attachShard(sh); err != nil {
+			return fmt.Errorf("could not attach shard with metabase %s: %w", metaPath, err)
+		}
+	}
+
+	if len(detach) != 0 {
+		if err := e.DetachShards(detach); err != nil {
+			return err
+		}
+	}
+
+	for i := range modes {
+		if err := e.SetShardMode(modes[i].id, modes[i].m, false); err != nil {
+			return fmt.Errorf("could not set mode of shard %s: %w", modes[i].id, err)
+		}
+
+		e.log.Info("shard mode is changed",
+			zap.Stringer("shard_id", modes[i].id),
+			zap.Stringer("mode", modes[i].m))
+	}
+
+	return nil
+}
+
+// setShardPoolSize resizes worker pools of the shards.
+// New shards are created with the new pool size.
+func (e *StorageEngine) setShardPoolSize(sz uint32) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.shardPoolSize == sz {
+		return
+	}
+
+	e.shardPoolSize = sz
+
+	for _, pool := range e.shardPools {
+		if p, ok := pool.(interface{ Tune(int) }); ok {
+			p.Tune(int(sz))
+		}
+	}
+
+	e.log.Info("shard pool size is changed", zap.Uint32("size", sz))
+}
@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReload(t *testing.T) {
+	dir := t.Name()
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	shardOpts := func(i int, m mode.Mode) []shard.Option {
+		return []shard.Option{
+			shard.WithMode(m),
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages(
+					newStorages(filepath.Join(dir, fmt.Sprintf("blobstor%d", i)), 1<<20))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			),
+			shard.WithPiloramaOptions(
+				pilorama.WithPath(filepath.Join(dir, fmt.Sprintf("pilorama%d", i)))),
+		}
+	}
+
+	e := testEngineFromShardOpts(t, 2, func(i int) []shard.Option {
+		return shardOpts(i, mode.ReadWrite)
+	})
+	t.Cleanup(func() { _ = e.Close() })
+
+	metaPaths := func() map[string]mode.Mode {
+		res := make(map[string]mode.Mode)
+		for _, sh := range e.unsortedShards() {
+			res[sh.DumpInfo().MetaBaseInfo.Path] = sh.GetMode()
+		}
+		return res
+	}
+
+	t.Run("duplicate metabase", func(t *testing.T) {
+		var rcfg ReConfiguration
+		rcfg.AddShard(filepath.Join(dir, "metabase2"), mode.ReadWrite, shardOpts(2, mode.ReadWrite))
+		rcfg.AddShard(filepath.Join(dir, "metabase2"), mode.ReadOnly, shardOpts(2, mode.ReadOnly))
+
+		require.Error(t, e.Reload(rcfg))
+		require.Len(t, e.unsortedShards(), 2)
+	})
+
+	var rcfg ReConfiguration
+	rcfg.SetShardPoolSize(5)
+	rcfg.AddShard(filepath.Join(dir, "metabase1"), mode.ReadOnly, shardOpts(1, mode.ReadOnly))
+	rcfg.AddShard(filepath.Join(dir, "metabase2"), mode.ReadWrite, shardOpts(2, mode.ReadWrite))
+
+	require.NoError(t, e.Reload(rcfg))
+	require.Equal(t, map[string]mode.Mode{
+		filepath.Join(dir, "metabase1"): mode.ReadOnly,
+		filepath.Join(dir, "metabase2"): mode.ReadWrite,
+	}, metaPaths())
+
+	e.mtx.RLock()
+	for _, pool := range e.shardPools {
+		require.Equal(t, 5, pool.(*ants.Pool).Cap())
+	}
+	e.mtx.RUnlock()
+
+	// Reloading the same configuration changes nothing.
+	require.NoError(t, e.Reload(rcfg))
+	require.Len(t, e.unsortedShards(), 2)
+
+	// Options of the attached shards are not used.
+	var same ReConfiguration
+	same.AddShard(filepath.Join(dir, "metabase1"), mode.ReadOnly, nil)
+	same.AddShard(filepath.Join(dir, "metabase2"), mode.ReadWrite, nil)
+
+	require.NoError(t, e.Reload(same))
+	require.Len(t, e.unsortedShards(), 2)
+}
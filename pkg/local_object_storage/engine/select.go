@@ -1,21 +1,29 @@
 package engine
 
 import (
+	"sort"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
+// SelectCursor is a type for continuous object selection.
+type SelectCursor = shard.SelectCursor
+
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+	limit   uint32
+	cursor  *SelectCursor
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *SelectCursor
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -32,13 +40,42 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) {
 	}
 }
 
+// WithLimit is a Select option to set the maximum amount of
+// addresses to return. Zero means no limit.
+func (p *SelectPrm) WithLimit(limit uint32) {
+	if p != nil {
+		p.limit = limit
+	}
+}
+
+// WithCursor is a Select option to set the cursor. For initial request
+// ignore this param or use nil value. For consecutive requests, use value
+// from SelectRes.
+func (p *SelectPrm) WithCursor(cursor *SelectCursor) {
+	if p != nil {
+		p.cursor = cursor
+	}
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns cursor for consecutive select requests.
+// Returns nil if there are no more objects to select.
+// Non-nil cursor can lead to the empty result of the
+// consecutive request.
+func (r SelectRes) Cursor() *SelectCursor {
+	return r.cursor
+}
+
 // Select selects the objects from local storage that match select parameters.
 //
+// If limit is set, the objects are returned in pages in the ascending
+// order of their identifiers' string representation. Use cursor value
+// from the response for consecutive requests.
+//
 // Returns any error encountered that did not allow to completely select the objects.
 //
 // Returns an error if executions are blocked (see BlockExecution).
@@ -59,11 +96,16 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 	addrList := make([]oid.Address, 0)
 	uniqueMap := make(map[string]struct{})
 
-	var outError error
+	var (
+		outError error
+		more     bool
+	)
 
 	var shPrm shard.SelectPrm
 	shPrm.SetContainerID(prm.cnr)
 	shPrm.SetFilters(prm.filters)
+	shPrm.SetLimit(prm.limit)
+	shPrm.SetCursor(prm.cursor)
 
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
 		res, err := sh.Select(shPrm)
@@ -72,6 +114,8 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 			return false
 		}
 
+		more = more || res.Cursor() != nil
+
 		for _, addr := range res.AddressList() { // save only unique values
 			if _, ok := uniqueMap[addr.EncodeToString()]; !ok {
 				uniqueMap[addr.EncodeToString()] = struct{}{}
@@ -82,8 +126,31 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 		return false
 	})
 
+	if prm.limit == 0 {
+		return SelectRes{
+			addrList: addrList,
+		}, outError
+	}
+
+	// Every shard returns the first objects following the cursor,
+	// so the first objects of the merged list are the page.
+	sort.Slice(addrList, func(i, j int) bool {
+		return addrList[i].Object().EncodeToString() < addrList[j].Object().EncodeToString()
+	})
+
+	if len(addrList) > int(prm.limit) {
+		addrList = addrList[:prm.limit]
+		more = true
+	}
+
+	var cursor *SelectCursor
+	if more && len(addrList) != 0 {
+		cursor = shard.NewSelectCursor(addrList[len(addrList)-1].Object())
+	}
+
 	return SelectRes{
 		addrList: addrList,
+		cursor:   cursor,
 	}, outError
 }
 
@@ -0,0 +1,77 @@
+package engine
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectWithLimit(t *testing.T) {
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+	e := testNewEngineWithShards(s1, s2)
+
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	const total = 11
+
+	cnr := cidtest.ID()
+	expected := make([]oid.Address, 0, total)
+
+	for i := 0; i < total; i++ {
+		obj := generateObjectWithCID(t, cnr)
+
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+
+		// every third object is stored in both shards
+		if i%3 != 0 {
+			_, err := s1.Put(prm)
+			require.NoError(t, err)
+		}
+		if i%3 != 1 {
+			_, err := s2.Put(prm)
+			require.NoError(t, err)
+		}
+
+		expected = append(expected, object.AddressOf(obj))
+	}
+
+	sort.Slice(expected, func(i, j int) bool {
+		return expected[i].Object().EncodeToString() < expected[j].Object().EncodeToString()
+	})
+
+	for _, limit := range []uint32{1, 4, total} {
+		var (
+			prm SelectPrm
+			got []oid.Address
+		)
+
+		prm.WithContainerID(cnr)
+		prm.WithLimit(limit)
+
+		for {
+			res, err := e.Select(prm)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.AddressList()), int(limit))
+
+			got = append(got, res.AddressList()...)
+			if res.Cursor() == nil {
+				break
+			}
+
+			prm.WithCursor(res.Cursor())
+		}
+
+		require.Equal(t, expected, got, "limit %d", limit)
+	}
+}
@@ -3,17 +3,24 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nspcc-dev/hrw"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/atomic"
+	"go.uber.org/zap"
 )
 
-var errShardNotFound = errors.New("shard not found")
+var (
+	errShardNotFound   = errors.New("shard not found")
+	errEmptyShardList  = errors.New("empty shard list")
+	errDetachAllShards = errors.New("could not detach all the shards")
+)
 
 type hashedShard shardWrapper
 
@@ -22,33 +29,117 @@ type hashedShard shardWrapper
 // Returns any error encountered that did not allow adding a shard.
 // Otherwise returns the ID of the added shard.
 func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
-	e.mtx.Lock()
-	defer e.mtx.Unlock()
+	sh, err := e.createShard(opts)
+	if err != nil {
+		return nil, err
+	}
 
-	pool, err := ants.NewPool(int(e.shardPoolSize), ants.WithNonblocking(true))
+	if err := sh.UpdateID(); err != nil {
+		return nil, fmt.Errorf("could not open shard: %w", err)
+	}
+
+	err = e.addShard(sh)
 	if err != nil {
 		return nil, err
 	}
 
+	return sh.ID(), nil
+}
+
+// AttachShard creates a new shard, opens and initializes it and
+// adds it to the working storage engine.
+//
+// Shard ID is read from the shard's metabase if it exists there,
+// so the shard detached earlier is attached with the same ID.
+func (e *StorageEngine) AttachShard(opts ...shard.Option) (*shard.ID, error) {
+	sh, err := e.createShard(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.attachShard(sh); err != nil {
+		return nil, err
+	}
+
+	return sh.ID(), nil
+}
+
+// attachShard opens and initializes the created shard and adds it
+// to the working storage engine.
+func (e *StorageEngine) attachShard(sh *shard.Shard) error {
+	// Metabase of the attached shard is locked, so it must be checked
+	// before the shard ID is read from it.
+	metaPath := sh.DumpInfo().MetaBaseInfo.Path
+
+	e.mtx.RLock()
+	for _, other := range e.shards {
+		if other.DumpInfo().MetaBaseInfo.Path == metaPath {
+			e.mtx.RUnlock()
+			return fmt.Errorf("shard with metabase %s was already added", metaPath)
+		}
+	}
+	e.mtx.RUnlock()
+
+	if err := sh.UpdateID(); err != nil {
+		return fmt.Errorf("could not open shard: %w", err)
+	}
+
+	if err := sh.Open(); err != nil {
+		return fmt.Errorf("could not open shard: %w", err)
+	}
+
+	if err := sh.Init(); err != nil {
+		_ = sh.Close()
+		return fmt.Errorf("could not initialize shard: %w", err)
+	}
+
+	if err := e.addShard(sh); err != nil {
+		_ = sh.Close()
+		return err
+	}
+
+	e.log.Info("shard is attached", zap.Stringer("shard_id", sh.ID()))
+
+	return nil
+}
+
+func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 	id, err := generateShardID()
 	if err != nil {
 		return nil, fmt.Errorf("could not generate shard ID: %w", err)
 	}
 
-	sh := shard.New(append(opts,
+	opts = append(opts,
 		shard.WithID(id),
 		shard.WithExpiredTombstonesCallback(e.processExpiredTombstones),
 		shard.WithExpiredLocksCallback(e.processExpiredLocks),
 		shard.WithDeletedLockCallback(e.processDeletedLocks),
-	)...)
+	)
 
-	if err := sh.UpdateID(); err != nil {
-		return nil, fmt.Errorf("could not open shard: %w", err)
+	if e.metrics != nil {
+		opts = append(opts, shard.WithMetricsWriter(&metricsWithID{
+			id: id.String(),
+			mw: e.metrics,
+		}))
 	}
 
+	sh := shard.New(opts...)
+
+	return sh, nil
+}
+
+func (e *StorageEngine) addShard(sh *shard.Shard) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
 	strID := sh.ID().String()
 	if _, ok := e.shards[strID]; ok {
-		return nil, fmt.Errorf("shard with id %s was already added", strID)
+		return fmt.Errorf("shard with id %s was already added", strID)
+	}
+
+	pool, err := ants.NewPool(int(e.shardPoolSize), ants.WithNonblocking(true))
+	if err != nil {
+		return err
 	}
 
 	e.shards[strID] = shardWrapper{
@@ -58,7 +149,85 @@ func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
 
 	e.shardPools[strID] = pool
 
-	return sh.ID(), nil
+	return nil
+}
+
+// DetachShards removes shards with the specified IDs from the storage
+// engine and closes them. Shard data is left untouched, so the shard
+// can be attached again later.
+//
+// Returns an error if any of the shards is not found or if all the shards
+// of the engine are requested to be detached.
+func (e *StorageEngine) DetachShards(ids []*shard.ID) error {
+	if len(ids) == 0 {
+		return errEmptyShardList
+	}
+
+	e.mtx.Lock()
+
+	for i := range ids {
+		if _, ok := e.shards[ids[i].String()]; !ok {
+			e.mtx.Unlock()
+			return fmt.Errorf("%w: %s", errShardNotFound, ids[i])
+		}
+	}
+
+	detached := make(map[string]shardWrapper, len(ids))
+	for i := range ids {
+		detached[ids[i].String()] = e.shards[ids[i].String()]
+	}
+
+	if len(detached) == len(e.shards) {
+		e.mtx.Unlock()
+		return errDetachAllShards
+	}
+
+	pools := make([]util.WorkerPool, 0, len(detached))
+	for id := range detached {
+		pools = append(pools, e.shardPools[id])
+
+		delete(e.shards, id)
+		delete(e.shardPools, id)
+	}
+
+	e.mtx.Unlock()
+
+	for i := range pools {
+		pools[i].Release()
+	}
+
+	var firstErr error
+	for id, sh := range detached {
+		// Shard is not reachable from the engine anymore,
+		// so no new events can be sent to it.
+		if ch := sh.NotificationChannel(); ch != nil {
+			close(ch)
+		}
+
+		err := sh.Close()
+
+		if e.metrics != nil {
+			for _, typ := range shard.ObjectCounterTypes {
+				e.metrics.DeleteObjectCounter(id, typ)
+			}
+		}
+
+		if err != nil {
+			e.log.Error("could not close detached shard",
+				zap.String("shard_id", id),
+				zap.String("error", err.Error()))
+
+			if firstErr == nil {
+				firstErr = fmt.Errorf("could not close shard %s: %w", id, err)
+			}
+
+			continue
+		}
+
+		e.log.Info("shard is detached", zap.String("shard_id", id))
+	}
+
+	return firstErr
 }
 
 func generateShardID() (*shard.ID, error) {
@@ -75,10 +244,17 @@ func generateShardID() (*shard.ID, error) {
 	return shard.NewIDFromBytes(bin), nil
 }
 
-func (e *StorageEngine) shardWeight(sh *shard.Shard) float64 {
+// referenceLatency is the average I/O latency which halves the weight of a shard.
+const referenceLatency = 10 * time.Millisecond
+
+// shardWeight returns the weight of the shard in HRW sorting. The weight
+// is proportional to the free disk space and is reduced by the recent
+// I/O error rate and latency.
+func shardWeight(sh *shard.Shard) float64 {
 	weightValues := sh.WeightValues()
 
-	return float64(weightValues.FreeSpace)
+	w := float64(weightValues.FreeSpace) * (1 - weightValues.ErrorRate)
+	return w / (1 + float64(weightValues.Latency)/float64(referenceLatency))
 }
 
 func (e *StorageEngine) sortShardsByWeight(objAddr interface{ EncodeToString() string }) []hashedShard {
@@ -90,7 +266,7 @@ func (e *StorageEngine) sortShardsByWeight(objAddr interface{ EncodeToString() s
 
 	for _, sh := range e.shards {
 		shards = append(shards, hashedShard(sh))
-		weights = append(weights, e.shardWeight(sh.Shard))
+		weights = append(weights, shardWeight(sh.Shard))
 	}
 
 	hrw.SortSliceByWeightValue(shards, weights, hrw.Hash([]byte(objAddr.EncodeToString())))
@@ -146,6 +322,18 @@ func (e *StorageEngine) SetShardMode(id *shard.ID, m mode.Mode, resetErrorCounte
 	return errShardNotFound
 }
 
+// HandleNewEpoch notifies every shard about NewEpoch event.
+func (e *StorageEngine) HandleNewEpoch(epoch uint64) {
+	ev := shard.EventNewEpoch(epoch)
+
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	for _, sh := range e.shards {
+		sh.NotifyGC(ev)
+	}
+}
+
 func (s hashedShard) Hash() uint64 {
 	return hrw.Hash(
 		[]byte(s.Shard.ID().String()),
@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachDetachShards(t *testing.T) {
+	dir := t.Name()
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	shardOpts := func(i int) []shard.Option {
+		return []shard.Option{
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages(
+					newStorages(filepath.Join(dir, fmt.Sprintf("blobstor%d", i)), 1<<20))),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			),
+			shard.WithPiloramaOptions(
+				pilorama.WithPath(filepath.Join(dir, fmt.Sprintf("pilorama%d", i)))),
+		}
+	}
+
+	e := testEngineFromShardOpts(t, 2, func(i int) []shard.Option {
+		return shardOpts(i)
+	})
+	t.Cleanup(func() { _ = e.Close() })
+
+	ids := make([]*shard.ID, 0, 3)
+	for _, sh := range e.unsortedShards() {
+		ids = append(ids, sh.ID())
+	}
+
+	id, err := e.AttachShard(shardOpts(2)...)
+	require.NoError(t, err)
+	require.Len(t, e.unsortedShards(), 3)
+
+	ids = append(ids, id)
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	require.NoError(t, Put(e, obj))
+
+	t.Run("attach twice", func(t *testing.T) {
+		_, err := e.AttachShard(shardOpts(2)...)
+		require.Error(t, err)
+		require.Len(t, e.unsortedShards(), 3)
+	})
+	t.Run("detach missing", func(t *testing.T) {
+		err := e.DetachShards([]*shard.ID{ids[0], shard.NewIDFromBytes([]byte{1, 2, 3})})
+		require.ErrorIs(t, err, errShardNotFound)
+		require.Len(t, e.unsortedShards(), 3)
+	})
+	t.Run("detach all", func(t *testing.T) {
+		require.ErrorIs(t, e.DetachShards(ids), errDetachAllShards)
+		require.ErrorIs(t, e.DetachShards(nil), errEmptyShardList)
+		require.Len(t, e.unsortedShards(), 3)
+	})
+
+	require.NoError(t, e.DetachShards(ids[2:]))
+	require.Len(t, e.unsortedShards(), 2)
+
+	// Shard ID is persisted in the metabase, so the shard is attached with the same ID.
+	id, err = e.AttachShard(shardOpts(2)...)
+	require.NoError(t, err)
+	require.Equal(t, ids[2], id)
+	require.Len(t, e.unsortedShards(), 3)
+
+	_, err = Get(e, object.AddressOf(obj))
+	require.NoError(t, err)
+
+	e.HandleNewEpoch(1)
+}
+
+func TestFillWatermark(t *testing.T) {
+	dir := t.Name()
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	// Any disk in use is above the watermark of the first shard.
+	e := testEngineFromShardOpts(t, 2, func(i int) []shard.Option {
+		if i == 0 {
+			return []shard.Option{shard.WithFillWatermark(1e-9)}
+		}
+		return nil
+	})
+	t.Cleanup(func() { _ = e.Close() })
+
+	var full, free hashedShard
+	for _, sh := range e.unsortedShards() {
+		if sh.DumpInfo().BlobStorInfo.SubStorages[0].Path == filepath.Join(dir, "blobstor0", "blobovnicza") {
+			full = sh
+		} else {
+			free = sh
+		}
+	}
+
+	cnr := cidtest.ID()
+	for i := 0; i < 10; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		require.NoError(t, Put(e, obj))
+
+		var existsPrm shard.ExistsPrm
+		existsPrm.SetAddress(object.AddressOf(obj))
+
+		res, err := full.Exists(existsPrm)
+		require.NoError(t, err)
+		require.False(t, res.Exists())
+
+		res, err = free.Exists(existsPrm)
+		require.NoError(t, err)
+		require.True(t, res.Exists())
+
+		_, err = Get(e, object.AddressOf(obj))
+		require.NoError(t, err)
+	}
+}
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
@@ -138,6 +139,28 @@ func (e *StorageEngine) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pil
 	return nil, err
 }
 
+// TreeGetChildrenSorted implements the pilorama.Forest interface.
+func (e *StorageEngine) TreeGetChildrenSorted(cid cidSDK.ID, treeID string, nodeID pilorama.Node, attr string, after *pilorama.ChildrenCursor, count int) ([]pilorama.NodeInfo, error) {
+	var err error
+	var nodes []pilorama.NodeInfo
+	for _, sh := range e.sortShardsByWeight(cid) {
+		nodes, err = sh.TreeGetChildrenSorted(cid, treeID, nodeID, attr, after, count)
+		if err != nil {
+			if err == shard.ErrPiloramaDisabled {
+				break
+			}
+			if !errors.Is(err, pilorama.ErrTreeNotFound) {
+				e.reportShardError(sh, "can't perform `TreeGetChildrenSorted`", err,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			continue
+		}
+		return nodes, nil
+	}
+	return nil, err
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (e *StorageEngine) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	var err error
@@ -159,3 +182,94 @@ func (e *StorageEngine) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64
 	}
 	return lm, err
 }
+
+// TreeDrop implements the pilorama.Forest interface.
+// The tree is removed from all the shards.
+func (e *StorageEngine) TreeDrop(cid cidSDK.ID, treeID string) error {
+	var err error
+	var found bool
+	for _, sh := range e.sortShardsByWeight(cid) {
+		shErr := sh.TreeDrop(cid, treeID)
+		if shErr != nil {
+			if shErr == shard.ErrPiloramaDisabled {
+				return shErr
+			}
+			if !errors.Is(shErr, pilorama.ErrTreeNotFound) && !errors.Is(shErr, shard.ErrReadOnlyMode) {
+				e.reportShardError(sh, "can't perform `TreeDrop`", shErr,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			if err == nil || errors.Is(err, pilorama.ErrTreeNotFound) {
+				err = shErr
+			}
+			continue
+		}
+		found = true
+	}
+	if found {
+		return nil
+	}
+	return err
+}
+
+// TreeList implements the pilorama.Forest interface.
+// Tree IDs from all the shards are returned in the sorted order.
+func (e *StorageEngine) TreeList(cid cidSDK.ID) ([]string, error) {
+	var resIDs []string
+	seen := make(map[string]struct{})
+
+	for _, sh := range e.unsortedShards() {
+		ids, err := sh.TreeList(cid)
+		if err != nil {
+			if errors.Is(err, shard.ErrPiloramaDisabled) {
+				return nil, err
+			}
+
+			e.reportShardError(sh, "can't perform `TreeList`", err,
+				zap.Stringer("cid", cid))
+
+			// returns as much info about
+			// trees as possible
+			continue
+		}
+
+		for i := range ids {
+			if _, ok := seen[ids[i]]; !ok {
+				seen[ids[i]] = struct{}{}
+				resIDs = append(resIDs, ids[i])
+			}
+		}
+	}
+
+	sort.Strings(resIDs)
+	return resIDs, nil
+}
+
+// TreeCompactLog implements the pilorama.Forest interface.
+// The log is compacted in all the shards storing the tree.
+func (e *StorageEngine) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	var err error
+	var found bool
+	for _, sh := range e.sortShardsByWeight(cid) {
+		shErr := sh.TreeCompactLog(cid, treeID, height)
+		if shErr != nil {
+			if shErr == shard.ErrPiloramaDisabled {
+				return shErr
+			}
+			if !errors.Is(shErr, pilorama.ErrTreeNotFound) && !errors.Is(shErr, shard.ErrReadOnlyMode) {
+				e.reportShardError(sh, "can't perform `TreeCompactLog`", shErr,
+					zap.Stringer("cid", cid),
+					zap.String("tree", treeID))
+			}
+			if err == nil || errors.Is(err, pilorama.ErrTreeNotFound) {
+				err = shErr
+			}
+			continue
+		}
+		found = true
+	}
+	if found {
+		return nil
+	}
+	return err
+}
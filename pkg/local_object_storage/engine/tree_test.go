@@ -1,12 +1,14 @@
 package engine
 
 import (
+	"os"
 	"strconv"
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
 )
 
 func BenchmarkTreeVsSearch(b *testing.B) {
@@ -71,3 +73,38 @@ func benchmarkTreeVsSearch(b *testing.B, objCount int) {
 		}
 	})
 }
+
+func TestTreeListDrop(t *testing.T) {
+	e, dir, _ := newEngineWithErrorThreshold(t, "", 0)
+	t.Cleanup(func() {
+		require.NoError(t, e.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	cid := cidtest.ID()
+	d := pilorama.CIDDescriptor{CID: cid, Position: 0, Size: 1}
+
+	trees := []string{"tree1", "tree2"}
+	for i := range trees {
+		_, err := e.TreeMove(d, trees[i], &pilorama.Move{Parent: pilorama.RootID, Child: pilorama.RootID})
+		require.NoError(t, err)
+	}
+
+	list, err := e.TreeList(cid)
+	require.NoError(t, err)
+	require.Equal(t, trees, list)
+
+	require.ErrorIs(t, e.TreeDrop(cid, "missing"), pilorama.ErrTreeNotFound)
+
+	require.NoError(t, e.TreeDrop(cid, trees[0]))
+
+	list, err = e.TreeList(cid)
+	require.NoError(t, err)
+	require.Equal(t, trees[1:], list)
+
+	require.NoError(t, e.TreeDrop(cid, ""))
+
+	list, err = e.TreeList(cid)
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
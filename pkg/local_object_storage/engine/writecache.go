@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+)
+
+// FlushWriteCachePrm groups the parameters of FlushWriteCache operation.
+type FlushWriteCachePrm struct {
+	shardID *shard.ID
+	disable bool
+}
+
+// SetShardID is an option to set shard ID.
+//
+// Option is required.
+func (p *FlushWriteCachePrm) SetShardID(id *shard.ID) {
+	p.shardID = id
+}
+
+// SetDisableWriteCache sets a flag to switch the write-cache off after the flush.
+func (p *FlushWriteCachePrm) SetDisableWriteCache(disable bool) {
+	p.disable = disable
+}
+
+// FlushWriteCacheRes groups the resulting values of FlushWriteCache operation.
+type FlushWriteCacheRes struct{}
+
+// FlushWriteCache synchronously flushes the write-cache of a single shard.
+func (e *StorageEngine) FlushWriteCache(p FlushWriteCachePrm) (FlushWriteCacheRes, error) {
+	sh, err := e.getShard(p.shardID)
+	if err != nil {
+		return FlushWriteCacheRes{}, err
+	}
+
+	var prm shard.FlushWriteCachePrm
+	prm.SetDisableWriteCache(p.disable)
+
+	return FlushWriteCacheRes{}, sh.FlushWriteCache(prm)
+}
+
+// WriteCacheStat returns the statistics of the write-cache of a single shard.
+func (e *StorageEngine) WriteCacheStat(id *shard.ID) (writecache.Stat, error) {
+	sh, err := e.getShard(id)
+	if err != nil {
+		return writecache.Stat{}, err
+	}
+
+	return sh.WriteCacheStat()
+}
+
+func (e *StorageEngine) getShard(id *shard.ID) (*shard.Shard, error) {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	sh, ok := e.shards[id.String()]
+	if !ok {
+		return nil, errShardNotFound
+	}
+
+	return sh.Shard, nil
+}
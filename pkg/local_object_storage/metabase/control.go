@@ -57,6 +57,7 @@ func (db *DB) Open(readOnly bool) error {
 }
 
 // Init initializes metabase. It creates static (CID-independent) buckets in underlying BoltDB instance.
+// Metabase of the older version is migrated to the current one, see Migrate.
 //
 // Does nothing if metabase has already been initialized and filled. To roll back the database to its initial state,
 // use Reset.
@@ -71,7 +72,20 @@ func (db *DB) Reset() error {
 }
 
 func (db *DB) init(reset bool) error {
-	if db.mode.NoMetabase() || db.mode.ReadOnly() {
+	if db.mode.NoMetabase() {
+		return nil
+	}
+
+	if db.mode.ReadOnly() || db.boltDB.IsReadOnly() {
+		// read-only metabase can't be migrated, so it must already have the current version
+		err := db.boltDB.View(func(tx *bbolt.Tx) error {
+			return checkVersion(tx, db.initialized)
+		})
+		if err != nil {
+			return fmt.Errorf("read-only metabase: %w", err)
+		}
+
+		db.reportObjectCounters()
 		return nil
 	}
 
@@ -80,9 +94,16 @@ func (db *DB) init(reset bool) error {
 		string(graveyardBucketName):       {},
 		string(toMoveItBucketName):        {},
 		string(garbageBucketName):         {},
+		string(countersBucketName):        {},
 	}
 
-	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+	if !reset && db.initialized {
+		if _, err := db.migrate(defaultMigrationBatchSize, false); err != nil {
+			return err
+		}
+	}
+
+	err := db.boltDB.Update(func(tx *bbolt.Tx) error {
 		var err error
 		if !reset {
 			// Normal open, check version and update if not initialized.
@@ -120,6 +141,13 @@ func (db *DB) init(reset bool) error {
 		}
 		return updateVersion(tx, version)
 	})
+	if err != nil {
+		return err
+	}
+
+	db.reportObjectCounters()
+
+	return nil
 }
 
 // Close closes boltDB instance.
@@ -0,0 +1,258 @@
+package meta
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.etcd.io/bbolt"
+)
+
+// ObjectCounters groups the object counters of the metabase.
+type ObjectCounters struct {
+	// Number of the physically stored objects.
+	Phy uint64
+	// Number of the logically available objects, i.e. the stored
+	// objects which are neither covered with a tombstone nor marked
+	// with GC mark.
+	Logic uint64
+	// Number of the physically stored objects of TOMBSTONE type.
+	Tombstones uint64
+	// Number of the physically stored objects of LOCK type.
+	Locks uint64
+	// Number of the physically stored objects which are not available
+	// logically and are waiting for the GC.
+	Garbage uint64
+}
+
+// CountersHandler is a handler of the changed object counters of the metabase.
+type CountersHandler func(ObjectCounters)
+
+// shardCounterKey is a key in the counters bucket which stores
+// the object counters of the whole metabase.
+var shardCounterKey = []byte("shard")
+
+// counterDelta is a change of the object counters.
+type counterDelta struct {
+	phy, logic, tombstones, locks int64
+}
+
+// storedObjectDelta returns the change of the counters caused by the
+// storing (sign is 1) or removal (sign is -1) of the object of the
+// specified type. Logical counter is changed only for the available objects.
+func storedObjectDelta(typ objectSDK.Type, available bool, sign int64) counterDelta {
+	d := counterDelta{phy: sign}
+	if available {
+		d.logic = sign
+	}
+
+	switch typ {
+	case objectSDK.TypeTombstone:
+		d.tombstones = sign
+	case objectSDK.TypeLock:
+		d.locks = sign
+	}
+
+	return d
+}
+
+const countersLen = 4 * 8
+
+func parseObjectCounters(v []byte) ObjectCounters {
+	var c ObjectCounters
+	if len(v) != countersLen {
+		return c
+	}
+
+	c.Phy = binary.LittleEndian.Uint64(v)
+	c.Logic = binary.LittleEndian.Uint64(v[8:])
+	c.Tombstones = binary.LittleEndian.Uint64(v[16:])
+	c.Locks = binary.LittleEndian.Uint64(v[24:])
+
+	if c.Phy > c.Logic {
+		c.Garbage = c.Phy - c.Logic
+	}
+
+	return c
+}
+
+func (c ObjectCounters) marshal() []byte {
+	buf := make([]byte, countersLen)
+	binary.LittleEndian.PutUint64(buf, c.Phy)
+	binary.LittleEndian.PutUint64(buf[8:], c.Logic)
+	binary.LittleEndian.PutUint64(buf[16:], c.Tombstones)
+	binary.LittleEndian.PutUint64(buf[24:], c.Locks)
+	return buf
+}
+
+func (c ObjectCounters) isZero() bool {
+	return c.Phy == 0 && c.Logic == 0 && c.Tombstones == 0 && c.Locks == 0
+}
+
+func (c *ObjectCounters) add(d counterDelta) {
+	c.Phy = addCounter(c.Phy, d.phy)
+	c.Logic = addCounter(c.Logic, d.logic)
+	c.Tombstones = addCounter(c.Tombstones, d.tombstones)
+	c.Locks = addCounter(c.Locks, d.locks)
+
+	c.Garbage = 0
+	if c.Phy > c.Logic {
+		c.Garbage = c.Phy - c.Logic
+	}
+}
+
+func addCounter(v uint64, d int64) uint64 {
+	if d >= 0 {
+		return v + uint64(d)
+	}
+	if v > uint64(-d) {
+		return v - uint64(-d)
+	}
+	return 0
+}
+
+func containerCounterKey(cnr cid.ID) []byte {
+	key := make([]byte, sha256.Size)
+	cnr.Encode(key)
+	return key
+}
+
+// changeObjectCounters applies the delta to the counters of the container and
+// of the whole metabase. The counters handler is called with the new values.
+func (db *DB) changeObjectCounters(tx *bbolt.Tx, cnr cid.ID, d counterDelta) error {
+	c, err := changeObjectCounters(tx, cnr, d)
+	if err != nil {
+		return err
+	}
+
+	if db.countersHandler != nil {
+		txID := tx.ID()
+		tx.OnCommit(func() {
+			db.handleObjectCounters(txID, c)
+		})
+	}
+
+	return nil
+}
+
+// handleObjectCounters calls the counters handler with the values written
+// by the transaction with the specified ID. Commit handlers are executed
+// after the database lock is released, so the values of the earlier
+// transactions are ignored.
+func (db *DB) handleObjectCounters(txID int, c ObjectCounters) {
+	db.countersMtx.Lock()
+	defer db.countersMtx.Unlock()
+
+	if txID < db.countersTxID {
+		return
+	}
+
+	db.countersTxID = txID
+	db.countersHandler(c)
+}
+
+func changeObjectCounters(tx *bbolt.Tx, cnr cid.ID, d counterDelta) (ObjectCounters, error) {
+	b, err := tx.CreateBucketIfNotExists(countersBucketName)
+	if err != nil {
+		return ObjectCounters{}, fmt.Errorf("can't create counters bucket: %w", err)
+	}
+
+	var c ObjectCounters
+
+	for _, key := range [][]byte{containerCounterKey(cnr), shardCounterKey} {
+		c = parseObjectCounters(b.Get(key))
+		c.add(d)
+
+		if c.isZero() && len(key) == sha256.Size {
+			// do not keep the counters of the emptied containers
+			err = b.Delete(key)
+		} else {
+			err = b.Put(key, c.marshal())
+		}
+		if err != nil {
+			return ObjectCounters{}, fmt.Errorf("can't update object counters: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// ObjectCounters returns the object counters of the whole metabase.
+func (db *DB) ObjectCounters() (c ObjectCounters, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return c, ErrDegradedMode
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		if b := tx.Bucket(countersBucketName); b != nil {
+			c = parseObjectCounters(b.Get(shardCounterKey))
+		}
+		return nil
+	})
+
+	return c, err
+}
+
+// ContainerCounters returns the object counters of the containers
+// having at least one stored object.
+func (db *DB) ContainerCounters() (map[cid.ID]ObjectCounters, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	res := make(map[cid.ID]ObjectCounters)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(countersBucketName)
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			if len(k) != sha256.Size {
+				return nil
+			}
+
+			var cnr cid.ID
+			if err := cnr.Decode(k); err != nil {
+				return nil
+			}
+
+			res[cnr] = parseObjectCounters(v)
+			return nil
+		})
+	})
+
+	return res, err
+}
+
+// reportObjectCounters calls the counters handler with the stored values.
+func (db *DB) reportObjectCounters() {
+	if db.countersHandler == nil {
+		return
+	}
+
+	var (
+		c    ObjectCounters
+		txID int
+	)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		txID = tx.ID()
+		if b := tx.Bucket(countersBucketName); b != nil {
+			c = parseObjectCounters(b.Get(shardCounterKey))
+		}
+		return nil
+	})
+	if err == nil {
+		db.handleObjectCounters(txID, c)
+	}
+}
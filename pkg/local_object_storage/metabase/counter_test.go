@@ -0,0 +1,120 @@
+package meta_test
+
+import (
+	"sync"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+type countersHandler struct {
+	mtx sync.Mutex
+	c   meta.ObjectCounters
+}
+
+func (h *countersHandler) handle(c meta.ObjectCounters) {
+	h.mtx.Lock()
+	h.c = c
+	h.mtx.Unlock()
+}
+
+func TestDB_ObjectCounters(t *testing.T) {
+	var h countersHandler
+	db := newDB(t, meta.WithCountersHandler(h.handle))
+
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+
+	check := func(t *testing.T, exp meta.ObjectCounters, expCnr map[cid.ID]meta.ObjectCounters) {
+		c, err := db.ObjectCounters()
+		require.NoError(t, err)
+		require.Equal(t, exp, c)
+
+		h.mtx.Lock()
+		require.Equal(t, exp, h.c)
+		h.mtx.Unlock()
+
+		cc, err := db.ContainerCounters()
+		require.NoError(t, err)
+		require.Equal(t, expCnr, cc)
+	}
+
+	check(t, meta.ObjectCounters{}, map[cid.ID]meta.ObjectCounters{})
+
+	regular := make([]*objectSDK.Object, 3)
+	for i := range regular {
+		regular[i] = generateObjectWithCID(t, cnr1)
+		require.NoError(t, putBig(db, regular[i]))
+	}
+
+	tomb := generateObjectWithCID(t, cnr1)
+	tomb.SetType(objectSDK.TypeTombstone)
+	require.NoError(t, putBig(db, tomb))
+
+	lock := generateObjectWithCID(t, cnr1)
+	lock.SetType(objectSDK.TypeLock)
+	require.NoError(t, putBig(db, lock))
+
+	other := generateObjectWithCID(t, cnr2)
+	require.NoError(t, putBig(db, other))
+
+	// already stored object is not counted twice
+	require.NoError(t, putBig(db, other))
+
+	check(t, meta.ObjectCounters{Phy: 6, Logic: 6, Tombstones: 1, Locks: 1}, map[cid.ID]meta.ObjectCounters{
+		cnr1: {Phy: 5, Logic: 5, Tombstones: 1, Locks: 1},
+		cnr2: {Phy: 1, Logic: 1},
+	})
+
+	t.Run("inhume", func(t *testing.T) {
+		tombAddr := objectCore.AddressOf(tomb)
+
+		require.NoError(t, metaInhume(db, objectCore.AddressOf(regular[0]), tombAddr))
+		// repeated inhuming does not change the counters
+		require.NoError(t, metaInhume(db, objectCore.AddressOf(regular[0]), tombAddr))
+
+		var prm meta.InhumePrm
+		prm.SetAddresses(objectCore.AddressOf(regular[1]), objectCore.AddressOf(regular[0]))
+		prm.SetGCMark()
+
+		_, err := db.Inhume(prm)
+		require.NoError(t, err)
+
+		// not stored object is not counted
+		require.NoError(t, metaInhume(db, objectCore.AddressOf(generateObjectWithCID(t, cnr1)), tombAddr))
+
+		check(t, meta.ObjectCounters{Phy: 6, Logic: 4, Tombstones: 1, Locks: 1, Garbage: 2}, map[cid.ID]meta.ObjectCounters{
+			cnr1: {Phy: 5, Logic: 3, Tombstones: 1, Locks: 1, Garbage: 2},
+			cnr2: {Phy: 1, Logic: 1},
+		})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, metaDelete(db,
+			objectCore.AddressOf(regular[0]),
+			objectCore.AddressOf(regular[1]),
+			objectCore.AddressOf(lock)))
+
+		check(t, meta.ObjectCounters{Phy: 3, Logic: 3, Tombstones: 1}, map[cid.ID]meta.ObjectCounters{
+			cnr1: {Phy: 2, Logic: 2, Tombstones: 1},
+			cnr2: {Phy: 1, Logic: 1},
+		})
+
+		// counters of the emptied container are removed
+		require.NoError(t, metaDelete(db, objectCore.AddressOf(other)))
+
+		check(t, meta.ObjectCounters{Phy: 2, Logic: 2, Tombstones: 1}, map[cid.ID]meta.ObjectCounters{
+			cnr1: {Phy: 2, Logic: 2, Tombstones: 1},
+		})
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		require.NoError(t, db.Reset())
+
+		check(t, meta.ObjectCounters{}, map[cid.ID]meta.ObjectCounters{})
+	})
+}
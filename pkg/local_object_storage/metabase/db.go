@@ -12,6 +12,7 @@ import (
 	"time"
 
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -43,6 +44,10 @@ type DB struct {
 	boltDB *bbolt.DB
 
 	initialized bool
+
+	// ID of the last transaction which counters were passed to the handler
+	countersMtx  sync.Mutex
+	countersTxID int
 }
 
 // Option is an option of DB constructor.
@@ -59,6 +64,8 @@ type cfg struct {
 	log *logger.Logger
 
 	epochState EpochState
+
+	countersHandler CountersHandler
 }
 
 func defaultCfg() *cfg {
@@ -103,6 +110,10 @@ func New(opts ...Option) *DB {
 				matchSlow:   stringCommonPrefixMatcher,
 				matchBucket: stringCommonPrefixMatcherBucket,
 			},
+			objectCore.MatchNumGT: numericMatcher(objectCore.MatchNumGT),
+			objectCore.MatchNumGE: numericMatcher(objectCore.MatchNumGE),
+			objectCore.MatchNumLT: numericMatcher(objectCore.MatchNumLT),
+			objectCore.MatchNumLE: numericMatcher(objectCore.MatchNumLE),
 		},
 	}
 }
@@ -242,6 +253,71 @@ func stringCommonPrefixMatcherBucket(b *bbolt.Bucket, fKey string, fVal string,
 	return nil
 }
 
+// numericMatcher returns matcher for the numeric match type.
+// Its matchBucket expects numeric index bucket.
+func numericMatcher(m object.SearchMatchType) matcher {
+	// cmpOK checks the result of the comparison of the object
+	// value with the filter one.
+	cmpOK := func(cmp int) bool {
+		switch m {
+		case objectCore.MatchNumGT:
+			return cmp > 0
+		case objectCore.MatchNumGE:
+			return cmp >= 0
+		case objectCore.MatchNumLT:
+			return cmp < 0
+		default:
+			return cmp <= 0
+		}
+	}
+
+	return matcher{
+		matchSlow: func(key string, objVal []byte, filterVal string) bool {
+			objKey, ok := numericKey(key, stringifyValue(key, objVal))
+			if !ok {
+				return false
+			}
+
+			filterKey, ok := numericKey(key, filterVal)
+			if !ok {
+				return false
+			}
+
+			return cmpOK(bytes.Compare(objKey, filterKey))
+		},
+		matchBucket: func(b *bbolt.Bucket, fKey string, fVal string, f func([]byte, []byte) error) error {
+			filterKey, ok := numericKey(fKey, fVal)
+			if !ok {
+				return nil
+			}
+
+			c := b.Cursor()
+
+			var k, v []byte
+			if m == objectCore.MatchNumGT || m == objectCore.MatchNumGE {
+				k, v = c.Seek(filterKey)
+			} else {
+				k, v = c.First()
+			}
+
+			for ; k != nil; k, v = c.Next() {
+				if cmp := bytes.Compare(k, filterKey); !cmpOK(cmp) {
+					if cmp > 0 {
+						break // keys are ordered, no more matches
+					}
+					continue
+				}
+
+				if err := f(k, v); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func unknownMatcher(_ string, _ []byte, _ string) bool {
 	return false
 }
@@ -337,3 +413,12 @@ func WithEpochState(s EpochState) Option {
 		c.epochState = s
 	}
 }
+
+// WithCountersHandler returns option to specify a handler of the object
+// counters. Handler is called with the new values every time the counters
+// are changed and must not block.
+func WithCountersHandler(h CountersHandler) Option {
+	return func(c *cfg) {
+		c.countersHandler = h
+	}
+}
@@ -2,6 +2,7 @@ package meta
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 
@@ -19,7 +20,15 @@ type DeletePrm struct {
 }
 
 // DeleteRes groups the resulting values of Delete operation.
-type DeleteRes struct{}
+type DeleteRes struct {
+	releasedPayloads [][sha256.Size]byte
+}
+
+// ReleasedPayloads returns SHA256 checksums of the deduplicated payloads
+// which are not referenced by any object after the deletion.
+func (r DeleteRes) ReleasedPayloads() [][sha256.Size]byte {
+	return r.releasedPayloads
+}
 
 // SetAddresses is a Delete option to set the addresses of the objects to delete.
 //
@@ -43,8 +52,11 @@ func (db *DB) Delete(prm DeletePrm) (DeleteRes, error) {
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
 
+	var res DeleteRes
+
 	err := db.boltDB.Update(func(tx *bbolt.Tx) error {
-		return db.deleteGroup(tx, prm.addrs)
+		res = DeleteRes{}
+		return db.deleteGroup(tx, prm.addrs, &res)
 	})
 	if err == nil {
 		for i := range prm.addrs {
@@ -53,15 +65,24 @@ func (db *DB) Delete(prm DeletePrm) (DeleteRes, error) {
 				storagelog.OpField("metabase DELETE"))
 		}
 	}
-	return DeleteRes{}, err
+	return res, err
 }
 
-func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address) error {
+func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address, res *DeleteRes) error {
 	refCounter := make(referenceCounter, len(addrs))
 	currEpoch := db.epochState.CurrentEpoch()
 
 	for i := range addrs {
-		err := db.delete(tx, addrs[i], refCounter, currEpoch)
+		sum, released, err := releasePayloadRef(tx, addrs[i].Container(), objectKey(addrs[i].Object()))
+		if err != nil {
+			return err
+		}
+
+		if released {
+			res.releasedPayloads = append(res.releasedPayloads, sum)
+		}
+
+		err = db.delete(tx, addrs[i], refCounter, currEpoch)
 		if err != nil {
 			return err // maybe log and continue?
 		}
@@ -80,10 +101,15 @@ func (db *DB) deleteGroup(tx *bbolt.Tx, addrs []oid.Address) error {
 }
 
 func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter, currEpoch uint64) error {
-	// remove record from the garbage bucket
+	addrKey := addressKey(addr)
 	garbageBKT := tx.Bucket(garbageBucketName)
+
+	// object status is checked before the GC mark is removed
+	available := inGraveyardWithKey(addrKey, tx.Bucket(graveyardBucketName), garbageBKT) == 0
+
+	// remove record from the garbage bucket
 	if garbageBKT != nil {
-		err := garbageBKT.Delete(addressKey(addr))
+		err := garbageBKT.Delete(addrKey)
 		if err != nil {
 			return fmt.Errorf("could not remove from garbage bucket: %w", err)
 		}
@@ -119,7 +145,12 @@ func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter
 	}
 
 	// remove object
-	return db.deleteObject(tx, obj, false)
+	err = db.deleteObject(tx, obj, false)
+	if err != nil {
+		return err
+	}
+
+	return db.changeObjectCounters(tx, addr.Container(), storedObjectDelta(obj.Type(), available, -1))
 }
 
 func (db *DB) deleteObject(
@@ -11,3 +11,7 @@ import (
 func IsErrRemoved(err error) bool {
 	return errors.As(err, new(apistatus.ObjectAlreadyRemoved))
 }
+
+// ErrDegradedMode is returned when the metabase is not available
+// due to the "degraded" mode.
+var ErrDegradedMode = errors.New("metabase is in degraded mode")
@@ -145,10 +145,11 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 			}
 
 			obj, err := db.get(tx, prm.target[i], false, true, currEpoch)
+			stored := err == nil
 
 			// if object is stored and it is regular object then update bucket
 			// with container size estimations
-			if err == nil && obj.Type() == object.TypeRegular {
+			if stored && obj.Type() == object.TypeRegular {
 				err := changeContainerSize(tx, cnr, obj.PayloadSize(), false)
 				if err != nil {
 					return err
@@ -157,6 +158,10 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 
 			targetKey := addressKey(prm.target[i])
 
+			// stored object stops being available logically
+			// only when it is inhumed for the first time
+			available := stored && inGraveyardWithKey(targetKey, tx.Bucket(graveyardBucketName), garbageBKT) == 0
+
 			if prm.tomb != nil {
 				targetIsTomb := false
 
@@ -197,6 +202,13 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 				return err
 			}
 
+			if available {
+				err = db.changeObjectCounters(tx, cnr, counterDelta{logic: -1})
+				if err != nil {
+					return err
+				}
+			}
+
 			if prm.lockObjectHandling {
 				// do not perform lock check if
 				// it was already called
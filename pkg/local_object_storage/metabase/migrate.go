@@ -0,0 +1,511 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// migration upgrades the metabase from the version `from` to the next one.
+type migration struct {
+	from        uint64
+	description string
+
+	// batch migrates at most size items starting after the cursor in the
+	// single transaction. Nil cursor means the beginning of the migration.
+	// Returns the number of processed items and the next cursor, nil next
+	// cursor means that the migration is finished.
+	batch func(tx *bbolt.Tx, cursor []byte, size int) (int, []byte, error)
+}
+
+// migrations contains all the metabase migrations ordered by the version,
+// migration with index i upgrades the metabase from version i to i+1.
+var migrations = []migration{
+	{
+		from:        0,
+		description: "index numeric attributes, creation epoch and payload length of the stored objects",
+		batch:       migrateNumericIndexes,
+	},
+	{
+		from:        1,
+		description: "count physically stored and logically available objects",
+		batch:       migrateObjectCounters,
+	},
+	{
+		from:        2,
+		description: "rebuild creation epoch and payload length numeric indexes with unsigned keys",
+		batch:       migrateUnsignedNumericIndexes,
+	},
+}
+
+// migrationKey is a key in the shard info bucket which stores the cursor
+// of the unfinished migration, so that it can be resumed after a restart.
+var migrationKey = []byte("migration")
+
+// defaultMigrationBatchSize is the default number of items processed
+// in a single transaction of the migration.
+const defaultMigrationBatchSize = 1000
+
+// MigrationInfo describes the metabase migration.
+type MigrationInfo struct {
+	// Version of the metabase to upgrade from. The migration
+	// upgrades the metabase to the next version.
+	From uint64
+	// Human-readable description of the migration.
+	Description string
+	// Number of processed items, zero in the dry-run mode.
+	Processed uint64
+	// Indicates that the migration was interrupted and is resumed.
+	Resumed bool
+}
+
+// MigratePrm groups the parameters of Migrate operation.
+type MigratePrm struct {
+	batchSize int
+	dryRun    bool
+}
+
+// MigrateRes groups the resulting values of Migrate operation.
+type MigrateRes struct {
+	from, to   uint64
+	migrations []MigrationInfo
+}
+
+// SetBatchSize sets the number of items processed in a single transaction.
+// Non-positive value means the default size.
+func (p *MigratePrm) SetBatchSize(sz int) {
+	p.batchSize = sz
+}
+
+// SetDryRun sets the flag to only list the pending migrations without
+// modifying the metabase.
+func (p *MigratePrm) SetDryRun(dryRun bool) {
+	p.dryRun = dryRun
+}
+
+// FromVersion returns the metabase version before the migration.
+func (r MigrateRes) FromVersion() uint64 {
+	return r.from
+}
+
+// ToVersion returns the metabase version after the migration.
+func (r MigrateRes) ToVersion() uint64 {
+	return r.to
+}
+
+// Migrations returns the list of the performed migrations
+// or, in the dry-run mode, the pending ones.
+func (r MigrateRes) Migrations() []MigrationInfo {
+	return r.migrations
+}
+
+// ErrReadOnlyMode is returned when the metabase can't be
+// modified due to the "read-only" mode.
+var ErrReadOnlyMode = errors.New("metabase is in read-only mode")
+
+// Migrate upgrades the metabase to the current version in place.
+//
+// Each migration is performed in batches, the progress is saved after
+// every batch, so an interrupted migration is resumed on the next call.
+//
+// Returns an error if the metabase has a newer version than the supported one.
+// Returns ErrReadOnlyMode if the metabase is opened in read-only mode
+// and the migration is not a dry run.
+func (db *DB) Migrate(prm MigratePrm) (MigrateRes, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if !prm.dryRun && (db.mode.NoMetabase() || db.mode.ReadOnly() || db.boltDB.IsReadOnly()) {
+		return MigrateRes{}, ErrReadOnlyMode
+	}
+
+	batchSize := prm.batchSize
+	if batchSize <= 0 {
+		batchSize = defaultMigrationBatchSize
+	}
+
+	return db.migrate(batchSize, prm.dryRun)
+}
+
+func (db *DB) migrate(batchSize int, dryRun bool) (MigrateRes, error) {
+	var (
+		stored uint64
+		cursor []byte
+	)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		stored = db.storedVersion(tx)
+		if b := tx.Bucket(shardInfoBucket); b != nil {
+			cursor = cloneBytes(b.Get(migrationKey))
+		}
+		return nil
+	})
+	if err != nil {
+		return MigrateRes{}, err
+	}
+
+	if stored > version {
+		return MigrateRes{}, fmt.Errorf("metabase version %d is newer than the supported one %d", stored, version)
+	}
+
+	res := MigrateRes{from: stored, to: stored}
+
+	for v := stored; v < version; v++ {
+		m := migrations[v]
+		info := MigrationInfo{
+			From:        v,
+			Description: m.description,
+			Resumed:     cursor != nil,
+		}
+
+		if dryRun {
+			res.migrations = append(res.migrations, info)
+			cursor = nil
+			continue
+		}
+
+		db.log.Info("migrating metabase",
+			zap.Uint64("from", v),
+			zap.Uint64("to", v+1),
+			zap.String("description", m.description),
+			zap.Bool("resumed", info.Resumed))
+
+		for {
+			var n int
+
+			err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+				var err error
+
+				n, cursor, err = m.batch(tx, cursor, batchSize)
+				if err != nil {
+					return err
+				}
+
+				if cursor != nil {
+					return saveMigrationCursor(tx, cursor)
+				}
+
+				b := tx.Bucket(shardInfoBucket)
+				if b != nil {
+					if err := b.Delete(migrationKey); err != nil {
+						return err
+					}
+				}
+				return updateVersion(tx, v+1)
+			})
+			if err != nil {
+				return res, fmt.Errorf("can't migrate metabase from version %d: %w", v, err)
+			}
+
+			info.Processed += uint64(n)
+
+			db.log.Debug("metabase migration batch is processed",
+				zap.Uint64("from", v),
+				zap.Uint64("processed", info.Processed))
+
+			if cursor == nil {
+				break
+			}
+		}
+
+		res.to = v + 1
+		res.migrations = append(res.migrations, info)
+
+		db.log.Info("metabase is migrated",
+			zap.Uint64("version", v+1),
+			zap.Uint64("processed", info.Processed))
+	}
+
+	if dryRun {
+		res.to = version
+	}
+
+	return res, nil
+}
+
+func saveMigrationCursor(tx *bbolt.Tx, cursor []byte) error {
+	b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+	if err != nil {
+		return fmt.Errorf("can't create auxilliary bucket: %w", err)
+	}
+	return b.Put(migrationKey, cursor)
+}
+
+// objectsCursor returns the cursor of the migration processing objects
+// bucket by bucket. Cursor consists of the 2-byte big-endian length
+// of the object bucket name, the name itself and the last processed key.
+func objectsCursor(bucketName, lastKey []byte) []byte {
+	cursor := make([]byte, 2, 2+len(bucketName)+len(lastKey))
+	binary.BigEndian.PutUint16(cursor, uint16(len(bucketName)))
+	cursor = append(cursor, bucketName...)
+	return append(cursor, lastKey...)
+}
+
+// parseObjectsCursor parses the cursor formed by objectsCursor.
+func parseObjectsCursor(cursor []byte) ([]byte, []byte, error) {
+	if cursor == nil {
+		return nil, nil, nil
+	}
+
+	if len(cursor) < 2 || len(cursor) < 2+int(binary.BigEndian.Uint16(cursor)) {
+		return nil, nil, errors.New("invalid migration cursor")
+	}
+
+	l := 2 + int(binary.BigEndian.Uint16(cursor))
+	return cursor[2:l], cursor[l:], nil
+}
+
+// migrateNumericIndexes builds numeric indexes of the objects stored
+// before version 1.
+func migrateNumericIndexes(tx *bbolt.Tx, cursor []byte, size int) (int, []byte, error) {
+	return indexObjects(tx, cursor, size, updateNumericIndexes)
+}
+
+// migrateUnsignedNumericIndexes rebuilds creation epoch and payload length
+// numeric indexes which had signed keys before version 3.
+func migrateUnsignedNumericIndexes(tx *bbolt.Tx, cursor []byte, size int) (int, []byte, error) {
+	if cursor == nil {
+		// indexes are built from scratch
+		var names [][]byte
+
+		err := tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+			if bytes.HasSuffix(name, []byte(numericAttributePostfix+v2object.FilterHeaderCreationEpoch)) ||
+				bytes.HasSuffix(name, []byte(numericAttributePostfix+v2object.FilterHeaderPayloadLength)) {
+				names = append(names, cloneBytes(name))
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+
+		for i := range names {
+			if err := tx.DeleteBucket(names[i]); err != nil {
+				return 0, nil, fmt.Errorf("can't remove numeric index bucket: %w", err)
+			}
+		}
+	}
+
+	return indexObjects(tx, cursor, size, updateHeaderNumericIndexes)
+}
+
+// indexObjects adds the stored objects and their parents to the indexes
+// with the update function.
+func indexObjects(tx *bbolt.Tx, cursor []byte, size int,
+	update func(*bbolt.Tx, *objectSDK.Object, updateIndexItemFunc) error) (int, []byte, error) {
+	bucketName, lastKey, err := parseObjectsCursor(cursor)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		n       int
+		objects []*objectSDK.Object
+	)
+
+	for {
+		// The cursor bucket is processed again only if it is not finished.
+		next := nextObjectBucket(tx, bucketName, lastKey != nil)
+		if next == nil {
+			return n, nil, nil
+		}
+		if !bytes.Equal(next, bucketName) {
+			lastKey = nil
+		}
+		bucketName = next
+
+		// Objects are decoded before indexing, because the index buckets
+		// are created in the same transaction.
+		objects, lastKey = readObjects(tx.Bucket(bucketName), lastKey, size-n, objects[:0])
+		for i := range objects {
+			err := update(tx, objects[i], putFKBTIndexItem)
+			if err != nil {
+				return n, nil, err
+			}
+
+			if par := objects[i].Parent(); par != nil {
+				if _, ok := par.ID(); ok {
+					err := update(tx, par, putFKBTIndexItem)
+					if err != nil {
+						return n, nil, err
+					}
+				}
+			}
+		}
+
+		n += len(objects)
+		if n >= size {
+			return n, objectsCursor(bucketName, lastKey), nil
+		}
+
+		// Less objects than requested are read, so the bucket is finished.
+		lastKey = nil
+	}
+}
+
+// migrateObjectCounters counts the objects stored before version 2.
+func migrateObjectCounters(tx *bbolt.Tx, cursor []byte, size int) (int, []byte, error) {
+	bucketName, lastKey, err := parseObjectsCursor(cursor)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if cursor == nil {
+		// counters are built from scratch
+		err := tx.DeleteBucket(countersBucketName)
+		if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+			return 0, nil, fmt.Errorf("can't remove counters bucket: %w", err)
+		}
+	}
+
+	var (
+		n         int
+		cnr       cid.ID
+		graveyard = tx.Bucket(graveyardBucketName)
+		garbage   = tx.Bucket(garbageBucketName)
+	)
+
+	for {
+		next := nextObjectBucket(tx, bucketName, lastKey != nil)
+		if next == nil {
+			return n, nil, nil
+		}
+		if !bytes.Equal(next, bucketName) {
+			lastKey = nil
+		}
+		bucketName = next
+
+		b58CID, postfix := parseContainerIDWithPostfix(&cnr, bucketName)
+
+		var (
+			d    counterDelta
+			read int
+		)
+
+		c := tx.Bucket(bucketName).Cursor()
+		k, _ := c.First()
+		if lastKey != nil {
+			k, _ = c.Seek(lastKey)
+			if k != nil && bytes.Equal(k, lastKey) {
+				k, _ = c.Next()
+			}
+		}
+
+		for ; k != nil && n+read < size; k, _ = c.Next() {
+			lastKey = k
+			read++
+
+			addrKey := make([]byte, 0, len(b58CID)+1+len(k))
+			addrKey = append(addrKey, b58CID...)
+			addrKey = append(addrKey, '/')
+			addrKey = append(addrKey, k...)
+
+			d.phy++
+			if inGraveyardWithKey(addrKey, graveyard, garbage) == 0 {
+				d.logic++
+			}
+
+			switch postfix {
+			case tombstonePostfix:
+				d.tombstones++
+			case bucketNameSuffixLockers:
+				d.locks++
+			}
+		}
+
+		// Counters bucket is changed after the cursor is not used anymore.
+		lastKey = cloneBytes(lastKey)
+
+		if read != 0 {
+			if _, err := changeObjectCounters(tx, cnr, d); err != nil {
+				return n, nil, err
+			}
+		}
+
+		n += read
+		if n >= size {
+			return n, objectsCursor(bucketName, lastKey), nil
+		}
+
+		lastKey = nil
+	}
+}
+
+// nextObjectBucket returns the name of the first bucket with objects
+// which goes after the specified one. If inclusive is true, the specified
+// bucket is returned too.
+func nextObjectBucket(tx *bbolt.Tx, after []byte, inclusive bool) []byte {
+	var containerID cid.ID
+
+	c := tx.Cursor()
+	name, _ := c.First()
+	if after != nil {
+		name, _ = c.Seek(after)
+		if name != nil && !inclusive && bytes.Equal(name, after) {
+			name, _ = c.Next()
+		}
+	}
+
+	for ; name != nil; name, _ = c.Next() {
+		b58CID, postfix := parseContainerIDWithPostfix(&containerID, name)
+		if b58CID == nil {
+			continue
+		}
+
+		switch postfix {
+		case
+			"",
+			storageGroupPostfix,
+			bucketNameSuffixLockers,
+			tombstonePostfix:
+			return cloneBytes(name)
+		}
+	}
+	return nil
+}
+
+// readObjects decodes at most count objects following the specified key
+// in the bucket. Returns the key of the last read item, undecodable items
+// are skipped.
+func readObjects(b *bbolt.Bucket, after []byte, count int, to []*objectSDK.Object) ([]*objectSDK.Object, []byte) {
+	if b == nil {
+		return to, nil
+	}
+
+	c := b.Cursor()
+	k, v := c.First()
+	if after != nil {
+		k, v = c.Seek(after)
+		if k != nil && bytes.Equal(k, after) {
+			k, v = c.Next()
+		}
+	}
+
+	var last []byte
+	for ; k != nil && len(to) < count; k, v = c.Next() {
+		last = k
+
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(v); err != nil {
+			continue
+		}
+
+		to = append(to, obj)
+	}
+
+	return to, cloneBytes(last)
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
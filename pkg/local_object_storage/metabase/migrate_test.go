@@ -0,0 +1,379 @@
+package meta
+
+import (
+	"bytes"
+	"math"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestMigrations(t *testing.T) {
+	require.Len(t, migrations, version)
+	for i := range migrations {
+		require.EqualValues(t, i, migrations[i].from)
+		require.NotEmpty(t, migrations[i].description)
+	}
+}
+
+func TestMigrateNumericIndexes(t *testing.T) {
+	const objCount = 10
+
+	cnr := cidtest.ID()
+	path := filepath.Join(t.TempDir(), "meta")
+
+	db := New(WithPath(path), WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	for i := 0; i < objCount; i++ {
+		obj := objectSDK.New()
+		obj.SetID(oidtest.ID())
+		obj.SetOwnerID(usertest.ID())
+		obj.SetContainerID(cnr)
+		obj.SetPayloadChecksum(checksumtest.Checksum())
+
+		var a objectSDK.Attribute
+		a.SetKey("n")
+		a.SetValue(strconv.Itoa(i))
+		obj.SetAttributes(a)
+
+		if i%2 == 0 {
+			obj.SetType(objectSDK.TypeTombstone)
+		}
+
+		var prm PutPrm
+		prm.SetObject(obj)
+		_, err := db.Put(prm)
+		require.NoError(t, err)
+	}
+
+	selectGE := func(t *testing.T, n int) int {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter("n", strconv.Itoa(n), objectCore.MatchNumGE)
+
+		var prm SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		return len(res.AddressList())
+	}
+
+	// downgrade removes numeric indexes and sets version 0 as it was before them.
+	downgrade := func(t *testing.T) {
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			var names [][]byte
+			err := tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+				if bytes.Contains(name, []byte(numericAttributePostfix)) {
+					names = append(names, cloneBytes(name))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			for i := range names {
+				if err := tx.DeleteBucket(names[i]); err != nil {
+					return err
+				}
+			}
+			return updateVersion(tx, 0)
+		}))
+		require.Equal(t, 0, selectGE(t, 0))
+	}
+
+	require.Equal(t, 5, selectGE(t, 5))
+
+	t.Run("dry run", func(t *testing.T) {
+		downgrade(t)
+
+		var prm MigratePrm
+		prm.SetDryRun(true)
+
+		res, err := db.Migrate(prm)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.FromVersion())
+		require.EqualValues(t, version, res.ToVersion())
+		require.Len(t, res.Migrations(), version)
+		require.Zero(t, res.Migrations()[0].Processed)
+
+		require.Equal(t, 0, selectGE(t, 0))
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		for _, batchSize := range []int{1, 3, objCount, objCount + 1} {
+			downgrade(t)
+
+			var prm MigratePrm
+			prm.SetBatchSize(batchSize)
+
+			res, err := db.Migrate(prm)
+			require.NoError(t, err)
+			require.EqualValues(t, 0, res.FromVersion())
+			require.EqualValues(t, version, res.ToVersion())
+			require.Len(t, res.Migrations(), version)
+			require.EqualValues(t, objCount, res.Migrations()[0].Processed)
+
+			require.Equal(t, objCount, selectGE(t, 0))
+			require.Equal(t, 5, selectGE(t, 5))
+
+			// Nothing is left to migrate.
+			res, err = db.Migrate(prm)
+			require.NoError(t, err)
+			require.Len(t, res.Migrations(), 0)
+		}
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		downgrade(t)
+
+		// Interrupt the migration after the first batch.
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			n, cursor, err := migrateNumericIndexes(tx, nil, 3)
+			require.Equal(t, 3, n)
+			require.NotNil(t, cursor)
+			if err != nil {
+				return err
+			}
+			return saveMigrationCursor(tx, cursor)
+		}))
+		require.Equal(t, 3, selectGE(t, 0))
+		require.NoError(t, db.Close())
+
+		// Migration is continued on the next start.
+		require.NoError(t, db.Open(false))
+
+		var prm MigratePrm
+		prm.SetDryRun(true)
+
+		res, err := db.Migrate(prm)
+		require.NoError(t, err)
+		require.True(t, res.Migrations()[0].Resumed)
+
+		require.NoError(t, db.Init())
+		require.Equal(t, objCount, selectGE(t, 0))
+
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Nil(t, tx.Bucket(shardInfoBucket).Get(migrationKey))
+			require.EqualValues(t, version, db.storedVersion(tx))
+			return nil
+		}))
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		downgrade(t)
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(true))
+
+		_, err := db.Migrate(MigratePrm{})
+		require.ErrorIs(t, err, ErrReadOnlyMode)
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.Equal(t, objCount, selectGE(t, 0))
+	})
+
+	t.Run("newer version", func(t *testing.T) {
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return updateVersion(tx, version+1)
+		}))
+
+		_, err := db.Migrate(MigratePrm{})
+		require.Error(t, err)
+	})
+
+	require.NoError(t, db.Close())
+}
+
+func TestMigrateObjectCounters(t *testing.T) {
+	const objCount = 10
+
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+	path := filepath.Join(t.TempDir(), "meta")
+
+	db := New(WithPath(path), WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	var tombAddr oid.Address
+	tombAddr.SetContainer(cnr1)
+	tombAddr.SetObject(oidtest.ID())
+
+	for i := 0; i < objCount; i++ {
+		obj := objectSDK.New()
+		obj.SetID(oidtest.ID())
+		obj.SetOwnerID(usertest.ID())
+		obj.SetContainerID(cnr1)
+		obj.SetPayloadChecksum(checksumtest.Checksum())
+
+		switch i % 4 {
+		case 1:
+			obj.SetType(objectSDK.TypeTombstone)
+		case 2:
+			obj.SetType(objectSDK.TypeLock)
+		case 3:
+			obj.SetContainerID(cnr2)
+		}
+
+		var prm PutPrm
+		prm.SetObject(obj)
+		_, err := db.Put(prm)
+		require.NoError(t, err)
+
+		if i%3 == 0 {
+			var prm InhumePrm
+			prm.SetAddresses(objectCore.AddressOf(obj))
+			prm.SetTombstoneAddress(tombAddr)
+			prm.SetForceGCMark()
+
+			_, err := db.Inhume(prm)
+			require.NoError(t, err)
+		}
+	}
+
+	expected, err := db.ObjectCounters()
+	require.NoError(t, err)
+	require.Equal(t, ObjectCounters{Phy: 10, Logic: 6, Tombstones: 3, Locks: 2, Garbage: 4}, expected)
+
+	expectedCnr, err := db.ContainerCounters()
+	require.NoError(t, err)
+	require.Len(t, expectedCnr, 2)
+
+	for _, batchSize := range []int{1, 3, objCount, objCount + 1} {
+		// downgrade removes the counters and sets version 1 as it was before them
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			if err := tx.DeleteBucket(countersBucketName); err != nil {
+				return err
+			}
+			return updateVersion(tx, 1)
+		}))
+
+		var prm MigratePrm
+		prm.SetBatchSize(batchSize)
+
+		res, err := db.Migrate(prm)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, res.FromVersion())
+		require.EqualValues(t, version, res.ToVersion())
+		require.Len(t, res.Migrations(), version-1)
+		require.EqualValues(t, objCount, res.Migrations()[0].Processed)
+
+		c, err := db.ObjectCounters()
+		require.NoError(t, err)
+		require.Equal(t, expected, c)
+
+		cc, err := db.ContainerCounters()
+		require.NoError(t, err)
+		require.Equal(t, expectedCnr, cc)
+	}
+
+	require.NoError(t, db.Close())
+}
+
+func TestMigrateUnsignedNumericIndexes(t *testing.T) {
+	const objCount = 10
+
+	cnr := cidtest.ID()
+
+	db := New(WithPath(filepath.Join(t.TempDir(), "meta")), WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+
+	for i := 0; i < objCount; i++ {
+		obj := objectSDK.New()
+		obj.SetID(oidtest.ID())
+		obj.SetOwnerID(usertest.ID())
+		obj.SetContainerID(cnr)
+		obj.SetPayloadChecksum(checksumtest.Checksum())
+		obj.SetCreationEpoch(uint64(i))
+		obj.SetPayloadSize(math.MaxUint64 - uint64(i))
+
+		var prm PutPrm
+		prm.SetObject(obj)
+		_, err := db.Put(prm)
+		require.NoError(t, err)
+	}
+
+	selectNum := func(t *testing.T, key, val string, m objectSDK.SearchMatchType) int {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter(key, val, m)
+
+		var prm SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		return len(res.AddressList())
+	}
+
+	check := func(t *testing.T) {
+		require.Equal(t, 5, selectNum(t, v2object.FilterHeaderCreationEpoch, "5", objectCore.MatchNumGE))
+		require.Equal(t, 3, selectNum(t, v2object.FilterHeaderPayloadLength, "18446744073709551613", objectCore.MatchNumGE))
+	}
+
+	check(t)
+
+	for _, batchSize := range []int{1, 3, objCount, objCount + 1} {
+		// downgrade replaces the keys with the signed ones as they were
+		// before version 3, an unsigned key has the same bytes as
+		// the signed key of the value with the flipped sign bit.
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			for _, attr := range []string{v2object.FilterHeaderCreationEpoch, v2object.FilterHeaderPayloadLength} {
+				b := tx.Bucket(numericAttributeBucketName(cnr, attr))
+				require.NotNil(t, b)
+
+				var keys [][]byte
+				require.NoError(t, b.ForEach(func(k, _ []byte) error {
+					keys = append(keys, cloneBytes(k))
+					return nil
+				}))
+
+				for _, k := range keys {
+					ids := b.Bucket(k)
+					require.NotNil(t, ids)
+
+					signed := cloneBytes(k)
+					signed[0] ^= 0x80
+
+					sb, err := b.CreateBucket(signed)
+					require.NoError(t, err)
+					require.NoError(t, ids.ForEach(func(id, v []byte) error {
+						return sb.Put(id, v)
+					}))
+					require.NoError(t, b.DeleteBucket(k))
+				}
+			}
+			return updateVersion(tx, 2)
+		}))
+
+		var prm MigratePrm
+		prm.SetBatchSize(batchSize)
+
+		res, err := db.Migrate(prm)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.FromVersion())
+		require.EqualValues(t, version, res.ToVersion())
+		require.Len(t, res.Migrations(), 1)
+		require.EqualValues(t, objCount, res.Migrations()[0].Processed)
+
+		check(t)
+	}
+
+	require.NoError(t, db.Close())
+}
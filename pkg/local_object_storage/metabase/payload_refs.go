@@ -0,0 +1,140 @@
+package meta
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// AddPayloadRef references the deduplicated payload with the given SHA256
+// checksum from the object. Repeated references from the same object are
+// ignored. Returns true if the reference has been added.
+func (db *DB) AddPayloadRef(addr oid.Address, sum [sha256.Size]byte) (bool, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	var added bool
+
+	err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+		objBkt, err := tx.CreateBucketIfNotExists(payloadRefBucketName(addr.Container()))
+		if err != nil {
+			return fmt.Errorf("could not create payload reference bucket: %w", err)
+		}
+
+		key := objectKey(addr.Object())
+		if objBkt.Get(key) != nil {
+			return nil
+		}
+
+		if err := objBkt.Put(key, sum[:]); err != nil {
+			return fmt.Errorf("could not save payload reference: %w", err)
+		}
+
+		refsBkt, err := tx.CreateBucketIfNotExists(payloadRefsBucketName)
+		if err != nil {
+			return fmt.Errorf("could not create payload references bucket: %w", err)
+		}
+
+		added = true
+
+		return refsBkt.Put(sum[:], refsValue(readRefs(refsBkt, sum)+1))
+	})
+
+	return added && err == nil, err
+}
+
+// RemovePayloadRef removes the reference of the object to the deduplicated
+// payload. Returns the checksum of the payload and true if the payload is not
+// referenced anymore. Missing reference is ignored.
+func (db *DB) RemovePayloadRef(addr oid.Address) (sum [sha256.Size]byte, released bool, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		sum, released, err = releasePayloadRef(tx, addr.Container(), objectKey(addr.Object()))
+		return err
+	})
+
+	return
+}
+
+// PayloadRefs returns the number of the objects referencing the deduplicated
+// payload with the given SHA256 checksum.
+func (db *DB) PayloadRefs(sum [sha256.Size]byte) (n uint64, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		n = readRefs(tx.Bucket(payloadRefsBucketName), sum)
+		return nil
+	})
+
+	return
+}
+
+// releasePayloadRef removes the reference of the object to the deduplicated
+// payload. Returns true if the payload is not referenced anymore. Bucket of
+// the container references is removed with the last reference.
+func releasePayloadRef(tx *bbolt.Tx, cnr cid.ID, objKey []byte) ([sha256.Size]byte, bool, error) {
+	var sum [sha256.Size]byte
+
+	objBktName := payloadRefBucketName(cnr)
+
+	objBkt := tx.Bucket(objBktName)
+	if objBkt == nil {
+		return sum, false, nil
+	}
+
+	val := objBkt.Get(objKey)
+	if len(val) != sha256.Size {
+		return sum, false, nil
+	}
+
+	copy(sum[:], val)
+
+	if err := objBkt.Delete(objKey); err != nil {
+		return sum, false, fmt.Errorf("could not remove payload reference: %w", err)
+	}
+
+	if k, _ := objBkt.Cursor().First(); k == nil {
+		if err := tx.DeleteBucket(objBktName); err != nil {
+			return sum, false, fmt.Errorf("could not remove payload reference bucket: %w", err)
+		}
+	}
+
+	refsBkt := tx.Bucket(payloadRefsBucketName)
+	if refsBkt == nil {
+		return sum, true, nil
+	}
+
+	n := readRefs(refsBkt, sum)
+	if n <= 1 {
+		return sum, true, refsBkt.Delete(sum[:])
+	}
+
+	return sum, false, refsBkt.Put(sum[:], refsValue(n-1))
+}
+
+func readRefs(b *bbolt.Bucket, sum [sha256.Size]byte) uint64 {
+	if b == nil {
+		return 0
+	}
+
+	val := b.Get(sum[:])
+	if len(val) != 8 {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(val)
+}
+
+func refsValue(n uint64) []byte {
+	val := make([]byte, 8)
+	binary.LittleEndian.PutUint64(val, n)
+
+	return val
+}
@@ -0,0 +1,100 @@
+package meta_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_PayloadRefs(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+	sum := sha256.Sum256([]byte("payload"))
+
+	obj1 := generateObjectWithCID(t, cnr)
+	obj2 := generateObjectWithCID(t, cnr)
+
+	for _, obj := range [...]*objectSDK.Object{obj1, obj2} {
+		require.NoError(t, putBig(db, obj))
+
+		added, err := db.AddPayloadRef(object.AddressOf(obj), sum)
+		require.NoError(t, err)
+		require.True(t, added)
+
+		// repeated references are ignored
+		added, err = db.AddPayloadRef(object.AddressOf(obj), sum)
+		require.NoError(t, err)
+		require.False(t, added)
+	}
+
+	n, err := db.PayloadRefs(sum)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, n)
+
+	var prm meta.DeletePrm
+
+	prm.SetAddresses(object.AddressOf(obj1))
+	res, err := db.Delete(prm)
+	require.NoError(t, err)
+	require.Empty(t, res.ReleasedPayloads())
+
+	n, err = db.PayloadRefs(sum)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, n)
+
+	prm.SetAddresses(object.AddressOf(obj2))
+	res, err = db.Delete(prm)
+	require.NoError(t, err)
+	require.Equal(t, [][sha256.Size]byte{sum}, res.ReleasedPayloads())
+
+	n, err = db.PayloadRefs(sum)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestDB_RemovePayloadRef(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+	sum := sha256.Sum256([]byte("payload"))
+
+	obj1 := generateObjectWithCID(t, cnr)
+	obj2 := generateObjectWithCID(t, cnr)
+
+	for _, obj := range [...]*objectSDK.Object{obj1, obj2} {
+		_, err := db.AddPayloadRef(object.AddressOf(obj), sum)
+		require.NoError(t, err)
+	}
+
+	cnrs, err := db.Containers()
+	require.NoError(t, err)
+	require.Contains(t, cnrs, cnr)
+
+	actual, released, err := db.RemovePayloadRef(object.AddressOf(obj1))
+	require.NoError(t, err)
+	require.Equal(t, sum, actual)
+	require.False(t, released)
+
+	// missing reference is ignored
+	_, released, err = db.RemovePayloadRef(object.AddressOf(obj1))
+	require.NoError(t, err)
+	require.False(t, released)
+
+	_, released, err = db.RemovePayloadRef(object.AddressOf(obj2))
+	require.NoError(t, err)
+	require.True(t, released)
+
+	n, err := db.PayloadRefs(sum)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	cnrs, err = db.Containers()
+	require.NoError(t, err)
+	require.NotContains(t, cnrs, cnr)
+}
@@ -5,13 +5,16 @@ import (
 	"errors"
 	"fmt"
 	gio "io"
+	"strconv"
 
 	"github.com/nspcc-dev/neo-go/pkg/io"
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
@@ -137,9 +140,16 @@ func (db *DB) put(
 		return fmt.Errorf("can't put fake bucket tree indexes: %w", err)
 	}
 
-	// update container volume size estimation
-	if obj.Type() == objectSDK.TypeRegular && !isParent {
-		err = changeContainerSize(tx, cnr, obj.PayloadSize(), true)
+	if !isParent {
+		// update container volume size estimation
+		if obj.Type() == objectSDK.TypeRegular {
+			err = changeContainerSize(tx, cnr, obj.PayloadSize(), true)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = db.changeObjectCounters(tx, cnr, storedObjectDelta(obj.Type(), true, 1))
 		if err != nil {
 			return err
 		}
@@ -304,9 +314,60 @@ func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFun
 		if err != nil {
 			return err
 		}
+
 	}
 
-	return nil
+	return updateNumericIndexes(tx, obj, f)
+}
+
+// updateNumericIndexes updates numeric indexes of the user attributes,
+// creation epoch and payload length of the object.
+func updateNumericIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFunc) error {
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+	objKey := []byte(id.EncodeToString())
+
+	attrs := obj.Attributes()
+	for i := range attrs {
+		err := updateNumericIndex(tx, cnr, attrs[i].Key(), attrs[i].Value(), objKey, f)
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateHeaderNumericIndexes(tx, obj, f)
+}
+
+// updateHeaderNumericIndexes updates numeric indexes of the creation
+// epoch and payload length of the object.
+func updateHeaderNumericIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFunc) error {
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+	objKey := []byte(id.EncodeToString())
+
+	err := updateNumericIndex(tx, cnr, v2object.FilterHeaderCreationEpoch,
+		strconv.FormatUint(obj.CreationEpoch(), 10), objKey, f)
+	if err != nil {
+		return err
+	}
+
+	return updateNumericIndex(tx, cnr, v2object.FilterHeaderPayloadLength,
+		strconv.FormatUint(obj.PayloadSize(), 10), objKey, f)
+}
+
+// updateNumericIndex updates numeric index of the header
+// if its value is a decimal integer.
+func updateNumericIndex(tx *bbolt.Tx, cnr cid.ID, key, value string, objKey []byte, f updateIndexItemFunc) error {
+	numKey, ok := numericKey(key, value)
+	if !ok {
+		return nil
+	}
+
+	return f(tx, namedBucketItem{
+		name: numericAttributeBucketName(cnr, key),
+		key:  numKey,
+		val:  objKey,
+	})
 }
 
 func putUniqueIndexItem(tx *bbolt.Tx, item namedBucketItem) error {
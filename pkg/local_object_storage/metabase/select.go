@@ -1,12 +1,14 @@
 package meta
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
 
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -32,11 +34,27 @@ type (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+	limit   int
+	cursor  *SelectCursor
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *SelectCursor
+}
+
+// SelectCursor is a type for continuous object selection.
+// Objects are selected in the ascending order of their
+// identifiers' string representation.
+type SelectCursor struct {
+	lastKey []byte
+}
+
+// NewSelectCursor returns cursor which makes Select
+// return objects following the object with the specified identifier.
+func NewSelectCursor(id oid.ID) *SelectCursor {
+	return &SelectCursor{lastKey: objectKey(id)}
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -49,12 +67,37 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetLimit sets maximum amount of addresses that Select should return.
+// Zero means no limit.
+func (p *SelectPrm) SetLimit(limit uint32) {
+	p.limit = int(limit)
+}
+
+// SetCursor sets cursor for Select operation. For initial request
+// ignore this param or use nil value. For consecutive requests, use value
+// from SelectRes.
+func (p *SelectPrm) SetCursor(cursor *SelectCursor) {
+	p.cursor = cursor
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns cursor for consecutive select requests.
+// Returns nil if there are no more objects to select.
+// Non-nil cursor can lead to the empty result of the
+// consecutive request.
+func (r SelectRes) Cursor() *SelectCursor {
+	return r.cursor
+}
+
 // Select returns list of addresses of objects that match search filters.
+//
+// If limit is set, the objects are returned in pages in the ascending
+// order of their identifiers' string representation. Use cursor value from
+// the response for consecutive requests.
 func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
@@ -65,14 +108,24 @@ func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 
 	currEpoch := db.epochState.CurrentEpoch()
 
-	return res, db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch, prm.cursor, prm.limit)
 
 		return err
 	})
+	if err != nil {
+		return res, err
+	}
+
+	if prm.limit > 0 && len(res.addrList) == prm.limit {
+		res.cursor = NewSelectCursor(res.addrList[len(res.addrList)-1].Object())
+	}
+
+	return res, nil
 }
 
-func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64) ([]oid.Address, error) {
+func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64,
+	cursor *SelectCursor, limit int) ([]oid.Address, error) {
 	group, err := groupFilters(fs)
 	if err != nil {
 		return nil, err
@@ -84,59 +137,339 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		return nil, nil
 	}
 
+	var res []oid.Address
+
+	// match checks the object and appends it to the result,
+	// returns false if the limit is reached.
+	match := func(key []byte) (bool, error) {
+		var addr oid.Address
+
+		err := decodeAddressFromKey(&addr, key)
+		if err != nil {
+			return false, err
+		}
+
+		if objectStatus(tx, addr, currEpoch) > 0 {
+			return true, nil // ignore removed objects
+		}
+
+		if !db.matchSlowFilters(tx, addr, group.slowFilters, currEpoch) {
+			return true, nil // ignore objects with unmatched slow filters
+		}
+
+		res = append(res, addr)
+
+		return limit == 0 || len(res) < limit, nil
+	}
+
+	prefix := cnr.EncodeToString() + "/"
+
+	if len(group.fastFilters) == 0 {
+		var after []byte
+		if cursor != nil {
+			after = cursor.lastKey
+		}
+
+		err = iterateOrdered(objectBuckets(tx, cnr), after, func(k []byte) (bool, error) {
+			return match([]byte(prefix + string(k)))
+		})
+
+		return res, err
+	}
+
+	if limit == 0 && cursor == nil {
+		keys := db.selectFastFilters(tx, cnr, group.fastFilters)
+
+		res = make([]oid.Address, 0, len(keys))
+
+		for i := range keys {
+			if _, err := match([]byte(keys[i])); err != nil {
+				return nil, err
+			}
+		}
+
+		return res, nil
+	}
+
+	var after []byte
+	if cursor != nil {
+		after = cursor.lastKey
+	}
+
+	err = db.selectFastFiltersOrdered(tx, cnr, group.fastFilters, after, func(k []byte) (bool, error) {
+		return match([]byte(prefix + string(k)))
+	})
+
+	return res, err
+}
+
+// selectFastFilters returns addresses of the container objects matching
+// all the fast filters.
+func (db *DB) selectFastFilters(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters) []string {
 	// keep matched addresses in this cache
 	// value equal to number (index+1) of latest matched filter
 	mAddr := make(map[string]int)
 
-	expLen := len(group.fastFilters) // expected value of matched filters in mAddr
+	for i := range fs {
+		db.selectFastFilter(tx, cnr, fs[i], mAddr, i)
+	}
 
-	if len(group.fastFilters) == 0 {
-		expLen = 1
+	expLen := len(fs) // expected value of matched filters in mAddr
 
-		db.selectAll(tx, cnr, mAddr)
-	} else {
-		for i := range group.fastFilters {
-			db.selectFastFilter(tx, cnr, group.fastFilters[i], mAddr, i)
+	keys := make([]string, 0, len(mAddr))
+	for a, ind := range mAddr {
+		if ind == expLen { // ignore objects with unmatched fast filters
+			keys = append(keys, a)
 		}
 	}
 
-	res := make([]oid.Address, 0, len(mAddr))
+	return keys
+}
 
-	for a, ind := range mAddr {
-		if ind != expLen {
-			continue // ignore objects with unmatched fast filters
+// selectFastFiltersOrdered passes to f the keys of the container objects
+// matching all the fast filters in the ascending order starting after the
+// specified key. Iteration is stopped if f returns false or an error.
+//
+// Objects are iterated by the cursors over the sorted index of one of the
+// filters, the other filters are checked for each object, so the consumed
+// memory doesn't depend on the number of the matching objects.
+func (db *DB) selectFastFiltersOrdered(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, after []byte,
+	f func(k []byte) (bool, error)) error {
+	indexes := make([]filterIndex, len(fs))
+	driver := -1
+
+	for i := range fs {
+		indexes[i] = db.fastFilterIndex(tx, cnr, fs[i])
+
+		// the filter with the least number of the buckets to iterate over
+		if indexes[i].sorted() && (driver < 0 || len(indexes[i].buckets) < len(indexes[driver].buckets)) {
+			driver = i
 		}
+	}
 
-		var addr oid.Address
+	var buckets []*bbolt.Bucket
+	if driver >= 0 {
+		buckets = indexes[driver].buckets
+	} else {
+		buckets = objectBuckets(tx, cnr)
+	}
 
-		err = decodeAddressFromKey(&addr, []byte(a))
-		if err != nil {
-			return nil, err
+	return iterateOrdered(buckets, after, func(k []byte) (bool, error) {
+		for i := range indexes {
+			if i != driver && !indexes[i].has(k) {
+				return true, nil // ignore objects with unmatched fast filters
+			}
 		}
 
-		if objectStatus(tx, addr, currEpoch) > 0 {
-			continue // ignore removed objects
+		return f(k)
+	})
+}
+
+// filterIndex describes the container objects matching the fast filter.
+type filterIndex struct {
+	// buckets which keys are the keys of the objects,
+	// keys of the bucket are sorted
+	buckets []*bbolt.Bucket
+
+	// if set, the filter is matched by the objects
+	// which are absent in all the buckets
+	exclude bool
+
+	// keys of the matching objects, set for the filters
+	// without sorted index only
+	keys map[string]struct{}
+}
+
+// sorted checks whether the matching objects can be iterated in
+// the ascending order over the buckets of the index.
+func (x filterIndex) sorted() bool {
+	return x.keys == nil && !x.exclude
+}
+
+// has checks whether the object with the specified key matches the filter.
+func (x filterIndex) has(key []byte) bool {
+	if x.keys != nil {
+		_, ok := x.keys[string(key)]
+		return ok
+	}
+
+	for i := range x.buckets {
+		if k, _ := x.buckets[i].Cursor().Seek(key); bytes.Equal(k, key) {
+			return !x.exclude
 		}
+	}
 
-		if !db.matchSlowFilters(tx, addr, group.slowFilters, currEpoch) {
-			continue // ignore objects with unmatched slow filters
+	return x.exclude
+}
+
+// fastFilterIndex returns the index of the objects matching the fast filter.
+func (db *DB) fastFilterIndex(tx *bbolt.Tx, cnr cid.ID, f object.SearchFilter) filterIndex {
+	if objectCore.IsNumericMatch(f.Operation()) {
+		return filterIndex{buckets: db.fkbtLeaves(tx, numericAttributeBucketName(cnr, f.Header()), f)}
+	}
+
+	switch f.Header() {
+	case v2object.FilterHeaderOwnerID:
+		return filterIndex{buckets: db.fkbtLeaves(tx, ownerBucketName(cnr), f)}
+	case v2object.FilterHeaderObjectType:
+		return filterIndex{buckets: existingBuckets(tx, bucketNamesForType(cnr, f.Operation(), f.Value()))}
+	case v2object.FilterPropertyRoot:
+		return filterIndex{buckets: existingBuckets(tx, [][]byte{rootBucketName(cnr)})}
+	case v2object.FilterPropertyPhy:
+		return filterIndex{buckets: existingBuckets(tx, [][]byte{
+			primaryBucketName(cnr),
+			tombstoneBucketName(cnr),
+			storageGroupBucketName(cnr),
+			bucketNameLockers(cnr),
+		})}
+	case
+		v2object.FilterHeaderObjectID,
+		v2object.FilterHeaderPayloadHash,
+		v2object.FilterHeaderParent,
+		v2object.FilterHeaderSplitID:
+		// these filters are usually matched by a few objects
+		// which are not indexed in the sorted buckets
+		mAddr := make(map[string]int)
+		db.selectFastFilter(tx, cnr, f, mAddr, 0)
+
+		prefix := cnr.EncodeToString() + "/"
+		keys := make(map[string]struct{}, len(mAddr))
+
+		for a := range mAddr {
+			keys[a[len(prefix):]] = struct{}{}
 		}
 
-		res = append(res, addr)
+		return filterIndex{keys: keys}
+	default: // user attribute
+		bucketName := attributeBucketName(cnr, f.Header())
+
+		if f.Operation() == object.MatchNotPresent {
+			var leaves []*bbolt.Bucket
+
+			if root := tx.Bucket(bucketName); root != nil {
+				_ = root.ForEach(func(k, _ []byte) error {
+					if leaf := root.Bucket(k); leaf != nil {
+						leaves = append(leaves, leaf)
+					}
+
+					return nil
+				})
+			}
+
+			return filterIndex{buckets: leaves, exclude: true}
+		}
+
+		return filterIndex{buckets: db.fkbtLeaves(tx, bucketName, f)}
 	}
+}
 
-	return res, nil
+// fkbtLeaves returns leaf buckets of the <fkbt> index which
+// values match the filter.
+func (db *DB) fkbtLeaves(tx *bbolt.Tx, name []byte, f object.SearchFilter) []*bbolt.Bucket {
+	matchFunc, ok := db.matchers[f.Operation()]
+	if !ok {
+		db.log.Debug("missing matcher", zap.Uint32("operation", uint32(f.Operation())))
+
+		return nil
+	}
+
+	fkbtRoot := tx.Bucket(name)
+	if fkbtRoot == nil {
+		return nil
+	}
+
+	var leaves []*bbolt.Bucket
+
+	err := matchFunc.matchBucket(fkbtRoot, f.Header(), f.Value(), func(k, _ []byte) error {
+		if fkbtLeaf := fkbtRoot.Bucket(k); fkbtLeaf != nil {
+			leaves = append(leaves, fkbtLeaf)
+		}
+
+		return nil
+	})
+	if err != nil {
+		db.log.Debug("error in FKBT selection", zap.String("error", err.Error()))
+	}
+
+	return leaves
 }
 
-// selectAll adds to resulting cache all available objects in metabase.
-func (db *DB) selectAll(tx *bbolt.Tx, cnr cid.ID, to map[string]int) {
-	prefix := cnr.EncodeToString() + "/"
+// existingBuckets returns the buckets with the specified names
+// which are present in the database.
+func existingBuckets(tx *bbolt.Tx, names [][]byte) []*bbolt.Bucket {
+	res := make([]*bbolt.Bucket, 0, len(names))
 
-	selectAllFromBucket(tx, primaryBucketName(cnr), prefix, to, 0)
-	selectAllFromBucket(tx, tombstoneBucketName(cnr), prefix, to, 0)
-	selectAllFromBucket(tx, storageGroupBucketName(cnr), prefix, to, 0)
-	selectAllFromBucket(tx, parentBucketName(cnr), prefix, to, 0)
-	selectAllFromBucket(tx, bucketNameLockers(cnr), prefix, to, 0)
+	for i := range names {
+		if bkt := tx.Bucket(names[i]); bkt != nil {
+			res = append(res, bkt)
+		}
+	}
+
+	return res
+}
+
+// objectBuckets returns the buckets of the container which
+// objects are selected from.
+func objectBuckets(tx *bbolt.Tx, cnr cid.ID) []*bbolt.Bucket {
+	return existingBuckets(tx, [][]byte{
+		primaryBucketName(cnr),
+		tombstoneBucketName(cnr),
+		storageGroupBucketName(cnr),
+		parentBucketName(cnr),
+		bucketNameLockers(cnr),
+	})
+}
+
+// iterateOrdered passes to f the keys of all the buckets in the ascending
+// order starting after the specified key. Keys present in several buckets
+// are passed once. Iteration is stopped if f returns false or an error.
+func iterateOrdered(buckets []*bbolt.Bucket, after []byte, f func(k []byte) (bool, error)) error {
+	var (
+		cursors = make([]*bbolt.Cursor, 0, len(buckets))
+		keys    = make([][]byte, 0, len(buckets))
+	)
+
+	for i := range buckets {
+		c := buckets[i].Cursor()
+
+		var k []byte
+		if after == nil {
+			k, _ = c.First()
+		} else {
+			k, _ = c.Seek(after)
+			if bytes.Equal(k, after) {
+				k, _ = c.Next()
+			}
+		}
+
+		cursors = append(cursors, c)
+		keys = append(keys, k)
+	}
+
+	for {
+		// merge sorted keys of all buckets
+		var minKey []byte
+		for i := range keys {
+			if keys[i] != nil && (minKey == nil || bytes.Compare(keys[i], minKey) < 0) {
+				minKey = keys[i]
+			}
+		}
+
+		if minKey == nil {
+			return nil
+		}
+
+		next, err := f(minKey)
+		if err != nil || !next {
+			return err
+		}
+
+		for i := range keys {
+			if bytes.Equal(keys[i], minKey) {
+				keys[i], _ = cursors[i].Next()
+			}
+		}
+	}
 }
 
 // selectAllFromBucket goes through all keys in bucket and adds them in a
@@ -167,6 +500,12 @@ func (db *DB) selectFastFilter(
 	prefix := cnr.EncodeToString() + "/"
 	currEpoch := db.epochState.CurrentEpoch()
 
+	if objectCore.IsNumericMatch(f.Operation()) {
+		// numeric values are compared using ordered numeric index
+		db.selectFromFKBT(tx, numericAttributeBucketName(cnr, f.Header()), f, prefix, to, fNum)
+		return
+	}
+
 	switch f.Header() {
 	case v2object.FilterHeaderObjectID:
 		db.selectObjectID(tx, f, cnr, to, fNum, currEpoch)
@@ -527,10 +866,16 @@ func groupFilters(filters object.SearchFilters) (filterGroup, error) {
 			}
 
 			res.withCnrFilter = true
+		case // indexed numeric filters
+			v2object.FilterHeaderCreationEpoch,
+			v2object.FilterHeaderPayloadLength:
+			if objectCore.IsNumericMatch(filters[i].Operation()) {
+				res.fastFilters = append(res.fastFilters, filters[i])
+			} else {
+				res.slowFilters = append(res.slowFilters, filters[i])
+			}
 		case // slow filters
 			v2object.FilterHeaderVersion,
-			v2object.FilterHeaderCreationEpoch,
-			v2object.FilterHeaderPayloadLength,
 			v2object.FilterHeaderHomomorphicHash:
 			res.slowFilters = append(res.slowFilters, filters[i])
 		default: // fast filters or user attributes if unknown
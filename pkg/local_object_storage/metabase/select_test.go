@@ -2,6 +2,9 @@ package meta_test
 
 import (
 	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -766,6 +769,246 @@ func TestDB_SelectContainerID(t *testing.T) {
 	})
 }
 
+func TestDB_SelectLimit(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	const objCount = 10
+
+	var exp, expAttr []oid.Address
+	for i := 0; i < objCount; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		if i%2 == 0 {
+			addAttribute(obj, "foo", "bar")
+			expAttr = append(expAttr, object.AddressOf(obj))
+		}
+
+		require.NoError(t, putBig(db, obj))
+		exp = append(exp, object.AddressOf(obj))
+	}
+
+	selectAll := func(t *testing.T, fs objectSDK.SearchFilters, limit uint32) []oid.Address {
+		var (
+			prm meta.SelectPrm
+			res []oid.Address
+		)
+
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+		prm.SetLimit(limit)
+
+		for {
+			r, err := db.Select(prm)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(r.AddressList()), int(limit))
+
+			res = append(res, r.AddressList()...)
+			if r.Cursor() == nil {
+				return res
+			}
+
+			prm.SetCursor(r.Cursor())
+		}
+	}
+
+	sorted := func(addrs []oid.Address) []oid.Address {
+		res := append([]oid.Address(nil), addrs...)
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].Object().EncodeToString() < res[j].Object().EncodeToString()
+		})
+		return res
+	}
+
+	for _, limit := range []uint32{1, 3, objCount, objCount + 1} {
+		t.Run(fmt.Sprintf("limit %d", limit), func(t *testing.T) {
+			require.Equal(t, sorted(exp), selectAll(t, nil, limit))
+
+			fs := objectSDK.SearchFilters{}
+			fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+			require.Equal(t, sorted(expAttr), selectAll(t, fs, limit))
+		})
+	}
+
+	filterSets := map[string]func(fs *objectSDK.SearchFilters){
+		"attribute not equal": func(fs *objectSDK.SearchFilters) {
+			fs.AddFilter("foo", "baz", objectSDK.MatchStringNotEqual)
+		},
+		"attribute prefix": func(fs *objectSDK.SearchFilters) {
+			fs.AddFilter("foo", "b", objectSDK.MatchCommonPrefix)
+		},
+		"attribute not present": func(fs *objectSDK.SearchFilters) {
+			fs.AddFilter("foo", "", objectSDK.MatchNotPresent)
+		},
+		"type": func(fs *objectSDK.SearchFilters) {
+			fs.AddTypeFilter(objectSDK.MatchStringEqual, objectSDK.TypeRegular)
+		},
+		"object ID": func(fs *objectSDK.SearchFilters) {
+			fs.AddObjectIDFilter(objectSDK.MatchStringEqual, exp[3].Object())
+		},
+		"root and attribute": func(fs *objectSDK.SearchFilters) {
+			fs.AddRootFilter()
+			fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+		},
+		"phy and attribute not present": func(fs *objectSDK.SearchFilters) {
+			fs.AddPhyFilter()
+			fs.AddFilter("foo", "", objectSDK.MatchNotPresent)
+		},
+	}
+
+	for name, fill := range filterSets {
+		fill := fill
+
+		t.Run(name, func(t *testing.T) {
+			var fs objectSDK.SearchFilters
+			fill(&fs)
+
+			all, err := metaSelect(db, cnr, fs)
+			require.NoError(t, err)
+			require.NotEmpty(t, all)
+
+			for _, limit := range []uint32{1, 3} {
+				require.Equal(t, sorted(all), selectAll(t, fs, limit))
+			}
+		})
+	}
+
+	t.Run("removed between pages", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+
+		var prm meta.SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+		prm.SetLimit(2)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		require.NotNil(t, res.Cursor())
+
+		expected := sorted(expAttr)
+		require.Equal(t, expected[:2], res.AddressList())
+
+		require.NoError(t, metaDelete(db, expected[2]))
+
+		prm.SetCursor(res.Cursor())
+
+		res, err = db.Select(prm)
+		require.NoError(t, err)
+		require.Equal(t, expected[3:5], res.AddressList())
+	})
+
+	t.Run("put between pages", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+
+		var prm meta.SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+		prm.SetLimit(1)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		require.NotNil(t, res.Cursor())
+
+		first := res.AddressList()[0].Object().EncodeToString()
+
+		var obj *objectSDK.Object
+		for {
+			obj = generateObjectWithCID(t, cnr)
+			if id, _ := obj.ID(); id.EncodeToString() > first {
+				break
+			}
+		}
+
+		addAttribute(obj, "foo", "bar")
+		require.NoError(t, putBig(db, obj))
+
+		prm.SetCursor(res.Cursor())
+		prm.SetLimit(objCount)
+
+		res, err = db.Select(prm)
+		require.NoError(t, err)
+		require.Contains(t, res.AddressList(), object.AddressOf(obj))
+	})
+}
+
+func TestDB_SelectNumeric(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	var addrs []oid.Address
+	for i, ts := range []string{"-5", "0", "10", "20", "abc"} {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetCreationEpoch(uint64(i + 1))
+		obj.SetPayloadSize(uint64(100 * (i + 1)))
+		addAttribute(obj, "Timestamp", ts)
+
+		require.NoError(t, putBig(db, obj))
+		addrs = append(addrs, object.AddressOf(obj))
+	}
+
+	numFilter := func(key, val string, m objectSDK.SearchMatchType) objectSDK.SearchFilters {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(key, val, m)
+		return fs
+	}
+
+	t.Run("user attribute", func(t *testing.T) {
+		testSelect(t, db, cnr, numFilter("Timestamp", "0", object.MatchNumGT), addrs[2], addrs[3])
+		testSelect(t, db, cnr, numFilter("Timestamp", "0", object.MatchNumGE), addrs[1], addrs[2], addrs[3])
+		testSelect(t, db, cnr, numFilter("Timestamp", "10", object.MatchNumLT), addrs[0], addrs[1])
+		testSelect(t, db, cnr, numFilter("Timestamp", "10", object.MatchNumLE), addrs[0], addrs[1], addrs[2])
+		testSelect(t, db, cnr, numFilter("Timestamp", "-100", object.MatchNumGT), addrs[:4]...)
+		testSelect(t, db, cnr, numFilter("Timestamp", "abc", object.MatchNumGE))
+		testSelect(t, db, cnr, numFilter("Unknown", "0", object.MatchNumGE))
+	})
+
+	t.Run("creation epoch", func(t *testing.T) {
+		fs := numFilter(v2object.FilterHeaderCreationEpoch, "2", object.MatchNumGT)
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "4", object.MatchNumLE)
+		testSelect(t, db, cnr, fs, addrs[2], addrs[3])
+	})
+
+	t.Run("payload length", func(t *testing.T) {
+		fs := numFilter(v2object.FilterHeaderPayloadLength, "300", object.MatchNumGE)
+		fs.AddFilter("Timestamp", "20", object.MatchNumLT)
+		testSelect(t, db, cnr, fs, addrs[2])
+	})
+
+	t.Run("slow filter", func(t *testing.T) {
+		fs := numFilter(v2object.FilterHeaderPayloadLength, "300", object.MatchNumGE)
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "3", objectSDK.MatchStringEqual)
+		testSelect(t, db, cnr, fs, addrs[2])
+	})
+
+	t.Run("values above max int64", func(t *testing.T) {
+		obj := generateObjectWithCID(t, cnr)
+		obj.SetCreationEpoch(math.MaxInt64 + 1)
+		obj.SetPayloadSize(math.MaxUint64)
+		require.NoError(t, putBig(db, obj))
+		addr := object.AddressOf(obj)
+
+		testSelect(t, db, cnr, numFilter(v2object.FilterHeaderCreationEpoch, "9223372036854775807", object.MatchNumGT), addr)
+		testSelect(t, db, cnr, numFilter(v2object.FilterHeaderPayloadLength, "18446744073709551615", object.MatchNumLE),
+			append([]oid.Address{addr}, addrs...)...)
+		testSelect(t, db, cnr, numFilter(v2object.FilterHeaderPayloadLength, "18446744073709551615", object.MatchNumLT), addrs...)
+		testSelect(t, db, cnr, numFilter(v2object.FilterHeaderPayloadLength, "-1", object.MatchNumGE))
+
+		fs := numFilter(v2object.FilterHeaderPayloadLength, "9223372036854775808", object.MatchNumGE)
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "9223372036854775808", objectSDK.MatchStringEqual)
+		testSelect(t, db, cnr, fs, addr)
+
+		require.NoError(t, metaDelete(db, addr))
+	})
+
+	t.Run("deleted object", func(t *testing.T) {
+		require.NoError(t, metaDelete(db, addrs[3]))
+		testSelect(t, db, cnr, numFilter("Timestamp", "0", object.MatchNumGT), addrs[2])
+	})
+}
+
 func BenchmarkSelect(b *testing.B) {
 	const objCount = 1000
 	db := newDB(b)
@@ -2,9 +2,12 @@ package meta
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"strconv"
 	"strings"
 
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -31,6 +34,12 @@ var (
 	garbageBucketName         = []byte(invalidBase58String + "Garbage")
 	toMoveItBucketName        = []byte(invalidBase58String + "ToMoveIt")
 	containerVolumeBucketName = []byte(invalidBase58String + "ContainerSize")
+	// countersBucketName stores the object counters of the containers
+	// and of the whole metabase.
+	countersBucketName = []byte(invalidBase58String + "Counters")
+	// payloadRefsBucketName stores the numbers of the objects referencing
+	// the deduplicated payloads.
+	payloadRefsBucketName = []byte(invalidBase58String + "PayloadRefs")
 
 	zeroValue = []byte{0xFF}
 
@@ -42,8 +51,10 @@ var (
 	rootPostfix         = invalidBase58String + "root"
 	parentPostfix       = invalidBase58String + "parent"
 	splitPostfix        = invalidBase58String + "splitid"
+	payloadRefPostfix   = invalidBase58String + "payloadref"
 
-	userAttributePostfix = invalidBase58String + "attr_"
+	userAttributePostfix    = invalidBase58String + "attr_"
+	numericAttributePostfix = invalidBase58String + "numattr_"
 
 	splitInfoError *object.SplitInfoError // for errors.As comparisons
 )
@@ -88,11 +99,57 @@ func cidFromAttributeBucket(val []byte, attributeKey string) []byte {
 	return val[:len(val)-len(suffix)]
 }
 
+// numericAttributeBucketName returns <CID>_numattr_<attributeKey>.
+func numericAttributeBucketName(cnr cid.ID, attributeKey string) []byte {
+	sb := strings.Builder{}
+	sb.WriteString(cnr.EncodeToString())
+	sb.WriteString(numericAttributePostfix)
+	sb.WriteString(attributeKey)
+
+	return []byte(sb.String())
+}
+
+// numericKey returns key for numeric index buckets of the attribute.
+// Keys are ordered in the same way as the numbers they represent.
+// Creation epoch and payload length are unsigned, so their keys are
+// big-endian values as is, other keys have the flipped sign bit.
+// Returns false if value is not a decimal 64-bit integer.
+func numericKey(attr, value string) ([]byte, bool) {
+	var n uint64
+
+	switch attr {
+	case v2object.FilterHeaderCreationEpoch, v2object.FilterHeaderPayloadLength:
+		u, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+
+		n = u
+	default:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+
+		n = uint64(i) ^ (1 << 63)
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, n)
+
+	return key, true
+}
+
 // payloadHashBucketName returns <CID>_payloadhash.
 func payloadHashBucketName(cnr cid.ID) []byte {
 	return []byte(cnr.EncodeToString() + payloadHashPostfix)
 }
 
+// payloadRefBucketName returns <CID>_payloadref.
+func payloadRefBucketName(cnr cid.ID) []byte {
+	return []byte(cnr.EncodeToString() + payloadRefPostfix)
+}
+
 // rootBucketName returns <CID>_root.
 func rootBucketName(cnr cid.ID) []byte {
 	return []byte(cnr.EncodeToString() + rootPostfix)
@@ -8,10 +8,14 @@ import (
 )
 
 // version contains current metabase version.
-const version = 0
+// Metabase of the older version is upgraded with migrations, see Migrate.
+const version = 3
 
 var versionKey = []byte("version")
 
+// checkVersion checks that the metabase has the current version. Initialized
+// metabase must have the stored version, so it must be migrated before the
+// check. Version of the new database is written in read-write transaction.
 func checkVersion(tx *bbolt.Tx, initialized bool) error {
 	b := tx.Bucket(shardInfoBucket)
 	if b != nil {
@@ -21,12 +25,32 @@ func checkVersion(tx *bbolt.Tx, initialized bool) error {
 			if stored != version {
 				return fmt.Errorf("invalid version: expected=%d, stored=%d", version, stored)
 			}
+			return nil
 		}
 	}
-	if !initialized { // new database, write version
-		return updateVersion(tx, version)
+	if initialized {
+		return fmt.Errorf("missing version: expected=%d", version)
 	}
-	return nil // return error here after the first version increase
+	if !tx.Writable() {
+		return nil
+	}
+	// new database, write version
+	return updateVersion(tx, version)
+}
+
+// storedVersion returns the version of the metabase. Initialized metabase
+// without the stored version is considered to have version 0, new one
+// is created with the current version.
+func (db *DB) storedVersion(tx *bbolt.Tx) uint64 {
+	if b := tx.Bucket(shardInfoBucket); b != nil {
+		if data := b.Get(versionKey); len(data) == 8 {
+			return binary.LittleEndian.Uint64(data)
+		}
+	}
+	if db.initialized {
+		return 0
+	}
+	return version
 }
 
 func updateVersion(tx *bbolt.Tx, version uint64) error {
@@ -55,6 +55,29 @@ func TestVersion(t *testing.T) {
 			require.NoError(t, db.Close())
 		})
 	})
+	t.Run("read-only", func(t *testing.T) {
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(true))
+		require.NoError(t, db.Init())
+		require.NoError(t, db.Close())
+	})
+	t.Run("missing version", func(t *testing.T) {
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return tx.Bucket(shardInfoBucket).Delete(versionKey)
+		}))
+		require.NoError(t, db.Close())
+
+		require.NoError(t, db.Open(true))
+		require.Error(t, db.Init())
+		require.NoError(t, db.Close())
+	})
 	t.Run("old data", func(t *testing.T) {
 		db := newDB(t)
 		require.NoError(t, db.Open(false))
@@ -78,6 +101,12 @@ func TestVersion(t *testing.T) {
 		require.Error(t, db.Init())
 		require.NoError(t, db.Close())
 
+		t.Run("read-only", func(t *testing.T) {
+			require.NoError(t, db.Open(true))
+			require.Error(t, db.Init())
+			require.NoError(t, db.Close())
+		})
+
 		t.Run("reset", func(t *testing.T) {
 			require.NoError(t, db.Open(false))
 			require.NoError(t, db.Reset())
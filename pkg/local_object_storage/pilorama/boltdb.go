@@ -28,6 +28,10 @@ type boltForest struct {
 var (
 	dataBucket = []byte{0}
 	logBucket  = []byte{1}
+	heightKey  = []byte{2}
+
+	// sortedIndexKey marks the trees with the sorted children index.
+	sortedIndexKey = []byte{3}
 )
 
 // NewBoltForest returns storage wrapper for storing operations on CRDT trees.
@@ -41,12 +45,16 @@ var (
 // log storage (logBucket):
 // timestamp in big-endian -> log operation
 //
+// compacted height (heightKey in the tree bucket):
+// timestamp below which the log is replaced with the tree snapshot
+//
 // tree storage (dataBucket):
 // 't' + node (id) -> timestamp when the node first appeared
 // 'p' + node (id) -> parent (id)
 // 'm' + node (id) -> serialized meta
 // 'c' + parent (id) + child (id) -> 0/1
 // 'i' + 0 + attrKey + 0 + attrValue + 0 + parent (id) + node (id) -> 0/1 (1 for automatically created nodes)
+// 's' + parent (id) + escaped FileName value + node (id in big-endian) -> 1
 func NewBoltForest(opts ...Option) ForestStorage {
 	b := boltForest{
 		cfg: cfg{
@@ -121,7 +129,47 @@ func (t *boltForest) Init() error {
 		if err != nil {
 			return err
 		}
-		return nil
+		return t.buildSortedIndexes(tx)
+	})
+}
+
+// buildSortedIndexes fills the sorted children index of the trees
+// created before the index was introduced.
+func (t *boltForest) buildSortedIndexes(tx *bbolt.Tx) error {
+	return tx.ForEach(func(name []byte, treeRoot *bbolt.Bucket) error {
+		if bytes.Equal(name, dataBucket) || bytes.Equal(name, logBucket) {
+			return nil
+		}
+		if treeRoot.Get(sortedIndexKey) != nil {
+			return nil
+		}
+
+		b := treeRoot.Bucket(dataBucket)
+		if b == nil {
+			return nil
+		}
+
+		var (
+			key  [9]byte
+			meta Meta
+			keys [][]byte
+		)
+
+		c := b.Cursor()
+		for k, v := c.Seek([]byte{'p'}); len(k) == 9 && k[0] == 'p'; k, v = c.Next() {
+			child := binary.LittleEndian.Uint64(k[1:])
+			if err := meta.FromBytes(b.Get(metaKey(key[:], child))); err != nil {
+				return err
+			}
+			keys = append(keys, sortedChildKey(binary.LittleEndian.Uint64(v), meta.GetAttr(AttributeFilename), child))
+		}
+
+		for i := range keys {
+			if err := b.Put(keys[i], []byte{1}); err != nil {
+				return err
+			}
+		}
+		return treeRoot.Put(sortedIndexKey, []byte{1})
 	})
 }
 func (t *boltForest) Close() error {
@@ -144,7 +192,8 @@ func (t *boltForest) TreeMove(d CIDDescriptor, treeID string, m *Move) (*LogMove
 			return err
 		}
 
-		m.Time = t.getLatestTimestamp(bLog, d.Position, d.Size)
+		h := t.getCompactedHeight(tx, d.CID, treeID)
+		m.Time = t.getLatestTimestamp(bLog, h, d.Position, d.Size)
 		if m.Child == RootID {
 			m.Child = t.findSpareID(bTree)
 		}
@@ -176,7 +225,8 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 			return err
 		}
 
-		ts := t.getLatestTimestamp(bLog, d.Position, d.Size)
+		h := t.getCompactedHeight(tx, d.CID, treeID)
+		ts := t.getLatestTimestamp(bLog, h, d.Position, d.Size)
 		lm = make([]LogMove, len(path)-i+1)
 		for j := i; j < len(path); j++ {
 			lm[j-i].Move = Move{
@@ -211,8 +261,8 @@ func (t *boltForest) TreeAddByPath(d CIDDescriptor, treeID string, attr string,
 }
 
 // getLatestTimestamp returns timestamp for a new operation which is guaranteed to be bigger than
-// all timestamps corresponding to already stored operations.
-func (t *boltForest) getLatestTimestamp(bLog *bbolt.Bucket, pos, size int) uint64 {
+// all timestamps corresponding to already stored operations and not less than the compacted height.
+func (t *boltForest) getLatestTimestamp(bLog *bbolt.Bucket, height uint64, pos, size int) uint64 {
 	var ts uint64
 
 	c := bLog.Cursor()
@@ -220,9 +270,24 @@ func (t *boltForest) getLatestTimestamp(bLog *bbolt.Bucket, pos, size int) uint6
 	if len(key) != 0 {
 		ts = binary.BigEndian.Uint64(key)
 	}
+	if ts+1 < height {
+		ts = height - 1
+	}
 	return nextTimestamp(ts, uint64(pos), uint64(size))
 }
 
+// getCompactedHeight returns the height below which the log of the tree is compacted.
+func (t *boltForest) getCompactedHeight(tx *bbolt.Tx, cid cidSDK.ID, treeID string) uint64 {
+	treeRoot := tx.Bucket(bucketName(cid, treeID))
+	if treeRoot == nil {
+		return 0
+	}
+	if data := treeRoot.Get(heightKey); len(data) == 8 {
+		return binary.LittleEndian.Uint64(data)
+	}
+	return 0
+}
+
 // findSpareID returns random unused ID.
 func (t *boltForest) findSpareID(bTree *bbolt.Bucket) uint64 {
 	id := uint64(rand.Int63())
@@ -247,6 +312,11 @@ func (t *boltForest) TreeApply(d CIDDescriptor, treeID string, m *Move) error {
 	}
 
 	return t.db.Batch(func(tx *bbolt.Tx) error {
+		if m.Time < t.getCompactedHeight(tx, d.CID, treeID) {
+			// Operation is already included in the compacted log.
+			return nil
+		}
+
 		bLog, bTree, err := t.getTreeBuckets(tx, d.CID, treeID)
 		if err != nil {
 			return err
@@ -272,6 +342,9 @@ func (t *boltForest) getTreeBuckets(tx *bbolt.Tx, cid cidSDK.ID, treeID string)
 		if bData, err = child.CreateBucket(dataBucket); err != nil {
 			return nil, nil, err
 		}
+		if err = child.Put(sortedIndexKey, []byte{1}); err != nil {
+			return nil, nil, err
+		}
 	} else {
 		child = tx.Bucket(treeRoot)
 		bLog = child.Bucket(logBucket)
@@ -310,9 +383,12 @@ func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, lm *Log
 			return err
 		}
 	}
-	key, value = c.Next()
 
 	// 3. Re-apply all other operations.
+	// The log is modified by `do`, so the cursor is repositioned after each operation.
+	var next [8]byte
+	binary.BigEndian.PutUint64(next[:], lm.Time+1)
+	key, value = c.Seek(next[:])
 	for len(key) == 8 {
 		b.Reset(value)
 		if err := t.logFromBytes(&tmp, r); err != nil {
@@ -321,7 +397,8 @@ func (t *boltForest) applyOperation(logBucket, treeBucket *bbolt.Bucket, lm *Log
 		if err := t.do(logBucket, treeBucket, cKey[:], &tmp); err != nil {
 			return err
 		}
-		key, value = c.Next()
+		binary.BigEndian.PutUint64(next[:], tmp.Time+1)
+		key, value = c.Seek(next[:])
 	}
 
 	return nil
@@ -331,8 +408,9 @@ func (t *boltForest) do(lb *bbolt.Bucket, b *bbolt.Bucket, key []byte, op *LogMo
 	shouldPut := !t.isAncestor(b, key, op.Child, op.Parent)
 
 	currParent := b.Get(parentKey(key, op.Child))
+	op.HasOld = currParent != nil
+	op.Old = nodeInfo{}
 	if currParent != nil { // node is already in tree
-		op.HasOld = true
 		op.Old.Parent = binary.LittleEndian.Uint64(currParent)
 		if err := op.Old.Meta.FromBytes(b.Get(metaKey(key, op.Child))); err != nil {
 			return err
@@ -360,6 +438,10 @@ func (t *boltForest) do(lb *bbolt.Bucket, b *bbolt.Bucket, key []byte, op *LogMo
 		var meta Meta
 		var k = metaKey(key, op.Child)
 		if err := meta.FromBytes(b.Get(k)); err == nil {
+			err := b.Delete(sortedChildKey(parent, meta.GetAttr(AttributeFilename), op.Child))
+			if err != nil {
+				return err
+			}
 			for i := range meta.Items {
 				if isAttributeInternal(meta.Items[i].Key) {
 					err := b.Delete(internalKey(nil, meta.Items[i].Key, string(meta.Items[i].Value), parent, op.Child))
@@ -406,6 +488,10 @@ func (t *boltForest) addNode(b *bbolt.Bucket, key []byte, child, parent Node, me
 	if err != nil {
 		return err
 	}
+	err = b.Put(sortedChildKey(parent, meta.GetAttr(AttributeFilename), child), []byte{1})
+	if err != nil {
+		return err
+	}
 	err = b.Put(metaKey(key, child), meta.Bytes())
 	if err != nil {
 		return err
@@ -434,6 +520,14 @@ func (t *boltForest) undo(m *Move, lm *LogMove, b *bbolt.Bucket, key []byte) err
 		return err
 	}
 
+	var meta Meta
+	if err := meta.FromBytes(b.Get(metaKey(key, m.Child))); err != nil {
+		return err
+	}
+	if err := b.Delete(sortedChildKey(m.Parent, meta.GetAttr(AttributeFilename), m.Child)); err != nil {
+		return err
+	}
+
 	if !lm.HasOld {
 		return t.removeNode(b, key, m.Child, m.Parent)
 	}
@@ -556,6 +650,83 @@ func (t *boltForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node)
 	return children, err
 }
 
+// TreeGetChildrenSorted implements the Forest interface.
+// Children sorted by AttributeFilename are read from the sorted index,
+// children sorted by the other attributes are sorted in memory.
+func (t *boltForest) TreeGetChildrenSorted(cid cidSDK.ID, treeID string, nodeID Node, attr string, after *ChildrenCursor, count int) ([]NodeInfo, error) {
+	var res []NodeInfo
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		b := treeRoot.Bucket(dataBucket)
+		if !isAttributeInternal(attr) || treeRoot.Get(sortedIndexKey) == nil {
+			var err error
+			res, err = t.getChildrenInfo(b, nodeID)
+			if err == nil {
+				res = sortChildren(res, attr, after, count)
+			}
+			return err
+		}
+
+		var key [9]byte
+
+		prefix := sortedChildKey(nodeID, nil, 0)
+		prefix = prefix[:9]
+
+		seek := prefix
+		if after != nil {
+			seek = sortedChildKey(nodeID, after.Value, 0)
+			seek = seek[:len(seek)-8]
+		}
+
+		c := b.Cursor()
+		for k, _ := c.Seek(seek); len(k) >= len(prefix)+8 && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			child := binary.BigEndian.Uint64(k[len(k)-8:])
+			if after != nil && !after.isBefore(unescapeValue(k[len(prefix):len(k)-8]), child) {
+				continue
+			}
+
+			info := NodeInfo{ID: child, ParentID: nodeID}
+			if err := info.Meta.FromBytes(b.Get(metaKey(key[:], child))); err != nil {
+				return err
+			}
+			res = append(res, info)
+
+			if count > 0 && len(res) == count {
+				break
+			}
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+// getChildrenInfo returns children of the node with their meta information.
+func (t *boltForest) getChildrenInfo(b *bbolt.Bucket, nodeID Node) ([]NodeInfo, error) {
+	var res []NodeInfo
+	var key [9]byte
+
+	prefix := childrenKey(make([]byte, 17), 0, nodeID)[:9]
+
+	c := b.Cursor()
+	for k, _ := c.Seek(prefix); len(k) == 17 && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		info := NodeInfo{
+			ID:       binary.LittleEndian.Uint64(k[9:]),
+			ParentID: nodeID,
+		}
+		if err := info.Meta.FromBytes(b.Get(metaKey(key[:], info.ID))); err != nil {
+			return nil, err
+		}
+		res = append(res, info)
+	}
+	return res, nil
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error) {
 	key := make([]byte, 8)
@@ -579,6 +750,147 @@ func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (
 	return lm, err
 }
 
+// TreeDrop implements the pilorama.Forest interface.
+func (t *boltForest) TreeDrop(cid cidSDK.ID, treeID string) error {
+	return t.db.Batch(func(tx *bbolt.Tx) error {
+		if treeID == "" {
+			c := tx.Cursor()
+			prefix := []byte(cid.String())
+
+			var names [][]byte
+			for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+				names = append(names, append([]byte(nil), k...))
+			}
+
+			for i := range names {
+				if err := tx.DeleteBucket(names[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		err := tx.DeleteBucket(bucketName(cid, treeID))
+		if err == bbolt.ErrBucketNotFound {
+			return ErrTreeNotFound
+		}
+		return err
+	})
+}
+
+// TreeList implements the pilorama.Forest interface.
+func (t *boltForest) TreeList(cid cidSDK.ID) ([]string, error) {
+	var ids []string
+	cidRaw := []byte(cid.String())
+
+	cidLen := len(cidRaw)
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Cursor()
+		for k, _ := c.Seek(cidRaw); k != nil && bytes.HasPrefix(k, cidRaw); k, _ = c.Next() {
+			ids = append(ids, string(k[cidLen:]))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list trees: %w", err)
+	}
+
+	return ids, nil
+}
+
+// TreeCompactLog implements the pilorama.Forest interface.
+func (t *boltForest) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	return t.db.Batch(func(tx *bbolt.Tx) error {
+		treeRoot := tx.Bucket(bucketName(cid, treeID))
+		if treeRoot == nil {
+			return ErrTreeNotFound
+		}
+
+		if height <= t.getCompactedHeight(tx, cid, treeID) {
+			return nil
+		}
+
+		bLog := treeRoot.Bucket(logBucket)
+		bTree := treeRoot.Bucket(dataBucket)
+
+		var lm LogMove
+		var snapshot []LogMove
+
+		b := bytes.NewReader(nil)
+		r := io.NewBinReaderFromIO(b)
+
+		// State of the nodes changed after the height is taken from the log.
+		seen := make(map[Node]struct{})
+
+		var key [8]byte
+		binary.BigEndian.PutUint64(key[:], height)
+
+		c := bLog.Cursor()
+		for k, v := c.Seek(key[:]); len(k) == 8; k, v = c.Next() {
+			b.Reset(v)
+			if err := t.logFromBytes(&lm, r); err != nil {
+				return err
+			}
+			if _, ok := seen[lm.Child]; ok {
+				continue
+			}
+			seen[lm.Child] = struct{}{}
+
+			if lm.HasOld {
+				snapshot = append(snapshot, LogMove{Move: Move{
+					Parent: lm.Old.Parent,
+					Meta:   lm.Old.Meta,
+					Child:  lm.Child,
+				}})
+			}
+		}
+
+		// State of the other nodes is taken from the tree storage.
+		var mKey [9]byte
+
+		c = bTree.Cursor()
+		for k, v := c.Seek([]byte{'p'}); len(k) == 9 && k[0] == 'p'; k, v = c.Next() {
+			child := binary.LittleEndian.Uint64(k[1:])
+			if _, ok := seen[child]; ok {
+				continue
+			}
+
+			m := LogMove{Move: Move{
+				Parent: binary.LittleEndian.Uint64(v),
+				Child:  child,
+			}}
+			if err := m.Meta.FromBytes(bTree.Get(metaKey(mKey[:], child))); err != nil {
+				return err
+			}
+			snapshot = append(snapshot, m)
+		}
+
+		var old [][]byte
+
+		c = bLog.Cursor()
+		for k, _ := c.First(); len(k) == 8 && binary.BigEndian.Uint64(k) < height; k, _ = c.Next() {
+			old = append(old, append([]byte(nil), k...))
+		}
+
+		for i := range old {
+			if err := bLog.Delete(old[i]); err != nil {
+				return err
+			}
+		}
+
+		for i := range snapshot {
+			binary.BigEndian.PutUint64(key[:], snapshot[i].Time)
+			if err := bLog.Put(key[:], t.logToBytes(&snapshot[i])); err != nil {
+				return err
+			}
+		}
+
+		return treeRoot.Put(heightKey, toUint64(height))
+	})
+}
+
 func (t *boltForest) getPathPrefix(bTree *bbolt.Bucket, attr string, path []string) (int, Node, error) {
 	c := bTree.Cursor()
 
@@ -709,3 +1021,42 @@ func toUint64(x uint64) []byte {
 	binary.LittleEndian.PutUint64(a[:], x)
 	return a[:]
 }
+
+// 's' + parent (id) + escaped value + node (id in big-endian) -> 1
+//
+// Zero bytes in the value are escaped as 0x00 0xFF and the value is terminated
+// by 0x00 0x01, so the keys with the same parent are ordered by the value and then by node.
+func sortedChildKey(parent Node, value []byte, node Node) []byte {
+	key := make([]byte, 9, 9+len(value)+2+8+1)
+	key[0] = 's'
+	binary.LittleEndian.PutUint64(key[1:], parent)
+
+	for _, c := range value {
+		key = append(key, c)
+		if c == 0 {
+			key = append(key, 0xFF)
+		}
+	}
+	key = append(key, 0, 1)
+
+	var raw [8]byte
+	binary.BigEndian.PutUint64(raw[:], node)
+	return append(key, raw[:]...)
+}
+
+// unescapeValue decodes the value escaped by sortedChildKey.
+func unescapeValue(escaped []byte) []byte {
+	value := make([]byte, 0, len(escaped))
+	for i := 0; i < len(escaped); i++ {
+		if escaped[i] == 0 {
+			if i+1 < len(escaped) && escaped[i+1] == 0xFF {
+				value = append(value, 0)
+				i++
+				continue
+			}
+			break
+		}
+		value = append(value, escaped[i])
+	}
+	return value
+}
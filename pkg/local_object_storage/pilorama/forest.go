@@ -2,6 +2,7 @@ package pilorama
 
 import (
 	"sort"
+	"strings"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	cidSDK "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -163,6 +164,30 @@ func (f *memoryForest) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node
 	return res, nil
 }
 
+// TreeGetChildrenSorted implements the Forest interface.
+func (f *memoryForest) TreeGetChildrenSorted(cid cidSDK.ID, treeID string, nodeID Node, attr string, after *ChildrenCursor, count int) ([]NodeInfo, error) {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return nil, ErrTreeNotFound
+	}
+
+	children := s.childMap[nodeID]
+	if len(children) == 0 {
+		return nil, nil
+	}
+
+	res := make([]NodeInfo, len(children))
+	for i := range children {
+		res[i] = NodeInfo{
+			ID:       children[i],
+			ParentID: nodeID,
+			Meta:     s.infoMap[children[i]].Meta,
+		}
+	}
+	return sortChildren(res, attr, after, count), nil
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (f *memoryForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error) {
 	fullID := cid.String() + "/" + treeID
@@ -179,3 +204,50 @@ func (f *memoryForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64)
 	}
 	return s.operations[n].Move, nil
 }
+
+// TreeDrop implements the pilorama.Forest interface.
+func (f *memoryForest) TreeDrop(cid cidSDK.ID, treeID string) error {
+	prefix := cid.String() + "/"
+	if treeID == "" {
+		for k := range f.treeMap {
+			if strings.HasPrefix(k, prefix) {
+				delete(f.treeMap, k)
+			}
+		}
+		return nil
+	}
+
+	fullID := prefix + treeID
+	if _, ok := f.treeMap[fullID]; !ok {
+		return ErrTreeNotFound
+	}
+	delete(f.treeMap, fullID)
+	return nil
+}
+
+// TreeList implements the pilorama.Forest interface.
+func (f *memoryForest) TreeList(cid cidSDK.ID) ([]string, error) {
+	var res []string
+	prefix := cid.String() + "/"
+
+	for k := range f.treeMap {
+		if strings.HasPrefix(k, prefix) {
+			res = append(res, k[len(prefix):])
+		}
+	}
+
+	sort.Strings(res)
+	return res, nil
+}
+
+// TreeCompactLog implements the pilorama.Forest interface.
+func (f *memoryForest) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	fullID := cid.String() + "/" + treeID
+	s, ok := f.treeMap[fullID]
+	if !ok {
+		return ErrTreeNotFound
+	}
+
+	s.compact(height)
+	return nil
+}
@@ -671,3 +671,372 @@ func testMove(t *testing.T, s Forest, ts int, node, parent Node, d CIDDescriptor
 		},
 	}))
 }
+
+func TestForest_TreeDrop(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeDrop(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeDrop(t *testing.T, s Forest) {
+	const cidsSize = 3
+	var cids [cidsSize]cidSDK.ID
+
+	for i := range cids {
+		cids[i] = cidtest.ID()
+	}
+	cid := cids[0]
+
+	t.Run("return nil if not found", func(t *testing.T) {
+		require.ErrorIs(t, s.TreeDrop(cid, "123"), ErrTreeNotFound)
+	})
+
+	require.NoError(t, s.TreeDrop(cid, ""))
+
+	trees := []string{"tree1", "tree2"}
+	d := CIDDescriptor{cid, 0, 1}
+	for i := range trees {
+		_, err := s.TreeAddByPath(d, trees[i], AttributeFilename, []string{"path"},
+			[]KeyValue{{Key: "TreeName", Value: []byte(trees[i])}})
+		require.NoError(t, err)
+	}
+
+	err := s.TreeDrop(cid, trees[0])
+	require.NoError(t, err)
+
+	_, err = s.TreeGetByPath(cid, trees[0], AttributeFilename, []string{"path"}, true)
+	require.ErrorIs(t, err, ErrTreeNotFound)
+
+	_, err = s.TreeGetByPath(cid, trees[1], AttributeFilename, []string{"path"}, true)
+	require.NoError(t, err)
+
+	for j := range cids {
+		d.CID = cids[j]
+		for i := range trees {
+			_, err := s.TreeAddByPath(d, trees[i], AttributeFilename, []string{"path"},
+				[]KeyValue{{Key: "TreeName", Value: []byte(trees[i])}})
+			require.NoError(t, err)
+		}
+	}
+
+	list, err := s.TreeList(cid)
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+
+	require.NoError(t, s.TreeDrop(cid, ""))
+
+	list, err = s.TreeList(cid)
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	for j := 1; j < len(cids); j++ {
+		list, err = s.TreeList(cids[j])
+		require.NoError(t, err)
+		require.Equal(t, len(list), len(trees))
+	}
+}
+
+func TestForest_TreeList(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeList(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeList(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+
+	list, err := s.TreeList(cid)
+	require.NoError(t, err)
+	require.Nil(t, list)
+
+	expected := []string{"tree1", "tree2", "tree3"}
+	for i := range expected {
+		_, err := s.TreeMove(d, expected[i], &Move{Parent: RootID, Child: RootID})
+		require.NoError(t, err)
+	}
+
+	d.CID = cidtest.ID()
+	_, err = s.TreeMove(d, "other", &Move{Parent: RootID, Child: RootID})
+	require.NoError(t, err)
+
+	list, err = s.TreeList(cid)
+	require.NoError(t, err)
+	require.Equal(t, expected, list)
+}
+
+func TestForest_TreeCompactLog(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeCompactLog(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestTreeCompactLog(t *testing.T, constructor func(t testing.TB) Forest) {
+	rand.Seed(42)
+
+	const (
+		nodeCount = 5
+		opCount   = 30
+		height    = 20
+	)
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	ops := make([]Move, opCount)
+	for i := range ops {
+		ops[i] = Move{
+			Parent: rand.Uint64() % (nodeCount + 1),
+			Meta: Meta{
+				Time:  Timestamp(i),
+				Items: []KeyValue{{Key: AttributeFilename, Value: []byte(strconv.Itoa(i))}},
+			},
+			Child: rand.Uint64()%nodeCount + 1,
+		}
+		if rand.Uint32()%5 == 0 {
+			ops[i].Parent = TrashID
+		}
+	}
+
+	expected := constructor(t)
+	actual := constructor(t)
+	for i := range ops {
+		require.NoError(t, expected.TreeApply(d, treeID, &ops[i]))
+		require.NoError(t, actual.TreeApply(d, treeID, &ops[i]))
+	}
+
+	require.ErrorIs(t, actual.TreeCompactLog(cid, treeID+"123", height), ErrTreeNotFound)
+	require.NoError(t, actual.TreeCompactLog(cid, treeID, height))
+
+	requireEqualTrees := func(t *testing.T, expected, actual Forest) {
+		for i := uint64(1); i <= nodeCount; i++ {
+			expectedMeta, expectedParent, err := expected.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			actualMeta, actualParent, err := actual.TreeGetMeta(cid, treeID, i)
+			require.NoError(t, err)
+			require.Equal(t, expectedParent, actualParent, "node id: %d", i)
+			require.Equal(t, expectedMeta, actualMeta, "node id: %d", i)
+		}
+	}
+
+	t.Run("state is kept", func(t *testing.T) {
+		requireEqualTrees(t, expected, actual)
+	})
+
+	t.Run("log is shortened", func(t *testing.T) {
+		var count int
+		for h := uint64(0); ; count++ {
+			lm, err := actual.TreeGetOpLog(cid, treeID, h)
+			require.NoError(t, err)
+			if lm.Time == 0 && lm.Child == 0 {
+				break
+			}
+			h = lm.Time + 1
+		}
+		require.LessOrEqual(t, count, nodeCount+opCount-height)
+	})
+
+	t.Run("log can be replayed", func(t *testing.T) {
+		replica := constructor(t)
+		for h := uint64(0); ; {
+			lm, err := actual.TreeGetOpLog(cid, treeID, h)
+			require.NoError(t, err)
+			if lm.Time == 0 && lm.Child == 0 {
+				break
+			}
+			require.NoError(t, replica.TreeApply(d, treeID, &lm))
+			h = lm.Time + 1
+		}
+		requireEqualTrees(t, expected, replica)
+	})
+
+	t.Run("old operations are ignored", func(t *testing.T) {
+		require.NoError(t, actual.TreeApply(d, treeID, &Move{
+			Parent: TrashID,
+			Meta:   Meta{Time: height - 1},
+			Child:  1,
+		}))
+		requireEqualTrees(t, expected, actual)
+	})
+
+	t.Run("new operations are applied", func(t *testing.T) {
+		m := Move{
+			Parent: RootID,
+			Meta:   Meta{Time: opCount + 10},
+			Child:  1,
+		}
+		require.NoError(t, expected.TreeApply(d, treeID, &m))
+		require.NoError(t, actual.TreeApply(d, treeID, &m))
+
+		// Insert into the middle of the kept log.
+		m = Move{
+			Parent: 2,
+			Meta:   Meta{Time: opCount + 5, Items: []KeyValue{{Key: "key", Value: []byte("value")}}},
+			Child:  3,
+		}
+		require.NoError(t, expected.TreeApply(d, treeID, &m))
+		require.NoError(t, actual.TreeApply(d, treeID, &m))
+		requireEqualTrees(t, expected, actual)
+
+		lm, err := actual.TreeMove(d, treeID, &Move{Parent: RootID, Child: RootID})
+		require.NoError(t, err)
+		require.Greater(t, lm.Time, uint64(opCount+10))
+	})
+}
+
+func TestForest_TreeGetChildrenSorted(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeGetChildrenSorted(t, providers[i].construct(t))
+		})
+	}
+}
+
+func testForestTreeGetChildrenSorted(t *testing.T, s Forest) {
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	// Values are added in the arbitrary order, some of them are duplicated.
+	names := []string{"b", "a\x00", "c", "", "a", "b", "ab", "a\x01"}
+	for i := range names {
+		meta := Meta{Time: uint64(i), Items: []KeyValue{{Key: AttributeVersion, Value: []byte{byte(len(names) - i)}}}}
+		if names[i] != "" {
+			meta.Items = append(meta.Items, KeyValue{Key: AttributeFilename, Value: []byte(names[i])})
+		}
+		require.NoError(t, s.TreeApply(d, treeID, &Move{Parent: 1, Meta: meta, Child: Node(i + 2)}))
+	}
+
+	// Node with a single child.
+	testMove(t, s, 100, 1, RootID, d, treeID, "dir", "")
+
+	getIDs := func(t *testing.T, attr string, after *ChildrenCursor, count int) []Node {
+		res, err := s.TreeGetChildrenSorted(cid, treeID, 1, attr, after, count)
+		require.NoError(t, err)
+
+		ids := make([]Node, len(res))
+		for i := range res {
+			require.Equal(t, Node(1), res[i].ParentID)
+			require.Equal(t, names[res[i].ID-2], string(res[i].Meta.GetAttr(AttributeFilename)))
+			ids[i] = res[i].ID
+		}
+		return ids
+	}
+
+	// "", "a", "a\x00", "a\x01", "ab", "b", "b", "c"
+	expected := []Node{5, 6, 3, 9, 8, 2, 7, 4}
+
+	require.Equal(t, expected, getIDs(t, AttributeFilename, nil, 0))
+	require.Equal(t, expected[:3], getIDs(t, AttributeFilename, nil, 3))
+
+	t.Run("cursor", func(t *testing.T) {
+		require.Equal(t, expected[2:5], getIDs(t, AttributeFilename, &ChildrenCursor{Value: []byte("a")}, 3))
+		require.Equal(t, expected[6:], getIDs(t, AttributeFilename, &ChildrenCursor{Value: []byte("b"), ID: 2}, 0))
+		require.Equal(t, expected[7:], getIDs(t, AttributeFilename, &ChildrenCursor{Value: []byte("b")}, 0))
+		require.Equal(t, expected[5:], getIDs(t, AttributeFilename, &ChildrenCursor{Value: []byte("abc")}, 0))
+		require.Empty(t, getIDs(t, AttributeFilename, &ChildrenCursor{Value: []byte("c")}, 0))
+	})
+
+	t.Run("arbitrary attribute", func(t *testing.T) {
+		require.Equal(t, []Node{9, 8, 7, 6, 5, 4, 3, 2}, getIDs(t, AttributeVersion, nil, 0))
+		require.Equal(t, []Node{6, 5}, getIDs(t, AttributeVersion, &ChildrenCursor{Value: []byte{3}}, 2))
+	})
+
+	t.Run("node is moved", func(t *testing.T) {
+		testMove(t, s, 101, 3, RootID, d, treeID, "a\x00", "")
+		testMove(t, s, 102, 6, 1, d, treeID, "z", "")
+		names[6-2] = "z"
+
+		require.Equal(t, []Node{5, 9, 8, 2, 7, 4, 6}, getIDs(t, AttributeFilename, nil, 0))
+
+		res, err := s.TreeGetChildrenSorted(cid, treeID, RootID, AttributeFilename, nil, 0)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, Node(3), res[0].ID)
+		require.Equal(t, Node(1), res[1].ID)
+	})
+
+	t.Run("missing node", func(t *testing.T) {
+		res, err := s.TreeGetChildrenSorted(cid, treeID, 123, AttributeFilename, nil, 0)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+
+	t.Run("missing tree", func(t *testing.T) {
+		_, err := s.TreeGetChildrenSorted(cid, treeID+"123", 1, AttributeFilename, nil, 0)
+		require.ErrorIs(t, err, ErrTreeNotFound)
+	})
+}
+
+func TestForest_TreeGetChildrenSortedRandom(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeGetChildrenSortedRandom(t, providers[i].construct)
+		})
+	}
+}
+
+func testForestTreeGetChildrenSortedRandom(t *testing.T, constructor func(t testing.TB) Forest) {
+	rand.Seed(42)
+
+	const (
+		nodeCount = 10
+		opCount   = 50
+		iterCount = 10
+	)
+
+	cid := cidtest.ID()
+	d := CIDDescriptor{cid, 0, 1}
+	treeID := "version"
+
+	ops := make([]Move, opCount)
+	for i := range ops {
+		ops[i] = Move{
+			Parent: rand.Uint64() % (nodeCount + 1),
+			Meta: Meta{
+				Time:  Timestamp(i),
+				Items: []KeyValue{{Key: AttributeFilename, Value: []byte(strconv.Itoa(rand.Intn(5)))}},
+			},
+			Child: rand.Uint64()%nodeCount + 1,
+		}
+	}
+
+	expected := constructor(t)
+	for i := range ops {
+		require.NoError(t, expected.TreeApply(d, treeID, &ops[i]))
+	}
+
+	for i := 0; i < iterCount; i++ {
+		// Apply operations in the random order, so that undo and redo are performed.
+		rand.Shuffle(len(ops), func(i, j int) { ops[i], ops[j] = ops[j], ops[i] })
+
+		actual := constructor(t)
+		for i := range ops {
+			require.NoError(t, actual.TreeApply(d, treeID, &ops[i]))
+		}
+
+		for parent := Node(0); parent <= nodeCount; parent++ {
+			children, err := expected.TreeGetChildren(cid, treeID, parent)
+			require.NoError(t, err)
+
+			var expectedChildren []NodeInfo
+			for i := range children {
+				m, p, err := expected.TreeGetMeta(cid, treeID, children[i])
+				require.NoError(t, err)
+				expectedChildren = append(expectedChildren, NodeInfo{ID: children[i], ParentID: p, Meta: m})
+			}
+			expectedChildren = sortChildren(expectedChildren, AttributeFilename, nil, 0)
+
+			actualChildren, err := actual.TreeGetChildrenSorted(cid, treeID, parent, AttributeFilename, nil, 0)
+			require.NoError(t, err)
+			require.Equal(t, expectedChildren, actualChildren, "parent: %d", parent)
+		}
+	}
+}
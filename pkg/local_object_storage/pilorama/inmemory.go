@@ -1,5 +1,7 @@
 package pilorama
 
+import "sort"
+
 // nodeInfo couples parent and metadata.
 type nodeInfo struct {
 	Parent    Node
@@ -10,6 +12,8 @@ type nodeInfo struct {
 // state represents state being replicated.
 type state struct {
 	operations []LogMove
+	// height is the timestamp below which the log is compacted.
+	height Timestamp
 	tree
 }
 
@@ -51,6 +55,11 @@ func (s *state) undo(op *LogMove) {
 // Apply puts op in log at a proper position, re-applies all subsequent operations
 // from log and changes s in-place.
 func (s *state) Apply(op *Move) error {
+	if op.Time < s.height {
+		// Operation is already included in the compacted log.
+		return nil
+	}
+
 	var index int
 	for index = len(s.operations); index > 0; index-- {
 		if s.operations[index-1].Time <= op.Time {
@@ -122,10 +131,63 @@ func (s *state) removeChild(child, parent Node) {
 }
 
 func (s *state) timestamp(pos, size int) Timestamp {
-	if len(s.operations) == 0 {
-		return nextTimestamp(0, uint64(pos), uint64(size))
+	var ts Timestamp
+	if len(s.operations) != 0 {
+		ts = s.operations[len(s.operations)-1].Time
+	}
+	if ts+1 < s.height {
+		ts = s.height - 1
+	}
+	return nextTimestamp(ts, uint64(pos), uint64(size))
+}
+
+// compact replaces operations below the height with the last operation
+// applied to every node before the height.
+func (s *state) compact(height Timestamp) {
+	if height <= s.height {
+		return
+	}
+
+	index := sort.Search(len(s.operations), func(i int) bool {
+		return s.operations[i].Time >= height
+	})
+
+	var snapshot []LogMove
+
+	// State of the nodes changed after the height is taken from the log.
+	seen := make(map[Node]struct{})
+	for i := index; i < len(s.operations); i++ {
+		op := &s.operations[i]
+		if _, ok := seen[op.Child]; ok {
+			continue
+		}
+		seen[op.Child] = struct{}{}
+
+		if op.HasOld {
+			snapshot = append(snapshot, LogMove{Move: Move{
+				Parent: op.Old.Parent,
+				Meta:   op.Old.Meta,
+				Child:  op.Child,
+			}})
+		}
 	}
-	return nextTimestamp(s.operations[len(s.operations)-1].Time, uint64(pos), uint64(size))
+
+	for child, info := range s.infoMap {
+		if _, ok := seen[child]; !ok {
+			snapshot = append(snapshot, LogMove{Move: Move{
+				Parent: info.Parent,
+				Meta:   info.Meta,
+				Child:  child,
+			}})
+		}
+	}
+
+	sort.Slice(snapshot, func(i, j int) bool {
+		return snapshot[i].Time < snapshot[j].Time
+	})
+
+	s.operations = append(snapshot, s.operations[index:]...)
+	s.height = height
 }
 
 func (s *state) findSpareID() Node {
@@ -31,9 +31,29 @@ type Forest interface {
 	// TreeGetChildren returns children of the node with the specified ID. The order is arbitrary.
 	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
 	TreeGetChildren(cid cidSDK.ID, treeID string, nodeID Node) ([]uint64, error)
+	// TreeGetChildrenSorted returns children of the node with the specified ID sorted
+	// by the value of the attr in meta and then by ID. Nodes without the attribute go first.
+	// Only the children placed after the cursor are returned, nil cursor means the
+	// beginning of the list. Zero count means no limit.
+	// Should return ErrTreeNotFound if the tree is not found, and empty result if the node is not in the tree.
+	TreeGetChildrenSorted(cid cidSDK.ID, treeID string, nodeID Node, attr string, after *ChildrenCursor, count int) ([]NodeInfo, error)
 	// TreeGetOpLog returns first log operation stored at or above the height.
 	// In case no such operation is found, empty Move and nil error should be returned.
 	TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error)
+	// TreeDrop drops a tree from the database.
+	// If the tree is not found, ErrTreeNotFound should be returned.
+	// In case of empty treeID drops all trees related to container.
+	TreeDrop(cid cidSDK.ID, treeID string) error
+	// TreeList returns all the tree IDs that have been added to the
+	// passed container ID. Nil slice should be returned if no tree found.
+	TreeList(cid cidSDK.ID) ([]string, error)
+	// TreeCompactLog replaces all log operations stored below the height
+	// with the snapshot of the tree state at this height: only the last
+	// operation applied to every node is kept. Operations below the height
+	// are ignored after the compaction, so the height must be chosen so that
+	// every container node has already applied them.
+	// Should return ErrTreeNotFound if the tree is not found.
+	TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error
 }
 
 type ForestStorage interface {
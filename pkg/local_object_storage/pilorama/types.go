@@ -41,6 +41,23 @@ type LogMove struct {
 	Old    nodeInfo
 }
 
+// NodeInfo groups the information about the tree node.
+type NodeInfo struct {
+	ID       Node
+	ParentID Node
+	Meta     Meta
+}
+
+// ChildrenCursor is a position in the list of the node children
+// sorted by the attribute value.
+type ChildrenCursor struct {
+	// Value of the attribute of the last returned node.
+	Value []byte
+	// ID of the last returned node. If zero,
+	// all the nodes with the Value are skipped.
+	ID Node
+}
+
 const (
 	// RootID represents the ID of a root node.
 	RootID = 0
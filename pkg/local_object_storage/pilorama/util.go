@@ -1,5 +1,10 @@
 package pilorama
 
+import (
+	"bytes"
+	"sort"
+)
+
 // nextTimestamp accepts the latest local timestamp, node position in a container and container size.
 // Returns the next timestamp which can be generated by this node.
 func nextTimestamp(ts Timestamp, pos, size uint64) Timestamp {
@@ -9,3 +14,48 @@ func nextTimestamp(ts Timestamp, pos, size uint64) Timestamp {
 	}
 	return base + size
 }
+
+// sortChildren sorts nodes by the value of attr and then by ID, drops
+// the nodes placed before the cursor and truncates the result to count.
+func sortChildren(nodes []NodeInfo, attr string, after *ChildrenCursor, count int) []NodeInfo {
+	sort.Slice(nodes, func(i, j int) bool {
+		return compareChildren(nodes[i].Meta.GetAttr(attr), nodes[i].ID,
+			nodes[j].Meta.GetAttr(attr), nodes[j].ID) < 0
+	})
+
+	if after != nil {
+		i := sort.Search(len(nodes), func(i int) bool {
+			return after.isBefore(nodes[i].Meta.GetAttr(attr), nodes[i].ID)
+		})
+		nodes = nodes[i:]
+	}
+
+	if count > 0 && len(nodes) > count {
+		nodes = nodes[:count]
+	}
+	return nodes
+}
+
+// compareChildren compares nodes by the attribute value and then by ID.
+func compareChildren(v1 []byte, id1 Node, v2 []byte, id2 Node) int {
+	if c := bytes.Compare(v1, v2); c != 0 {
+		return c
+	}
+	switch {
+	case id1 < id2:
+		return -1
+	case id1 > id2:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// isBefore checks whether the cursor is placed before the node with
+// the specified attribute value and ID.
+func (c *ChildrenCursor) isBefore(value []byte, id Node) bool {
+	if c.ID == 0 {
+		return bytes.Compare(c.Value, value) < 0
+	}
+	return compareChildren(c.Value, c.ID, value, id) < 0
+}
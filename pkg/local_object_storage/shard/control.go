@@ -125,6 +125,7 @@ func (s *Shard) Init() error {
 		gcCfg:       s.gcCfg,
 		remover:     s.removeGarbage,
 		stopChannel: make(chan struct{}),
+		eventChan:   make(chan Event, 1),
 		mEventHandler: map[eventType]*eventHandlers{
 			eventNewEpoch: {
 				cancelFunc: func() {},
@@ -150,7 +151,7 @@ func (s *Shard) refillMetabase() error {
 
 	obj := objectSDK.New()
 
-	return blobstor.IterateBinaryObjects(s.blobStor, func(addr oid.Address, data []byte, blzID *blobovnicza.ID) error {
+	return blobstor.IterateBinaryObjects(s.blobStor, func(addr oid.Address, data []byte, storageID []byte) error {
 		if err := obj.Unmarshal(data); err != nil {
 			s.log.Warn("could not unmarshal object",
 				zap.Stringer("address", addr),
@@ -206,19 +207,32 @@ func (s *Shard) refillMetabase() error {
 
 		var mPrm meta.PutPrm
 		mPrm.SetObject(obj)
-		mPrm.SetBlobovniczaID(blzID)
+		if len(storageID) != 0 {
+			mPrm.SetBlobovniczaID(blobovnicza.NewIDFromBytes(storageID))
+		}
 
 		_, err := s.metaBase.Put(mPrm)
 		if err != nil && !meta.IsErrRemoved(err) && !errors.Is(err, object.ErrObjectIsExpired) {
 			return err
 		}
 
+		if sum, ok := blobstor.DeduplicatedPayload(obj); ok {
+			_, err = s.metaBase.AddPayloadRef(addr, sum)
+			if err != nil {
+				return fmt.Errorf("could not reference deduplicated payload: %w", err)
+			}
+		}
+
 		return nil
 	})
 }
 
 // Close releases all Shard's components.
 func (s *Shard) Close() error {
+	if s.gc != nil {
+		s.gc.stop()
+	}
+
 	components := []interface{ Close() error }{}
 
 	if s.pilorama != nil {
@@ -237,7 +251,5 @@ func (s *Shard) Close() error {
 		}
 	}
 
-	s.gc.stop()
-
 	return nil
 }
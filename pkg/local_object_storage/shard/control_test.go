@@ -8,6 +8,8 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
@@ -38,11 +40,14 @@ func TestShardOpen(t *testing.T) {
 		return New(
 			WithLogger(zaptest.NewLogger(t)),
 			WithBlobStorOptions(
-				blobstor.WithRootPath(filepath.Join(dir, "blob")),
-				blobstor.WithShallowDepth(1),
-				blobstor.WithSmallSizeLimit(1),
-				blobstor.WithBlobovniczaShallowWidth(1),
-				blobstor.WithBlobovniczaShallowDepth(1)),
+				blobstor.WithStorages([]blobstor.SubStorage{
+					{
+						Storage: fstree.New(
+							fstree.WithDirNameLen(2),
+							fstree.WithPath(filepath.Join(dir, "blob")),
+							fstree.WithDepth(1)),
+					},
+				})),
 			WithMetaBaseOptions(meta.WithPath(metaPath), meta.WithEpochState(epochState{})),
 			WithPiloramaOptions(
 				pilorama.WithPath(filepath.Join(dir, "pilorama"))),
@@ -80,11 +85,15 @@ func TestRefillMetabaseCorrupted(t *testing.T) {
 	dir := t.TempDir()
 
 	blobOpts := []blobstor.Option{
-		blobstor.WithRootPath(filepath.Join(dir, "blob")),
-		blobstor.WithShallowDepth(1),
-		blobstor.WithSmallSizeLimit(1),
-		blobstor.WithBlobovniczaShallowWidth(1),
-		blobstor.WithBlobovniczaShallowDepth(1)}
+		blobstor.WithStorages([]blobstor.SubStorage{
+			{
+				Storage: fstree.New(
+					fstree.WithDirNameLen(2),
+					fstree.WithPath(filepath.Join(dir, "blob")),
+					fstree.WithDepth(1)),
+			},
+		}),
+	}
 
 	sh := New(
 		WithBlobStorOptions(blobOpts...),
@@ -104,12 +113,12 @@ func TestRefillMetabaseCorrupted(t *testing.T) {
 	require.NoError(t, sh.Close())
 
 	addr := object.AddressOf(obj)
-	fs := fstree.FSTree{
-		DirNameLen: 2,
-		Depth:      1,
-		Info:       sh.blobStor.DumpInfo(),
-	}
-	require.NoError(t, fs.Put(addr, []byte("not an object")))
+	fs := fstree.New(
+		fstree.WithDirNameLen(2),
+		fstree.WithPath(filepath.Join(dir, "blob")),
+		fstree.WithDepth(1))
+	_, err = fs.Put(common.PutPrm{Address: addr, RawData: []byte("not an object")})
+	require.NoError(t, err)
 
 	sh = New(
 		WithBlobStorOptions(blobOpts...),
@@ -132,9 +141,21 @@ func TestRefillMetabase(t *testing.T) {
 	defer os.RemoveAll(p)
 
 	blobOpts := []blobstor.Option{
-		blobstor.WithRootPath(filepath.Join(p, "blob")),
-		blobstor.WithBlobovniczaShallowWidth(1),
-		blobstor.WithBlobovniczaShallowDepth(1),
+		blobstor.WithStorages([]blobstor.SubStorage{
+			{
+				Storage: blobovniczatree.NewBlobovniczaTree(
+					blobovniczatree.WithBlobovniczaShallowWidth(1),
+					blobovniczatree.WithBlobovniczaShallowDepth(1),
+					blobovniczatree.WithRootPath(filepath.Join(p, "blob", "blobovnicza"))),
+				Policy: func(_ *objectSDK.Object, data []byte) bool {
+					return len(data) <= 1<<20
+				},
+			},
+			{
+				Storage: fstree.New(
+					fstree.WithPath(filepath.Join(p, "blob"))),
+			},
+		}),
 	}
 
 	sh := New(
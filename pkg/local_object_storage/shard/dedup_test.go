@@ -0,0 +1,87 @@
+package shard_test
+
+import (
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_PayloadDedup(t *testing.T) {
+	dir := t.TempDir()
+	payloadDir := filepath.Join(dir, "payloads")
+
+	sh := newCustomShard(t, dir, false, []writecache.Option{writecache.WithMaxMemSize(0)},
+		[]blobstor.Option{
+			blobstor.WithPayloadDedup(blobstor.DedupPrm{
+				Storage: fstree.New(fstree.WithPath(payloadDir)),
+			}),
+		})
+	defer releaseShard(sh, t)
+
+	payload := make([]byte, blobstor.MinDedupPayloadSize)
+	_, _ = rand.Read(payload)
+
+	cnr := cidtest.ID()
+	objs := []*objectSDK.Object{
+		generateObjectWithPayload(cnr, payload),
+		generateObjectWithPayload(cnr, payload),
+	}
+
+	for _, obj := range objs {
+		obj.SetPayloadSize(uint64(len(payload)))
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	payloadFiles := func() int {
+		var n int
+
+		require.NoError(t, filepath.Walk(payloadDir, func(_ string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				n++
+			}
+			return err
+		}))
+
+		return n
+	}
+
+	require.Equal(t, 1, payloadFiles())
+
+	for _, obj := range objs {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(object.AddressOf(obj))
+
+		res, err := sh.Get(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object())
+	}
+
+	var delPrm shard.DeletePrm
+	delPrm.SetAddresses(object.AddressOf(objs[0]))
+
+	_, err := sh.Delete(delPrm)
+	require.NoError(t, err)
+	require.Equal(t, 1, payloadFiles())
+
+	delPrm = shard.DeletePrm{}
+	delPrm.SetAddresses(object.AddressOf(objs[1]))
+
+	_, err = sh.Delete(delPrm)
+	require.NoError(t, err)
+	require.Equal(t, 0, payloadFiles())
+}
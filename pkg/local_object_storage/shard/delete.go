@@ -1,8 +1,9 @@
 package shard
 
 import (
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"encoding/hex"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -35,10 +36,8 @@ func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
 	}
 
 	ln := len(prm.addr)
-	var delSmallPrm blobstor.DeleteSmallPrm
-	var delBigPrm blobstor.DeleteBigPrm
 
-	smalls := make(map[oid.Address]*blobovnicza.ID, ln)
+	smalls := make(map[oid.Address][]byte, ln)
 
 	for i := range prm.addr {
 		if s.hasWriteCache() {
@@ -60,45 +59,47 @@ func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
 			continue
 		}
 
-		if res.BlobovniczaID() != nil {
-			smalls[prm.addr[i]] = res.BlobovniczaID()
+		if id := res.BlobovniczaID(); id != nil {
+			smalls[prm.addr[i]] = *id
 		}
 	}
 
 	var delPrm meta.DeletePrm
 	delPrm.SetAddresses(prm.addr...)
 
-	_, err := s.metaBase.Delete(delPrm)
+	res, err := s.metaBase.Delete(delPrm)
 	if err != nil {
 		return DeleteRes{}, err // stop on metabase error ?
 	}
 
-	for i := range prm.addr { // delete small object
-		if id, ok := smalls[prm.addr[i]]; ok {
-			delSmallPrm.SetAddress(prm.addr[i])
-			delSmallPrm.SetBlobovniczaID(id)
-
-			_, err = s.blobStor.DeleteSmall(delSmallPrm)
-			if err != nil {
-				s.log.Debug("can't remove small object from blobStor",
-					zap.Stringer("object_address", prm.addr[i]),
-					zap.String("error", err.Error()))
-			}
-
-			continue
+	for i := range prm.addr {
+		var delPrm common.DeletePrm
+		delPrm.Address = prm.addr[i]
+
+		id, ok := smalls[prm.addr[i]]
+		if ok {
+			delPrm.StorageID = id
+		} else {
+			// object is not stored in blobovnicza tree
+			delPrm.StorageID = []byte{}
 		}
 
-		// delete big object
-
-		delBigPrm.SetAddress(prm.addr[i])
-
-		_, err = s.blobStor.DeleteBig(delBigPrm)
+		_, err = s.blobStor.Delete(delPrm)
 		if err != nil {
-			s.log.Debug("can't remove big object from blobStor",
+			s.log.Debug("can't remove object from blobStor",
 				zap.Stringer("object_address", prm.addr[i]),
 				zap.String("error", err.Error()))
 		}
 	}
 
+	for _, sum := range res.ReleasedPayloads() {
+		err = s.blobStor.ReleasePayload(sum)
+		if err != nil {
+			s.log.Debug("can't remove deduplicated payload from blobStor",
+				zap.String("checksum", hex.EncodeToString(sum[:])),
+				zap.String("error", err.Error()))
+		}
+	}
+
 	return DeleteRes{}, nil
 }
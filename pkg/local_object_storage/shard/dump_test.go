@@ -13,6 +13,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
@@ -288,14 +290,27 @@ func TestDumpIgnoreErrors(t *testing.T) {
 
 	dir := t.TempDir()
 	bsPath := filepath.Join(dir, "blob")
-	bsOpts := []blobstor.Option{
-		blobstor.WithSmallSizeLimit(bsSmallObjectSize),
-		blobstor.WithRootPath(bsPath),
-		blobstor.WithCompressObjects(true),
-		blobstor.WithShallowDepth(1),
-		blobstor.WithBlobovniczaShallowDepth(1),
-		blobstor.WithBlobovniczaShallowWidth(2),
-		blobstor.WithBlobovniczaOpenedCacheSize(1),
+	bsOpts := func(sw uint64) []blobstor.Option {
+		return []blobstor.Option{
+			blobstor.WithCompressObjects(true),
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{
+					Storage: blobovniczatree.NewBlobovniczaTree(
+						blobovniczatree.WithRootPath(filepath.Join(bsPath, "blobovnicza")),
+						blobovniczatree.WithBlobovniczaShallowDepth(1),
+						blobovniczatree.WithBlobovniczaShallowWidth(sw),
+						blobovniczatree.WithOpenedCacheSize(1)),
+					Policy: func(_ *objectSDK.Object, data []byte) bool {
+						return len(data) <= bsSmallObjectSize
+					},
+				},
+				{
+					Storage: fstree.New(
+						fstree.WithPath(bsPath),
+						fstree.WithDepth(1)),
+				},
+			}),
+		}
 	}
 	wcPath := filepath.Join(dir, "writecache")
 	wcOpts := []writecache.Option{
@@ -303,7 +318,7 @@ func TestDumpIgnoreErrors(t *testing.T) {
 		writecache.WithSmallObjectSize(wcSmallObjectSize),
 		writecache.WithMaxObjectSize(wcBigObjectSize),
 	}
-	sh := newCustomShard(t, dir, true, wcOpts, bsOpts)
+	sh := newCustomShard(t, dir, true, wcOpts, bsOpts(2))
 
 	objects := make([]*objectSDK.Object, objCount)
 	for i := 0; i < objCount; i++ {
@@ -351,8 +366,7 @@ func TestDumpIgnoreErrors(t *testing.T) {
 		require.NoError(t, os.MkdirAll(filepath.Join(bsPath, "ZZ"), 0))
 	}
 
-	bsOpts = append(bsOpts, blobstor.WithBlobovniczaShallowWidth(3))
-	sh = newCustomShard(t, dir, true, wcOpts, bsOpts)
+	sh = newCustomShard(t, dir, true, wcOpts, bsOpts(3))
 	require.NoError(t, sh.SetMode(mode.ReadOnly))
 
 	{
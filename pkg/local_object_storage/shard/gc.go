@@ -50,6 +50,41 @@ func EventNewEpoch(e uint64) Event {
 	}
 }
 
+// NotificationChannel returns channel for shard events.
+//
+// Returns nil channel if the shard is not initialized yet.
+func (s *Shard) NotificationChannel() chan<- Event {
+	if s.gc == nil {
+		return nil
+	}
+
+	return s.gc.eventChan
+}
+
+// NotifyGC passes the event to the GC of the shard without blocking.
+// If GC is busy and the previous event is still pending, the pending
+// event is replaced, so GC handles the latest epoch when it is free.
+//
+// Does nothing if the shard is not initialized yet.
+func (s *Shard) NotifyGC(ev Event) {
+	if s.gc == nil {
+		return
+	}
+
+	for {
+		select {
+		case s.gc.eventChan <- ev:
+			return
+		default:
+		}
+
+		select {
+		case <-s.gc.eventChan:
+		default:
+		}
+	}
+}
+
 type eventHandler func(context.Context, Event)
 
 type eventHandlers struct {
@@ -70,12 +105,11 @@ type gc struct {
 
 	remover func()
 
+	eventChan     chan Event
 	mEventHandler map[eventType]*eventHandlers
 }
 
 type gcCfg struct {
-	eventChan <-chan Event
-
 	removerInterval time.Duration
 
 	log *logger.Logger
@@ -84,11 +118,7 @@ type gcCfg struct {
 }
 
 func defaultGCCfg() *gcCfg {
-	ch := make(chan Event)
-	close(ch)
-
 	return &gcCfg{
-		eventChan:       ch,
 		removerInterval: 10 * time.Second,
 		log:             zap.L(),
 		workerPoolInit: func(int) util.WorkerPool {
@@ -109,7 +139,12 @@ func (gc *gc) init() {
 	}
 
 	go gc.tickRemover()
-	go gc.listenEvents()
+
+	// Events can't be handled without the worker pool,
+	// so the pending event is just replaced by NotifyGC.
+	if gc.workerPool != nil {
+		go gc.listenEvents()
+	}
 }
 
 func (gc *gc) listenEvents() {
@@ -0,0 +1,30 @@
+package shard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_NotifyGC(t *testing.T) {
+	// GC of the shard without worker pool doesn't listen to the events,
+	// so the pending event must be replaced instead of blocking the sender
+	sh := newShard(t, false)
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	done := make(chan struct{})
+	go func() {
+		for i := uint64(1); i <= 3; i++ {
+			sh.NotifyGC(shard.EventNewEpoch(i))
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "NotifyGC is blocked by the pending event")
+	}
+}
@@ -2,9 +2,10 @@ package shard
 
 import (
 	"fmt"
+	"time"
 
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
@@ -14,8 +15,9 @@ import (
 )
 
 // storFetcher is a type to unify object fetching mechanism in `fetchObjectData`
-// method. It represents generalization of `getSmall` and `getBig` methods.
-type storFetcher = func(stor *blobstor.BlobStor, id *blobovnicza.ID) (*objectSDK.Object, error)
+// method. It represents generalization of `Get` and `GetRange` methods of BlobStor.
+// Storage ID is nil if it is unknown.
+type storFetcher = func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object, error)
 
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
@@ -61,39 +63,27 @@ func (r GetRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
 func (s *Shard) Get(prm GetPrm) (GetRes, error) {
-	var big, small storFetcher
+	cb := func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object, error) {
+		var getPrm common.GetPrm
+		getPrm.Address = prm.addr
+		getPrm.StorageID = id
 
-	big = func(stor *blobstor.BlobStor, _ *blobovnicza.ID) (*objectSDK.Object, error) {
-		var getBigPrm blobstor.GetBigPrm
-		getBigPrm.SetAddress(prm.addr)
-
-		res, err := stor.GetBig(getBigPrm)
-		if err != nil {
-			return nil, err
-		}
-
-		return res.Object(), nil
-	}
-
-	small = func(stor *blobstor.BlobStor, id *blobovnicza.ID) (*objectSDK.Object, error) {
-		var getSmallPrm blobstor.GetSmallPrm
-		getSmallPrm.SetAddress(prm.addr)
-		getSmallPrm.SetBlobovniczaID(id)
-
-		res, err := stor.GetSmall(getSmallPrm)
+		res, err := stor.Get(getPrm)
 		if err != nil {
 			return nil, err
 		}
 
-		return res.Object(), nil
+		return res.Object, nil
 	}
 
 	wc := func(c writecache.Cache) (*objectSDK.Object, error) {
 		return c.Get(prm.addr)
 	}
 
+	start := time.Now()
 	skipMeta := prm.skipMeta || s.GetMode().NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, big, small, wc)
+	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	s.reportIO(start, err)
 
 	return GetRes{
 		obj:     obj,
@@ -102,7 +92,7 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 }
 
 // fetchObjectData looks through writeCache and blobStor to find object.
-func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, big, small storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
+func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
 	var (
 		err error
 		res *objectSDK.Object
@@ -134,11 +124,7 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, big, small stor
 	}
 
 	if skipMeta || err != nil {
-		res, err = small(s.blobStor, nil)
-		if err == nil || IsErrOutOfRange(err) {
-			return res, false, err
-		}
-		res, err = big(s.blobStor, nil)
+		res, err = cb(s.blobStor, nil)
 		return res, false, err
 	}
 
@@ -156,11 +142,12 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, big, small stor
 		return nil, true, fmt.Errorf("can't fetch blobovnicza id from metabase: %w", err)
 	}
 
-	if mRes.BlobovniczaID() != nil {
-		res, err = small(s.blobStor, mRes.BlobovniczaID())
-	} else {
-		res, err = big(s.blobStor, nil)
+	storageID := []byte{}
+	if id := mRes.BlobovniczaID(); id != nil {
+		storageID = *id
 	}
 
+	res, err = cb(s.blobStor, storageID)
+
 	return res, true, err
 }
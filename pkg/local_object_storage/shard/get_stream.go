@@ -0,0 +1,80 @@
+package shard
+
+import (
+	"io"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// GetStreamRes groups the resulting values of GetStream operation.
+type GetStreamRes struct {
+	hdr     *objectSDK.Object
+	payload io.ReadCloser
+	hasMeta bool
+}
+
+// Header returns the header of the requested object.
+func (r GetStreamRes) Header() *objectSDK.Object {
+	return r.hdr
+}
+
+// Payload returns the reader of the requested object payload.
+// It must be closed by the caller.
+func (r GetStreamRes) Payload() io.ReadCloser {
+	return r.payload
+}
+
+// HasMeta returns true if info about the object was found in the metabase.
+func (r GetStreamRes) HasMeta() bool {
+	return r.hasMeta
+}
+
+// GetStream reads an object header from shard and returns the reader of
+// the object payload. Payload of the big objects is read on the fly
+// without loading the whole object into memory.
+//
+// Returns the same errors as Get.
+func (s *Shard) GetStream(prm GetPrm) (GetStreamRes, error) {
+	var payload io.ReadCloser
+
+	cb := func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object, error) {
+		var getPrm common.GetPrm
+		getPrm.Address = prm.addr
+		getPrm.StorageID = id
+
+		res, err := stor.GetStream(getPrm)
+		if err != nil {
+			return nil, err
+		}
+
+		payload = res.Payload
+
+		return res.Header, nil
+	}
+
+	wc := func(c writecache.Cache) (*objectSDK.Object, error) {
+		res, err := c.GetStream(prm.addr)
+		if err != nil {
+			return nil, err
+		}
+
+		payload = res.Payload
+
+		return res.Header, nil
+	}
+
+	start := time.Now()
+	skipMeta := prm.skipMeta || s.GetMode().NoMetabase()
+	hdr, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	s.reportIO(start, err)
+
+	return GetStreamRes{
+		hdr:     hdr,
+		payload: payload,
+		hasMeta: hasMeta,
+	}, err
+}
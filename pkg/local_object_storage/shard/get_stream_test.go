@@ -0,0 +1,58 @@
+package shard_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_GetStream(t *testing.T) {
+	t.Run("without write cache", func(t *testing.T) {
+		testShardGetStream(t, false)
+	})
+
+	t.Run("with write cache", func(t *testing.T) {
+		testShardGetStream(t, true)
+	})
+}
+
+func testShardGetStream(t *testing.T, hasWriteCache bool) {
+	sh := newShard(t, hasWriteCache)
+	defer releaseShard(sh, t)
+
+	for _, size := range []int{0, 1 << 5, 1 << 20} {
+		obj := generateObject(t)
+		addAttribute(obj, "foo", "bar")
+		addPayload(obj, size)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(object.AddressOf(obj))
+
+		res, err := sh.GetStream(getPrm)
+		require.NoError(t, err)
+
+		require.Equal(t, object.AddressOf(obj), object.AddressOf(res.Header()))
+		require.Equal(t, obj.PayloadSize(), res.Header().PayloadSize())
+		require.Empty(t, res.Header().Payload())
+
+		payload, err := io.ReadAll(res.Payload())
+		require.NoError(t, err)
+		require.NoError(t, res.Payload().Close())
+		require.Equal(t, obj.Payload(), payload)
+	}
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(object.AddressOf(generateObject(t)))
+
+	_, err := sh.GetStream(getPrm)
+	require.True(t, shard.IsErrNotFound(err))
+}
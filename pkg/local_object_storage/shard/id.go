@@ -44,6 +44,10 @@ func (s *Shard) UpdateID() (err error) {
 		s.info.ID = NewIDFromBytes(id)
 	}
 
+	if s.metricsWriter != nil {
+		s.metricsWriter.SetShardID(s.info.ID.String())
+	}
+
 	s.log = s.log.With(zap.String("shard_id", s.info.ID.String()))
 	s.metaBase.SetLogger(s.log)
 	s.blobStor.SetLogger(s.log)
@@ -28,6 +28,10 @@ type Info struct {
 	// Weight parameters of the shard.
 	WeightValues WeightValues
 
+	// ObjectCounters contains object counters of the shard,
+	// zero in the "degraded" mode.
+	ObjectCounters meta.ObjectCounters
+
 	// ErrorCount contains amount of errors occurred in shard operations.
 	ErrorCount uint32
 
@@ -37,5 +41,7 @@ type Info struct {
 
 // DumpInfo returns information about the Shard.
 func (s *Shard) DumpInfo() Info {
-	return s.info
+	info := s.info
+	info.WeightValues = s.WeightValues()
+	return info
 }
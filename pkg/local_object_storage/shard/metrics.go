@@ -0,0 +1,63 @@
+package shard
+
+import (
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+)
+
+// MetricsWriter is an interface that must store shard's metrics.
+type MetricsWriter interface {
+	// SetShardID must set the identifier of the shard the metrics belong to.
+	SetShardID(id string)
+	// SetObjectCounter must set the value of the object counter
+	// of the specified type.
+	SetObjectCounter(objectType string, v uint64)
+}
+
+// Types of the object counters passed to the MetricsWriter.
+const (
+	// Number of the physically stored objects.
+	ObjectCounterPhysical = "phy"
+	// Number of the logically available objects.
+	ObjectCounterLogical = "logic"
+	// Number of the stored tombstones.
+	ObjectCounterTombstone = "tombstone"
+	// Number of the stored locks.
+	ObjectCounterLock = "lock"
+	// Number of the stored objects waiting for the GC.
+	ObjectCounterGarbage = "garbage"
+)
+
+// ObjectCounterTypes lists all the types of the object counters.
+var ObjectCounterTypes = []string{
+	ObjectCounterPhysical,
+	ObjectCounterLogical,
+	ObjectCounterTombstone,
+	ObjectCounterLock,
+	ObjectCounterGarbage,
+}
+
+// WithMetricsWriter returns option to specify the writer of the shard's metrics.
+func WithMetricsWriter(v MetricsWriter) Option {
+	return func(c *cfg) {
+		c.metricsWriter = v
+	}
+}
+
+// ObjectCounters returns the object counters of the shard.
+//
+// Returns ErrDegradedMode error if shard is in "degraded" mode.
+func (s *Shard) ObjectCounters() (meta.ObjectCounters, error) {
+	if s.GetMode().NoMetabase() {
+		return meta.ObjectCounters{}, ErrDegradedMode
+	}
+
+	return s.metaBase.ObjectCounters()
+}
+
+func (c *cfg) setObjectCounters(oc meta.ObjectCounters) {
+	c.metricsWriter.SetObjectCounter(ObjectCounterPhysical, oc.Phy)
+	c.metricsWriter.SetObjectCounter(ObjectCounterLogical, oc.Logic)
+	c.metricsWriter.SetObjectCounter(ObjectCounterTombstone, oc.Tombstones)
+	c.metricsWriter.SetObjectCounter(ObjectCounterLock, oc.Locks)
+	c.metricsWriter.SetObjectCounter(ObjectCounterGarbage, oc.Garbage)
+}
@@ -0,0 +1,115 @@
+package shard_test
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+type metricsStore struct {
+	mtx sync.Mutex
+	id  string
+	cnt map[string]uint64
+}
+
+func (m *metricsStore) SetShardID(id string) {
+	m.mtx.Lock()
+	m.id = id
+	m.mtx.Unlock()
+}
+
+func (m *metricsStore) SetObjectCounter(objectType string, v uint64) {
+	m.mtx.Lock()
+	m.cnt[objectType] = v
+	m.mtx.Unlock()
+}
+
+func (m *metricsStore) counters() map[string]uint64 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	res := make(map[string]uint64, len(m.cnt))
+	for k, v := range m.cnt {
+		res[k] = v
+	}
+	return res
+}
+
+func TestShard_ObjectCounters(t *testing.T) {
+	dir := t.TempDir()
+	mm := &metricsStore{cnt: make(map[string]uint64)}
+	id := shard.NewIDFromBytes([]byte{1, 2, 3})
+
+	sh := shard.New(
+		shard.WithID(id),
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{{
+				Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "blob"))),
+			}})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(epochState{})),
+		shard.WithMetricsWriter(mm),
+	)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	require.Equal(t, id.String(), mm.id)
+
+	check := func(t *testing.T, phy, logic uint64) {
+		c, err := sh.ObjectCounters()
+		require.NoError(t, err)
+		require.Equal(t, meta.ObjectCounters{Phy: phy, Logic: logic, Garbage: phy - logic}, c)
+
+		require.Equal(t, map[string]uint64{
+			shard.ObjectCounterPhysical:  phy,
+			shard.ObjectCounterLogical:   logic,
+			shard.ObjectCounterTombstone: 0,
+			shard.ObjectCounterLock:      0,
+			shard.ObjectCounterGarbage:   phy - logic,
+		}, mm.counters())
+	}
+
+	check(t, 0, 0)
+
+	cnr := cidtest.ID()
+	addrs := make([]oid.Address, 3)
+	for i := range addrs {
+		obj := generateObjectWithCID(t, cnr)
+		addrs[i] = objectcore.AddressOf(obj)
+
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+
+	check(t, 3, 3)
+
+	var inhumePrm shard.InhumePrm
+	inhumePrm.MarkAsGarbage(addrs[0])
+
+	_, err := sh.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	check(t, 3, 2)
+
+	var delPrm shard.DeletePrm
+	delPrm.SetAddresses(addrs[0], addrs[1])
+
+	_, err = sh.Delete(delPrm)
+	require.NoError(t, err)
+
+	check(t, 1, 1)
+}
@@ -2,9 +2,12 @@ package shard
 
 import (
 	"fmt"
+	"time"
 
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
@@ -28,14 +31,26 @@ func (p *PutPrm) SetObject(obj *object.Object) {
 // did not allow to completely save the object.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
+// Returns ErrShardFull error if the fill watermark of the shard is reached.
 func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 	m := s.GetMode()
 	if m.ReadOnly() {
 		return PutRes{}, ErrReadOnlyMode
 	}
+	if s.isFull() {
+		return PutRes{}, ErrShardFull
+	}
+
+	start := time.Now()
+	res, err := s.put(prm, m)
+	s.reportIO(start, err)
 
-	var putPrm blobstor.PutPrm // form Put parameters
-	putPrm.SetObject(prm.obj)
+	return res, err
+}
+
+func (s *Shard) put(prm PutPrm, m mode.Mode) (PutRes, error) {
+	var putPrm common.PutPrm // form Put parameters
+	putPrm.Object = prm.obj
 
 	// exist check are not performed there, these checks should be executed
 	// ahead of `Put` by storage engine
@@ -51,7 +66,7 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 
 	var (
 		err error
-		res blobstor.PutRes
+		res common.PutRes
 	)
 
 	if res, err = s.blobStor.Put(putPrm); err != nil {
@@ -61,7 +76,9 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 	if !m.NoMetabase() {
 		var pPrm meta.PutPrm
 		pPrm.SetObject(prm.obj)
-		pPrm.SetBlobovniczaID(res.BlobovniczaID())
+		if len(res.StorageID) != 0 {
+			pPrm.SetBlobovniczaID(blobovnicza.NewIDFromBytes(res.StorageID))
+		}
 		if _, err := s.metaBase.Put(pPrm); err != nil {
 			// may we need to handle this case in a special way
 			// since the object has been successfully written to BlobStor
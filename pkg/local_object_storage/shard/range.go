@@ -1,8 +1,8 @@
 package shard
 
 import (
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -66,41 +66,23 @@ func (r RngRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
 func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
-	var big, small storFetcher
-
 	rng := object.NewRange()
 	rng.SetOffset(prm.off)
 	rng.SetLength(prm.ln)
 
-	big = func(stor *blobstor.BlobStor, _ *blobovnicza.ID) (*object.Object, error) {
-		var getRngBigPrm blobstor.GetRangeBigPrm
-		getRngBigPrm.SetAddress(prm.addr)
-		getRngBigPrm.SetRange(rng)
-
-		res, err := stor.GetRangeBig(getRngBigPrm)
-		if err != nil {
-			return nil, err
-		}
-
-		obj := object.New()
-		obj.SetPayload(res.RangeData())
-
-		return obj, nil
-	}
-
-	small = func(stor *blobstor.BlobStor, id *blobovnicza.ID) (*object.Object, error) {
-		var getRngSmallPrm blobstor.GetRangeSmallPrm
-		getRngSmallPrm.SetAddress(prm.addr)
-		getRngSmallPrm.SetRange(rng)
-		getRngSmallPrm.SetBlobovniczaID(id)
+	cb := func(stor *blobstor.BlobStor, id []byte) (*object.Object, error) {
+		var getRngPrm common.GetRangePrm
+		getRngPrm.Address = prm.addr
+		getRngPrm.Range = *rng
+		getRngPrm.StorageID = id
 
-		res, err := stor.GetRangeSmall(getRngSmallPrm)
+		res, err := stor.GetRange(getRngPrm)
 		if err != nil {
 			return nil, err
 		}
 
 		obj := object.New()
-		obj.SetPayload(res.RangeData())
+		obj.SetPayload(res.Data)
 
 		return obj, nil
 	}
@@ -124,7 +106,7 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 	}
 
 	skipMeta := prm.skipMeta || s.GetMode().NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, big, small, wc)
+	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
 
 	return RngRes{
 		obj:     obj,
@@ -2,11 +2,14 @@ package shard_test
 
 import (
 	"math"
+	"path/filepath"
 	"testing"
 
 	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
@@ -60,9 +63,24 @@ func testShardGetRange(t *testing.T, hasWriteCache bool) {
 			testCase{true, "object in write-cache, out of range, big offset", 100, newRange(101, math.MaxUint64-10)})
 	}
 
-	sh := newCustomShard(t, t.TempDir(), hasWriteCache,
+	dir := t.TempDir()
+	sh := newCustomShard(t, dir, hasWriteCache,
 		[]writecache.Option{writecache.WithMaxMemSize(0), writecache.WithMaxObjectSize(writeCacheMaxSize)},
-		[]blobstor.Option{blobstor.WithSmallSizeLimit(smallObjectSize)})
+		[]blobstor.Option{blobstor.WithStorages([]blobstor.SubStorage{
+			{
+				Storage: blobovniczatree.NewBlobovniczaTree(
+					blobovniczatree.WithRootPath(filepath.Join(dir, "blob", "blobovnicza")),
+					blobovniczatree.WithBlobovniczaShallowDepth(1),
+					blobovniczatree.WithBlobovniczaShallowWidth(1)),
+				Policy: func(_ *objectSDK.Object, data []byte) bool {
+					return len(data) <= smallObjectSize
+				},
+			},
+			{
+				Storage: fstree.New(
+					fstree.WithPath(filepath.Join(dir, "blob"))),
+			},
+		})})
 	defer releaseShard(sh, t)
 
 	for _, tc := range testCases {
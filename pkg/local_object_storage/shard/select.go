@@ -9,15 +9,27 @@ import (
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
+// SelectCursor is a type for continuous object selection.
+type SelectCursor = meta.SelectCursor
+
+// NewSelectCursor returns cursor which makes Select
+// return objects following the object with the specified identifier.
+func NewSelectCursor(id oid.ID) *SelectCursor {
+	return meta.NewSelectCursor(id)
+}
+
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+	limit   uint32
+	cursor  *SelectCursor
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *SelectCursor
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -30,11 +42,30 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetLimit sets maximum amount of addresses that Select should return.
+// Zero means no limit.
+func (p *SelectPrm) SetLimit(limit uint32) {
+	p.limit = limit
+}
+
+// SetCursor sets cursor for Select operation. For initial request
+// ignore this param or use nil value. For consecutive requests, use value
+// from SelectRes.
+func (p *SelectPrm) SetCursor(cursor *SelectCursor) {
+	p.cursor = cursor
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns cursor for consecutive select requests.
+// Returns nil if there are no more objects to select.
+func (r SelectRes) Cursor() *SelectCursor {
+	return r.cursor
+}
+
 // Select selects the objects from shard that match select parameters.
 //
 // Returns any error encountered that
@@ -47,6 +78,8 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 	var selectPrm meta.SelectPrm
 	selectPrm.SetFilters(prm.filters)
 	selectPrm.SetContainerID(prm.cnr)
+	selectPrm.SetLimit(prm.limit)
+	selectPrm.SetCursor(prm.cursor)
 
 	mRes, err := s.metaBase.Select(selectPrm)
 	if err != nil {
@@ -55,5 +88,6 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 
 	return SelectRes{
 		addrList: mRes.AddressList(),
+		cursor:   mRes.Cursor(),
 	}, nil
 }
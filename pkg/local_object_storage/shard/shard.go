@@ -13,6 +13,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -30,6 +31,8 @@ type Shard struct {
 
 	metaBase *meta.DB
 
+	weight *weightSampler
+
 	tsSource TombstoneSource
 }
 
@@ -52,7 +55,8 @@ type cfg struct {
 
 	rmBatchSize int
 
-	useWriteCache bool
+	// can be switched off at runtime, see FlushWriteCache
+	useWriteCache atomic.Bool
 
 	info Info
 
@@ -75,13 +79,20 @@ type cfg struct {
 	deletedLockCallBack DeletedLockCallback
 
 	tsSource TombstoneSource
+
+	weightSampleInterval time.Duration
+
+	fillWatermark float64
+
+	metricsWriter MetricsWriter
 }
 
 func defaultCfg() *cfg {
 	return &cfg{
-		rmBatchSize: 100,
-		log:         zap.L(),
-		gcCfg:       defaultGCCfg(),
+		rmBatchSize:          100,
+		log:                  zap.L(),
+		gcCfg:                defaultGCCfg(),
+		weightSampleInterval: defaultWeightSampleInterval,
 	}
 }
 
@@ -93,11 +104,22 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
-	bs := blobstor.New(c.blobOpts...)
-	mb := meta.New(c.metaOpts...)
+	metaOpts := c.metaOpts
+	if c.metricsWriter != nil {
+		metaOpts = append(metaOpts[:len(metaOpts):len(metaOpts)],
+			meta.WithCountersHandler(c.setObjectCounters))
+
+		if c.info.ID != nil {
+			c.metricsWriter.SetShardID(c.info.ID.String())
+		}
+	}
+
+	mb := meta.New(metaOpts...)
+	bs := blobstor.New(append(c.blobOpts[:len(c.blobOpts):len(c.blobOpts)],
+		blobstor.WithPayloadRefs(mb))...)
 
 	var writeCache writecache.Cache
-	if c.useWriteCache {
+	if c.useWriteCache.Load() {
 		writeCache = writecache.New(
 			append(c.writeCacheOpts,
 				writecache.WithBlobstor(bs),
@@ -110,6 +132,7 @@ func New(opts ...Option) *Shard {
 		metaBase:   mb,
 		writeCache: writeCache,
 		tsSource:   c.tsSource,
+		weight:     new(weightSampler),
 	}
 
 	if s.piloramaOpts != nil {
@@ -167,13 +190,13 @@ func WithLogger(l *logger.Logger) Option {
 // WithWriteCache returns option to toggle write cache usage.
 func WithWriteCache(use bool) Option {
 	return func(c *cfg) {
-		c.useWriteCache = use
+		c.useWriteCache.Store(use)
 	}
 }
 
 // hasWriteCache returns bool if write cache exists on shards.
 func (s Shard) hasWriteCache() bool {
-	return s.cfg.useWriteCache
+	return s.cfg.useWriteCache.Load()
 }
 
 // needRefillMetabase returns true if metabase is needed to be refilled.
@@ -189,18 +212,28 @@ func WithRemoverBatchSize(sz int) Option {
 	}
 }
 
-// WithGCWorkerPoolInitializer returns option to set initializer of
-// worker pool with specified worker number.
-func WithGCWorkerPoolInitializer(wpInit func(int) util.WorkerPool) Option {
+// WithWeightSampleInterval returns option to set the minimum interval
+// between two samples of the free disk space of the shard.
+func WithWeightSampleInterval(d time.Duration) Option {
 	return func(c *cfg) {
-		c.gcCfg.workerPoolInit = wpInit
+		c.weightSampleInterval = d
 	}
 }
 
-// WithGCEventChannel returns option to set a GC event channel.
-func WithGCEventChannel(eventChan <-chan Event) Option {
+// WithFillWatermark returns option to set the used part of the disk space,
+// from 0 to 1, starting from which new objects are not accepted by the shard.
+// Zero value disables the limit.
+func WithFillWatermark(ratio float64) Option {
 	return func(c *cfg) {
-		c.gcCfg.eventChan = eventChan
+		c.fillWatermark = ratio
+	}
+}
+
+// WithGCWorkerPoolInitializer returns option to set initializer of
+// worker pool with specified worker number.
+func WithGCWorkerPoolInitializer(wpInit func(int) util.WorkerPool) Option {
+	return func(c *cfg) {
+		c.gcCfg.workerPoolInit = wpInit
 	}
 }
 
@@ -264,7 +297,7 @@ func (s *Shard) fillInfo() {
 	s.cfg.info.BlobStorInfo = s.blobStor.DumpInfo()
 	s.cfg.info.Mode = s.GetMode()
 
-	if s.cfg.useWriteCache {
+	if s.cfg.useWriteCache.Load() {
 		s.cfg.info.WriteCacheInfo = s.writeCache.DumpInfo()
 	}
 	if s.pilorama != nil {
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
@@ -49,9 +51,21 @@ func newCustomShard(t testing.TB, rootPath string, enableWriteCache bool, wcOpts
 		shard.WithLogger(zap.L()),
 		shard.WithBlobStorOptions(
 			append([]blobstor.Option{
-				blobstor.WithRootPath(filepath.Join(rootPath, "blob")),
-				blobstor.WithBlobovniczaShallowWidth(2),
-				blobstor.WithBlobovniczaShallowDepth(2),
+				blobstor.WithStorages([]blobstor.SubStorage{
+					{
+						Storage: blobovniczatree.NewBlobovniczaTree(
+							blobovniczatree.WithRootPath(filepath.Join(rootPath, "blob", "blobovnicza")),
+							blobovniczatree.WithBlobovniczaShallowDepth(2),
+							blobovniczatree.WithBlobovniczaShallowWidth(2)),
+						Policy: func(_ *object.Object, data []byte) bool {
+							return len(data) <= 1<<20
+						},
+					},
+					{
+						Storage: fstree.New(
+							fstree.WithPath(filepath.Join(rootPath, "blob"))),
+					},
+				}),
 			}, bsOpts...)...,
 		),
 		shard.WithMetaBaseOptions(
@@ -69,6 +69,14 @@ func (s *Shard) TreeGetChildren(cid cidSDK.ID, treeID string, nodeID pilorama.No
 	return s.pilorama.TreeGetChildren(cid, treeID, nodeID)
 }
 
+// TreeGetChildrenSorted implements the pilorama.Forest interface.
+func (s *Shard) TreeGetChildrenSorted(cid cidSDK.ID, treeID string, nodeID pilorama.Node, attr string, after *pilorama.ChildrenCursor, count int) ([]pilorama.NodeInfo, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeGetChildrenSorted(cid, treeID, nodeID, attr, after, count)
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (s *Shard) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilorama.Move, error) {
 	if s.pilorama == nil {
@@ -76,3 +84,33 @@ func (s *Shard) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (pilor
 	}
 	return s.pilorama.TreeGetOpLog(cid, treeID, height)
 }
+
+// TreeDrop implements the pilorama.Forest interface.
+func (s *Shard) TreeDrop(cid cidSDK.ID, treeID string) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+	if s.GetMode().ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeDrop(cid, treeID)
+}
+
+// TreeList implements the pilorama.Forest interface.
+func (s *Shard) TreeList(cid cidSDK.ID) ([]string, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeList(cid)
+}
+
+// TreeCompactLog implements the pilorama.Forest interface.
+func (s *Shard) TreeCompactLog(cid cidSDK.ID, treeID string, height uint64) error {
+	if s.pilorama == nil {
+		return ErrPiloramaDisabled
+	}
+	if s.GetMode().ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	return s.pilorama.TreeCompactLog(cid, treeID, height)
+}
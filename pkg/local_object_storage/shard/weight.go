@@ -1,12 +1,135 @@
 package shard
 
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
 // WeightValues groups values of Shard weight parameters.
 type WeightValues struct {
 	// Amount of free disk space. Measured in kilobytes.
 	FreeSpace uint64
+	// Used part of the most filled disk of the shard, from 0 to 1.
+	FillRatio float64
+	// Part of the recent I/O operations which have failed, from 0 to 1.
+	ErrorRate float64
+	// Average duration of the recent I/O operations.
+	Latency time.Duration
+}
+
+// ErrShardFull is returned by Put when the used disk space
+// has reached the fill watermark of the shard.
+var ErrShardFull = errors.New("shard is full")
+
+const (
+	// defaultWeightSampleInterval is the default minimum interval
+	// between two samples of the disk space.
+	defaultWeightSampleInterval = 30 * time.Second
+
+	// ioSmoothingFactor is the weight of the last I/O operation
+	// in the moving averages of the error rate and latency.
+	ioSmoothingFactor = 0.05
+)
+
+// weightSampler keeps the recent values of the shard weight parameters.
+type weightSampler struct {
+	mtx sync.Mutex
+
+	lastSample time.Time
+
+	freeSpace uint64
+	fillRatio float64
+
+	errorRate float64
+	latency   float64
 }
 
 // WeightValues returns current weight values of the Shard.
+//
+// Disk space is sampled at most once per the interval set by
+// WithWeightSampleInterval, the previous values are returned in between.
 func (s *Shard) WeightValues() WeightValues {
-	return s.info.WeightValues
+	s.weight.mtx.Lock()
+	defer s.weight.mtx.Unlock()
+
+	if now := time.Now(); now.Sub(s.weight.lastSample) >= s.weightSampleInterval {
+		s.weight.lastSample = now
+		s.sampleDiskSpace()
+	}
+
+	return WeightValues{
+		FreeSpace: s.weight.freeSpace,
+		FillRatio: s.weight.fillRatio,
+		ErrorRate: s.weight.errorRate,
+		Latency:   time.Duration(s.weight.latency),
+	}
+}
+
+// sampleDiskSpace updates the free space and the fill ratio of the shard
+// according to the file systems of the BLOB storage and write-cache paths.
+// Free space of the most filled file system is used.
+func (s *Shard) sampleDiskSpace() {
+	paths := make([]string, 0, len(s.info.BlobStorInfo.SubStorages)+1)
+	for _, sub := range s.info.BlobStorInfo.SubStorages {
+		paths = append(paths, sub.Path)
+	}
+	if s.writeCache != nil {
+		paths = append(paths, s.writeCache.DumpInfo().Path)
+	}
+
+	var (
+		sampled   bool
+		freeSpace uint64
+		fillRatio float64
+	)
+
+	for i := range paths {
+		free, total, err := diskSpace(paths[i])
+		if err != nil {
+			s.log.Debug("could not sample free disk space",
+				zap.String("path", paths[i]),
+				zap.Error(err))
+			continue
+		}
+
+		if !sampled || free < freeSpace {
+			freeSpace = free
+		}
+		if total != 0 {
+			if ratio := 1 - float64(free)/float64(total); ratio > fillRatio {
+				fillRatio = ratio
+			}
+		}
+		sampled = true
+	}
+
+	if sampled {
+		s.weight.freeSpace = freeSpace
+		s.weight.fillRatio = fillRatio
+	}
+}
+
+// reportIO updates the error rate and the latency of the shard
+// with the result of the I/O operation started at the specified time.
+func (s *Shard) reportIO(start time.Time, err error) {
+	var failed float64
+	if err != nil && !IsErrNotFound(err) && !IsErrRemoved(err) &&
+		!IsErrObjectExpired(err) && !IsErrOutOfRange(err) {
+		failed = 1
+	}
+
+	d := float64(time.Since(start))
+
+	s.weight.mtx.Lock()
+	s.weight.errorRate += (failed - s.weight.errorRate) * ioSmoothingFactor
+	s.weight.latency += (d - s.weight.latency) * ioSmoothingFactor
+	s.weight.mtx.Unlock()
+}
+
+// isFull checks whether the used disk space has reached the fill watermark.
+func (s *Shard) isFull() bool {
+	return s.fillWatermark > 0 && s.WeightValues().FillRatio >= s.fillWatermark
 }
@@ -0,0 +1,11 @@
+//go:build windows
+// +build windows
+
+package shard
+
+import "errors"
+
+// diskSpace is not supported on this platform.
+func diskSpace(string) (uint64, uint64, error) {
+	return 0, 0, errors.New("disk space sampling is not supported")
+}
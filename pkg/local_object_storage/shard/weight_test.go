@@ -0,0 +1,73 @@
+package shard_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_WeightValues(t *testing.T) {
+	newShard := func(t *testing.T, watermark float64) *shard.Shard {
+		dir := t.TempDir()
+		sh := shard.New(
+			shard.WithFillWatermark(watermark),
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages([]blobstor.SubStorage{{
+					Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "blob"))),
+				}})),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(dir, "meta")),
+				meta.WithEpochState(epochState{})))
+		require.NoError(t, sh.Open())
+		require.NoError(t, sh.Init())
+		t.Cleanup(func() { require.NoError(t, sh.Close()) })
+		return sh
+	}
+
+	t.Run("disk space", func(t *testing.T) {
+		sh := newShard(t, 0)
+
+		wv := sh.WeightValues()
+		require.NotZero(t, wv.FreeSpace)
+		require.True(t, wv.FillRatio > 0 && wv.FillRatio <= 1, "fill ratio: %f", wv.FillRatio)
+		require.Equal(t, wv, sh.DumpInfo().WeightValues)
+	})
+
+	t.Run("I/O statistics", func(t *testing.T) {
+		sh := newShard(t, 0)
+
+		obj := generateObjectWithCID(t, cidtest.ID())
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(obj)
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+
+		wv := sh.WeightValues()
+		require.NotZero(t, wv.Latency)
+		require.Zero(t, wv.ErrorRate)
+
+		// Missing objects are not I/O errors.
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(object.AddressOf(generateObjectWithCID(t, cidtest.ID())))
+		_, err = sh.Get(getPrm)
+		require.True(t, shard.IsErrNotFound(err), "got: %v", err)
+		require.Zero(t, sh.WeightValues().ErrorRate)
+	})
+
+	t.Run("fill watermark", func(t *testing.T) {
+		sh := newShard(t, 1e-9)
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(generateObjectWithCID(t, cidtest.ID()))
+		_, err := sh.Put(putPrm)
+		require.ErrorIs(t, err, shard.ErrShardFull)
+	})
+}
@@ -0,0 +1,18 @@
+//go:build !windows
+// +build !windows
+
+package shard
+
+import "syscall"
+
+// diskSpace returns free and total space of the file system
+// containing the path. Measured in kilobytes.
+func diskSpace(path string) (free uint64, total uint64, err error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, 0, err
+	}
+
+	bsize := uint64(st.Bsize)
+	return st.Bavail * bsize / 1024, st.Blocks * bsize / 1024, nil
+}
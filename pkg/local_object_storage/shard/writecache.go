@@ -0,0 +1,88 @@
+package shard
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"go.uber.org/zap"
+)
+
+var errWriteCacheDisabled = errors.New("write-cache is disabled")
+
+// FlushWriteCachePrm represents parameters of a `FlushWriteCache` operation.
+type FlushWriteCachePrm struct {
+	disable bool
+}
+
+// SetDisableWriteCache sets a flag to switch the write-cache off after the flush.
+// Objects are put directly to the blobstor then. The write-cache is used
+// again only after the shard is re-created.
+func (p *FlushWriteCachePrm) SetDisableWriteCache(disable bool) {
+	p.disable = disable
+}
+
+// FlushWriteCache synchronously flushes all the objects from the write-cache
+// to the blobstor and the metabase.
+//
+// Returns ErrReadOnlyMode or ErrDegradedMode if the shard can't accept the objects.
+func (s *Shard) FlushWriteCache(prm FlushWriteCachePrm) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if !s.hasWriteCache() {
+		return errWriteCacheDisabled
+	}
+
+	// To write data to the blobstor and the metabase we need to be in read-write mode.
+	if s.info.Mode.ReadOnly() {
+		return ErrReadOnlyMode
+	}
+	if s.info.Mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	if !prm.disable {
+		return s.writeCache.Flush()
+	}
+
+	// New objects are put directly to the blobstor
+	// while the write-cache is read-only.
+	if err := s.writeCache.SetMode(mode.ReadOnly); err != nil {
+		return fmt.Errorf("could not switch write-cache to read-only mode: %w", err)
+	}
+
+	if err := s.writeCache.Flush(); err != nil {
+		if mErr := s.writeCache.SetMode(s.info.Mode); mErr != nil {
+			s.log.Error("could not restore write-cache mode",
+				zap.Stringer("mode", s.info.Mode),
+				zap.String("error", mErr.Error()))
+		}
+
+		return err
+	}
+
+	s.useWriteCache.Store(false)
+	s.info.WriteCacheInfo = writecache.Info{}
+
+	if err := s.writeCache.Close(); err != nil {
+		return fmt.Errorf("could not close write-cache: %w", err)
+	}
+
+	s.log.Info("write-cache is switched off")
+
+	return nil
+}
+
+// WriteCacheStat returns the statistics of the shard's write-cache.
+func (s *Shard) WriteCacheStat() (writecache.Stat, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if !s.hasWriteCache() {
+		return writecache.Stat{}, errWriteCacheDisabled
+	}
+
+	return s.writeCache.Stat()
+}
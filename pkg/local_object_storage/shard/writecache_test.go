@@ -0,0 +1,76 @@
+package shard_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushWriteCache(t *testing.T) {
+	sh := newShard(t, true)
+	defer releaseShard(sh, t)
+
+	objects := []*objectSDK.Object{
+		generateObject(t),
+		generateObjectWithPayload(cidtest.ID(), make([]byte, 64<<10)), // stored in FSTree
+	}
+
+	for i := range objects {
+		var putPrm shard.PutPrm
+		putPrm.SetObject(objects[i])
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	st, err := sh.WriteCacheStat()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), st.DBObjects)
+	require.Equal(t, uint64(1), st.FSObjects)
+	require.NotZero(t, st.DiskSize)
+
+	var prm shard.FlushWriteCachePrm
+
+	t.Run("read-only shard", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+		require.ErrorIs(t, sh.FlushWriteCache(prm), shard.ErrReadOnlyMode)
+		require.NoError(t, sh.SetMode(mode.ReadWrite))
+	})
+
+	require.NoError(t, sh.FlushWriteCache(prm))
+
+	st, err = sh.WriteCacheStat()
+	require.NoError(t, err)
+	require.Zero(t, st.NotFlushed)
+
+	prm.SetDisableWriteCache(true)
+	require.NoError(t, sh.FlushWriteCache(prm))
+	require.Empty(t, sh.DumpInfo().WriteCacheInfo.Path)
+
+	_, err = sh.WriteCacheStat()
+	require.Error(t, err)
+	require.Error(t, sh.FlushWriteCache(prm))
+
+	// Objects are available without the write-cache, new ones are put to the blobstor.
+	objects = append(objects, generateObject(t))
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(objects[len(objects)-1])
+
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+
+	for i := range objects {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(object.AddressOf(objects[i]))
+
+		res, err := sh.Get(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, objects[i], res.Object())
+	}
+}
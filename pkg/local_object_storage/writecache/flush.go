@@ -1,13 +1,13 @@
 package writecache
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
 	"github.com/mr-tron/base58"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -136,8 +136,8 @@ func (c *cache) flushBigObjects() {
 
 			evictNum := 0
 
-			var prm fstree.IterationPrm
-			prm.WithLazyHandler(func(addr oid.Address, f func() ([]byte, error)) error {
+			var prm common.IteratePrm
+			prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
 				sAddr := addr.EncodeToString()
 
 				if _, ok := c.store.flushed.Peek(sAddr); ok {
@@ -151,10 +151,16 @@ func (c *cache) flushBigObjects() {
 				}
 
 				c.mtx.Lock()
-				_, compress := c.compressFlags[sAddr]
+				codec, compress := c.compressFlags[sAddr]
 				c.mtx.Unlock()
 
-				if _, err := c.blobstor.PutRaw(addr, data, compress); err != nil {
+				var pPrm common.PutPrm
+				pPrm.Address = addr
+				pPrm.RawData = data
+				pPrm.DontCompress = !compress
+				pPrm.Codec = codec
+
+				if _, err := c.blobstor.Put(pPrm); err != nil {
 					c.log.Error("cant flush object to blobstor", zap.Error(err))
 					return nil
 				}
@@ -171,9 +177,9 @@ func (c *cache) flushBigObjects() {
 				evictNum++
 
 				return nil
-			})
+			}
 
-			_ = c.fsTree.Iterate(prm)
+			_, _ = c.fsTree.Iterate(prm)
 
 			// evict objects which were successfully written to BlobStor
 			c.evictObjects(evictNum)
@@ -227,15 +233,14 @@ func (c *cache) writeObject(obj *object.Object, metaOnly bool) error {
 	var id *blobovnicza.ID
 
 	if !metaOnly {
-		var prm blobstor.PutPrm
-		prm.SetObject(obj)
-
-		res, err := c.blobstor.Put(prm)
+		res, err := c.blobstor.Put(common.PutPrm{Object: obj})
 		if err != nil {
 			return err
 		}
 
-		id = res.BlobovniczaID()
+		if len(res.StorageID) != 0 {
+			id = blobovnicza.NewIDFromBytes(res.StorageID)
+		}
 	}
 
 	var pPrm meta.PutPrm
@@ -251,3 +256,107 @@ func cloneBytes(a []byte) []byte {
 	copy(b, a)
 	return b
 }
+
+// Flush synchronously flushes all objects from the write-cache to the main storage.
+// Objects which are kept in memory are persisted in the database first.
+//
+// Write-cache operations are blocked until the flush is finished.
+// Main storage must be writable. Returns the first error encountered.
+func (c *cache) Flush() error {
+	c.modeMtx.Lock()
+	defer c.modeMtx.Unlock()
+
+	if !c.readOnly() {
+		c.persistMemoryCache()
+	}
+
+	var (
+		addrs [][]byte
+		count int
+	)
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, _ []byte) error {
+			if _, ok := c.flushed.Peek(string(k)); !ok {
+				addrs = append(addrs, cloneBytes(k))
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("could not read write-cache database: %w", err)
+	}
+
+	for i := range addrs {
+		var data []byte
+
+		err := c.db.View(func(tx *bbolt.Tx) error {
+			data = cloneBytes(tx.Bucket(defaultBucket).Get(addrs[i]))
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("could not read object %s from write-cache database: %w", addrs[i], err)
+		}
+
+		if err := c.flushObject(string(addrs[i]), data); err != nil {
+			return err
+		}
+
+		c.flushed.Add(string(addrs[i]), true)
+		count++
+	}
+
+	var prm common.IteratePrm
+	prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
+		sAddr := addr.EncodeToString()
+
+		if _, ok := c.flushed.Peek(sAddr); ok {
+			return nil
+		}
+
+		data, err := f()
+		if err != nil {
+			return fmt.Errorf("could not read object %s from write-cache: %w", sAddr, err)
+		}
+
+		if err := c.flushObject(sAddr, data); err != nil {
+			return err
+		}
+
+		c.mtx.Lock()
+		delete(c.compressFlags, sAddr)
+		c.mtx.Unlock()
+
+		c.flushed.Add(sAddr, false)
+		count++
+
+		return nil
+	}
+
+	if _, err := c.fsTree.Iterate(prm); err != nil {
+		return err
+	}
+
+	c.log.Info("write-cache is flushed", zap.Int("count", count))
+
+	return nil
+}
+
+// flushObject writes an object from the write-cache to the main storage.
+func (c *cache) flushObject(addr string, data []byte) error {
+	obj := object.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return fmt.Errorf("could not unmarshal object %s: %w", addr, err)
+	}
+
+	if err := c.writeObject(obj, false); err != nil {
+		return fmt.Errorf("could not flush object %s: %w", addr, err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package writecache
 
 import (
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -13,6 +14,50 @@ import (
 func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 	saddr := addr.EncodeToString()
 
+	if obj, ok, err := c.getSmall(saddr); ok {
+		return obj, err
+	}
+
+	res, err := c.fsTree.Get(common.GetPrm{Address: addr})
+	if err != nil {
+		var errNotFound apistatus.ObjectNotFound
+
+		return nil, errNotFound
+	}
+
+	c.flushed.Get(saddr)
+	return res.Object, nil
+}
+
+// GetStream returns object header and payload reader from write-cache.
+// Payload of the big objects is read on the fly.
+//
+// Returns an error of type apistatus.ObjectNotFound if the requested object is missing in write-cache.
+func (c *cache) GetStream(addr oid.Address) (common.GetStreamRes, error) {
+	saddr := addr.EncodeToString()
+
+	if obj, ok, err := c.getSmall(saddr); ok {
+		if err != nil {
+			return common.GetStreamRes{}, err
+		}
+
+		return common.StreamObject(obj), nil
+	}
+
+	res, err := c.fsTree.GetStream(common.GetPrm{Address: addr})
+	if err != nil {
+		var errNotFound apistatus.ObjectNotFound
+
+		return common.GetStreamRes{}, errNotFound
+	}
+
+	c.flushed.Get(saddr)
+	return res, nil
+}
+
+// getSmall returns the object from memory or database.
+// Returns false if the object is missing there.
+func (c *cache) getSmall(saddr string) (*objectSDK.Object, bool, error) {
 	c.mtx.RLock()
 	for i := range c.mem {
 		if saddr == c.mem[i].addr {
@@ -22,7 +67,7 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 			// of unintentional object corruption by caller.
 			// It is safe to unmarshal without mutex, as storage under `c.mem[i].data` slices is not reused.
 			obj := objectSDK.New()
-			return obj, obj.Unmarshal(data)
+			return obj, true, obj.Unmarshal(data)
 		}
 	}
 	c.mtx.RUnlock()
@@ -31,23 +76,10 @@ func (c *cache) Get(addr oid.Address) (*objectSDK.Object, error) {
 	if err == nil {
 		obj := objectSDK.New()
 		c.flushed.Get(saddr)
-		return obj, obj.Unmarshal(value)
-	}
-
-	data, err := c.fsTree.Get(addr)
-	if err != nil {
-		var errNotFound apistatus.ObjectNotFound
-
-		return nil, errNotFound
+		return obj, true, obj.Unmarshal(value)
 	}
 
-	obj := objectSDK.New()
-	if err := obj.Unmarshal(data); err != nil {
-		return nil, err
-	}
-
-	c.flushed.Get(saddr)
-	return obj, nil
+	return nil, false, nil
 }
 
 // Head returns object header from write-cache.
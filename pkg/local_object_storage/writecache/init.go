@@ -4,8 +4,7 @@ import (
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
-	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -15,14 +14,14 @@ import (
 func (c *cache) initFlushMarks() {
 	c.log.Info("filling flush marks for objects in FSTree")
 
-	var prm fstree.IterationPrm
-	prm.WithLazyHandler(func(addr oid.Address, _ func() ([]byte, error)) error {
+	var prm common.IteratePrm
+	prm.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
 		if c.isFlushed(addr) {
 			c.store.flushed.Add(addr.EncodeToString(), true)
 		}
 		return nil
-	})
-	_ = c.fsTree.Iterate(prm)
+	}
+	_, _ = c.fsTree.Iterate(prm)
 
 	c.log.Info("filling flush marks for objects in database")
 
@@ -75,9 +74,6 @@ func (c *cache) isFlushed(addr oid.Address) bool {
 		return false
 	}
 
-	var prm blobstor.ExistsPrm
-	prm.SetAddress(addr)
-
-	res, err := c.blobstor.Exists(prm)
-	return err == nil && res.Exists()
+	res, err := c.blobstor.Exists(common.ExistsPrm{Address: addr})
+	return err == nil && res.Exists
 }
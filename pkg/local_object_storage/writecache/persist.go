@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
 	"go.etcd.io/bbolt"
 	"go.uber.org/zap"
@@ -113,12 +115,16 @@ func (c *cache) persistBigObject(objInfo objectInfo) {
 	cacheSz := c.estimateCacheSize()
 	metaIndex := 0
 	if c.incSizeFS(cacheSz) <= c.maxCacheSize {
-		err := c.fsTree.Put(object.AddressOf(objInfo.obj), objInfo.data)
+		_, err := c.fsTree.Put(common.PutPrm{
+			Address:      object.AddressOf(objInfo.obj),
+			RawData:      objInfo.data,
+			DontCompress: true,
+		})
 		if err == nil {
 			metaIndex = 1
-			if c.blobstor.NeedsCompression(objInfo.obj) {
+			if codec := c.blobstor.CompressionCodec(objInfo.obj); codec != compression.TypeNone {
 				c.mtx.Lock()
-				c.compressFlags[objInfo.addr] = struct{}{}
+				c.compressFlags[objInfo.addr] = codec
 				c.mtx.Unlock()
 			}
 			c.objCounters.IncFS()
@@ -0,0 +1,95 @@
+package writecache
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// Stat groups the statistics of the write-cache.
+type Stat struct {
+	// Number of objects kept in memory.
+	MemObjects uint64
+	// Total size of objects kept in memory.
+	MemSize uint64
+	// Number of objects in the database.
+	DBObjects uint64
+	// Number of objects in the FSTree.
+	FSObjects uint64
+	// Number of objects which are not flushed to the main storage yet.
+	// Objects kept in memory are not flushed.
+	NotFlushed uint64
+	// Total size of the write-cache files on disk.
+	DiskSize uint64
+}
+
+// Stat returns the statistics of the write-cache.
+//
+// All the write-cache objects are listed, so the operation can take some time.
+func (c *cache) Stat() (Stat, error) {
+	c.modeMtx.RLock()
+	defer c.modeMtx.RUnlock()
+
+	var st Stat
+
+	c.mtx.RLock()
+	st.MemObjects = uint64(len(c.mem))
+	st.MemSize = c.curMemSize
+	c.mtx.RUnlock()
+
+	st.NotFlushed = st.MemObjects
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(defaultBucket)
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, _ []byte) error {
+			st.DBObjects++
+			if _, ok := c.flushed.Peek(string(k)); !ok {
+				st.NotFlushed++
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return Stat{}, fmt.Errorf("could not read write-cache database: %w", err)
+	}
+
+	var prm common.IteratePrm
+	prm.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
+		st.FSObjects++
+		if _, ok := c.flushed.Peek(addr.EncodeToString()); !ok {
+			st.NotFlushed++
+		}
+		return nil
+	}
+
+	if _, err := c.fsTree.Iterate(prm); err != nil {
+		return Stat{}, fmt.Errorf("could not iterate over write-cache FSTree: %w", err)
+	}
+
+	err = filepath.WalkDir(c.path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		st.DiskSize += uint64(info.Size())
+		return nil
+	})
+	if err != nil {
+		return Stat{}, fmt.Errorf("could not calculate write-cache disk size: %w", err)
+	}
+
+	return st, nil
+}
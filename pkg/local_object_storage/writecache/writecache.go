@@ -3,6 +3,8 @@ package writecache
 import (
 	"sync"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -20,6 +22,7 @@ type Info struct {
 // Cache represents write-cache for objects.
 type Cache interface {
 	Get(address oid.Address) (*object.Object, error)
+	GetStream(oid.Address) (common.GetStreamRes, error)
 	Head(oid.Address) (*object.Object, error)
 	Delete(oid.Address) error
 	Iterate(IterationPrm) error
@@ -27,6 +30,8 @@ type Cache interface {
 	SetMode(mode.Mode) error
 	SetLogger(*zap.Logger)
 	DumpInfo() Info
+	Flush() error
+	Stat() (Stat, error)
 
 	Init() error
 	Open(readOnly bool) error
@@ -43,9 +48,10 @@ type cache struct {
 	mode    mode.Mode
 	modeMtx sync.RWMutex
 
-	// compressFlags maps address of a big object to boolean value indicating
-	// whether object should be compressed.
-	compressFlags map[string]struct{}
+	// compressFlags maps address of a big object to the type of the codec
+	// the object should be compressed with. Objects which should not be
+	// compressed are absent.
+	compressFlags map[string]compression.Type
 
 	// curMemSize is the current size of all objects cached in memory.
 	curMemSize uint64
@@ -93,7 +99,7 @@ func New(opts ...Option) Cache {
 		evictCh:  make(chan []byte),
 		mode:     mode.ReadWrite,
 
-		compressFlags: make(map[string]struct{}),
+		compressFlags: make(map[string]compression.Type),
 		options: options{
 			log:             zap.NewNop(),
 			maxMemSize:      maxInMemorySizeBytes,
@@ -19,10 +19,17 @@ type (
 		rangeDuration                 prometheus.Counter
 		searchDuration                prometheus.Counter
 		listObjectsDuration           prometheus.Counter
+
+		objectCounter *prometheus.GaugeVec
 	}
 )
 
-const engineSubsystem = "engine"
+const (
+	engineSubsystem = "engine"
+
+	shardIDLabelKey     = "shard"
+	counterTypeLabelKey = "type"
+)
 
 func newEngineMetrics() engineMetrics {
 	var (
@@ -102,6 +109,13 @@ func newEngineMetrics() engineMetrics {
 			Name:      "list_objects_duration",
 			Help:      "Accumulated duration of engine list objects operations",
 		})
+
+		objectCounter = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "object_counter",
+			Help:      "Number of objects in the shard by the counter type",
+		}, []string{shardIDLabelKey, counterTypeLabelKey})
 	)
 
 	return engineMetrics{
@@ -116,6 +130,7 @@ func newEngineMetrics() engineMetrics {
 		rangeDuration:                 rangeDuration,
 		searchDuration:                searchDuration,
 		listObjectsDuration:           listObjectsDuration,
+		objectCounter:                 objectCounter,
 	}
 }
 
@@ -131,6 +146,7 @@ func (m engineMetrics) register() {
 	prometheus.MustRegister(m.rangeDuration)
 	prometheus.MustRegister(m.searchDuration)
 	prometheus.MustRegister(m.listObjectsDuration)
+	prometheus.MustRegister(m.objectCounter)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
@@ -176,3 +192,17 @@ func (m engineMetrics) AddSearchDuration(d time.Duration) {
 func (m engineMetrics) AddListObjectsDuration(d time.Duration) {
 	m.listObjectsDuration.Add(float64(d))
 }
+
+func (m engineMetrics) SetObjectCounter(shardID, objectType string, v uint64) {
+	m.objectCounter.With(prometheus.Labels{
+		shardIDLabelKey:     shardID,
+		counterTypeLabelKey: objectType,
+	}).Set(float64(v))
+}
+
+func (m engineMetrics) DeleteObjectCounter(shardID, objectType string) {
+	m.objectCounter.Delete(prometheus.Labels{
+		shardIDLabelKey:     shardID,
+		counterTypeLabelKey: objectType,
+	})
+}
@@ -8,6 +8,8 @@ type NodeMetrics struct {
 	objectServiceMetrics
 	engineMetrics
 	stateMetrics
+	replicatorMetrics
+	objectLimitMetrics
 	epoch prometheus.Gauge
 }
 
@@ -21,6 +23,12 @@ func NewNodeMetrics() *NodeMetrics {
 	state := newStateMetrics()
 	state.register()
 
+	replicator := newReplicatorMetrics()
+	replicator.register()
+
+	objectLimits := newObjectLimitMetrics()
+	objectLimits.register()
+
 	epoch := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Subsystem: innerRingSubsystem,
@@ -33,6 +41,8 @@ func NewNodeMetrics() *NodeMetrics {
 		objectServiceMetrics: objectService,
 		engineMetrics:        engine,
 		stateMetrics:         state,
+		replicatorMetrics:    replicator,
+		objectLimitMetrics:   objectLimits,
 		epoch:                epoch,
 	}
 }
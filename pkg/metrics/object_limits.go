@@ -0,0 +1,34 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	methodLabelKey = "method"
+	reasonLabelKey = "reason"
+)
+
+type objectLimitMetrics struct {
+	rejectedCounter *prometheus.CounterVec
+}
+
+func newObjectLimitMetrics() objectLimitMetrics {
+	return objectLimitMetrics{
+		rejectedCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "rejected_req_count",
+			Help:      "Number of requests rejected due to the object service limits",
+		}, []string{methodLabelKey, reasonLabelKey}),
+	}
+}
+
+func (m objectLimitMetrics) register() {
+	prometheus.MustRegister(m.rejectedCounter)
+}
+
+func (m objectLimitMetrics) AddRejectedRequest(method, reason string) {
+	m.rejectedCounter.With(prometheus.Labels{
+		methodLabelKey: method,
+		reasonLabelKey: reason,
+	}).Inc()
+}
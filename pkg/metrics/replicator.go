@@ -0,0 +1,44 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const (
+	replicatorSubsystem = "replicator"
+
+	nodeLabelKey = "node"
+)
+
+type replicatorMetrics struct {
+	successCounter *prometheus.CounterVec
+	failureCounter *prometheus.CounterVec
+}
+
+func newReplicatorMetrics() replicatorMetrics {
+	return replicatorMetrics{
+		successCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: replicatorSubsystem,
+			Name:      "success_count",
+			Help:      "Number of replicas successfully sent to the node",
+		}, []string{nodeLabelKey}),
+		failureCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: replicatorSubsystem,
+			Name:      "failure_count",
+			Help:      "Number of replicas failed to be sent to the node",
+		}, []string{nodeLabelKey}),
+	}
+}
+
+func (m replicatorMetrics) register() {
+	prometheus.MustRegister(m.successCounter)
+	prometheus.MustRegister(m.failureCounter)
+}
+
+func (m replicatorMetrics) AddReplicationSuccess(node string) {
+	m.successCounter.With(prometheus.Labels{nodeLabelKey: node}).Inc()
+}
+
+func (m replicatorMetrics) AddReplicationFailure(node string) {
+	m.failureCounter.With(prometheus.Labels{nodeLabelKey: node}).Inc()
+}
@@ -34,12 +34,10 @@ func (a Address) equal(addr Address) bool {
 	return a.ma.Equal(addr.ma)
 }
 
-// URIAddr returns Address as a URI.
+// HostAddr returns Address as a HostAddr string without a scheme.
 //
 // Panics if host address cannot be fetched from Address.
-//
-// See also FromString.
-func (a Address) URIAddr() string {
+func (a Address) HostAddr() string {
 	_, host, err := manet.DialArgs(a.ma)
 	if err != nil {
 		// the only correct way to construct Address is AddressFromString
@@ -47,7 +45,18 @@ func (a Address) URIAddr() string {
 		panic(fmt.Errorf("could not get host addr: %w", err))
 	}
 
-	if !a.isTLSEnabled() {
+	return host
+}
+
+// URIAddr returns Address as a URI.
+//
+// Panics if host address cannot be fetched from Address.
+//
+// See also FromString.
+func (a Address) URIAddr() string {
+	host := a.HostAddr()
+
+	if !a.IsTLSEnabled() {
 		return host
 	}
 
@@ -78,7 +87,7 @@ func (a *Address) FromString(s string) error {
 		if err == nil {
 			a.ma, err = multiaddr.NewMultiaddr(s)
 			if err == nil && hasTLS {
-				a.ma = a.ma.Encapsulate(tls)
+				a.ma = a.ma.Encapsulate(tlsAddr)
 			}
 		}
 	}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 )
 
@@ -22,6 +23,14 @@ type (
 		DialTimeout      time.Duration
 		Key              *ecdsa.PrivateKey
 		ResponseCallback func(client.ResponseMetaInfo) error
+		// TLS is an identity of the local node. If set, connections to
+		// TLS endpoints are established only if the server certificate
+		// is bound to the node key from the network map or trusted by
+		// the system certificate authorities.
+		TLS *network.TLSIdentity
+
+		// public key of the node from the network map
+		serverKey []byte
 	}
 )
 
@@ -61,6 +70,7 @@ func (c *ClientCache) Get(info clientcore.NodeInfo) (clientcore.Client, error) {
 
 	newClientOpts := c.opts
 	newClientOpts.ResponseCallback = clientcore.AssertKeyResponseCallback(info.PublicKey())
+	newClientOpts.serverKey = info.PublicKey()
 	cli := newMultiClient(netAddr, newClientOpts)
 
 	c.clients[cacheKey] = cli
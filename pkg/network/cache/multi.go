@@ -39,6 +39,10 @@ func (x *multiClient) createForAddress(addr network.Address) clientcore.Client {
 
 	prmDial.SetServerURI(addr.URIAddr())
 
+	if x.opts.TLS != nil && addr.IsTLSEnabled() {
+		prmDial.SetTLSConfig(x.opts.TLS.ClientConfig(x.opts.serverKey, addr))
+	}
+
 	if x.opts.Key != nil {
 		prmInit.SetDefaultPrivateKey(*x.opts.Key)
 	}
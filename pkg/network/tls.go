@@ -1,18 +1,44 @@
 package network
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync/atomic"
+	"time"
+
 	"github.com/multiformats/go-multiaddr"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 )
 
 const (
 	tlsProtocolName = "tls"
+
+	// NodeServerName is a TLS server name which NeoFS nodes indicate when
+	// connecting to each other. Servers respond to it with the certificate
+	// bound to the node key.
+	NodeServerName = "node.neofs"
+
+	selfSignedLifetime = 10 * 365 * 24 * time.Hour
 )
 
-// tls var is used for (un)wrapping other multiaddrs around TLS multiaddr.
-var tls, _ = multiaddr.NewMultiaddr("/" + tlsProtocolName)
+// tlsAddr var is used for (un)wrapping other multiaddrs around TLS multiaddr.
+var tlsAddr, _ = multiaddr.NewMultiaddr("/" + tlsProtocolName)
+
+// ErrCertificateKeyMismatch is returned when certificate public key
+// differs from the expected one.
+var ErrCertificateKeyMismatch = errors.New("certificate public key mismatch")
 
-// isTLSEnabled searches for wrapped TLS protocol in multiaddr.
-func (a Address) isTLSEnabled() bool {
+// IsTLSEnabled searches for wrapped TLS protocol in multiaddr.
+func (a Address) IsTLSEnabled() bool {
 	for _, protoc := range a.ma.Protocols() {
 		if protoc.Code == multiaddr.P_TLS {
 			return true
@@ -21,3 +47,306 @@ func (a Address) isTLSEnabled() bool {
 
 	return false
 }
+
+// TLSIdentity is a TLS certificate bound to the NeoFS node key: public key
+// of the certificate is the public key of the node. It allows nodes to check
+// each other's identity against the keys from the network map without any
+// certificate authority.
+//
+// Identity may also hold a certificate presented to the clients which don't
+// indicate NodeServerName and the public keys of the clients allowed to
+// connect to the server.
+//
+// Certificates and keys can be replaced at runtime, established connections
+// are not affected.
+type TLSIdentity struct {
+	key *keys.PrivateKey
+
+	cert atomic.Value // *tls.Certificate
+
+	serverCert atomic.Value // *tls.Certificate
+
+	peerKeys atomic.Value // [][]byte
+
+	caFallback atomic.Value // CAFallbackHandler
+
+	// certificate authorities of the peers without node certificates,
+	// system ones are used if nil
+	roots *x509.CertPool
+}
+
+// CAFallbackHandler is called on each connection to the node which server
+// certificate is not bound to the node key but is trusted by the certificate
+// authorities.
+type CAFallbackHandler func(serverKey []byte, host string)
+
+// NewTLSIdentity creates new TLSIdentity of the node with the given key.
+// Load must be called before the identity is used.
+func NewTLSIdentity(key *keys.PrivateKey) *TLSIdentity {
+	x := &TLSIdentity{key: key}
+	x.serverCert.Store((*tls.Certificate)(nil))
+	x.peerKeys.Store([][]byte(nil))
+	x.caFallback.Store(CAFallbackHandler(nil))
+
+	return x
+}
+
+// Load reads a PEM encoded certificate and its private key from the files.
+// If both paths are empty, self-signed certificate is generated from the node key.
+//
+// Returns ErrCertificateKeyMismatch if certificate is not issued for the node key.
+func (x *TLSIdentity) Load(certFile, keyFile string) error {
+	var (
+		cert tls.Certificate
+		err  error
+	)
+
+	if certFile == "" && keyFile == "" {
+		cert, err = NewSelfSignedCertificate(x.key)
+		if err != nil {
+			return err
+		}
+	} else {
+		cert, err = loadCertificate(certFile, keyFile)
+		if err != nil {
+			return err
+		}
+
+		err = checkCertificateKey(cert.Leaf, x.key.PublicKey().Bytes())
+		if err != nil {
+			return err
+		}
+	}
+
+	x.cert.Store(&cert)
+
+	return nil
+}
+
+// LoadServerCertificate reads a PEM encoded certificate and its private key
+// from the files. The certificate is presented by the server to the clients
+// which don't indicate NodeServerName, it is not required to be bound to the
+// node key. If both paths are empty, the node certificate is presented to
+// all the clients.
+func (x *TLSIdentity) LoadServerCertificate(certFile, keyFile string) error {
+	if certFile == "" && keyFile == "" {
+		x.serverCert.Store((*tls.Certificate)(nil))
+		return nil
+	}
+
+	cert, err := loadCertificate(certFile, keyFile)
+	if err != nil {
+		return err
+	}
+
+	x.serverCert.Store(&cert)
+
+	return nil
+}
+
+// SetPeerKeys sets the public keys of the clients allowed to connect to the
+// server configured with MutualServerConfig.
+func (x *TLSIdentity) SetPeerKeys(pubs [][]byte) {
+	x.peerKeys.Store(pubs)
+}
+
+// SetCAFallback enables the verification of the server certificates which
+// are not bound to the node key against the system certificate authorities
+// and the host name of the address. It allows to connect to the nodes which
+// don't use the certificates bound to their keys yet, so node-to-node TLS
+// may be enabled in the network node by node. The handler is called for
+// each server accepted this way. Nil handler disables the fallback.
+func (x *TLSIdentity) SetCAFallback(h CAFallbackHandler) {
+	x.caFallback.Store(h)
+}
+
+// Certificate returns current certificate of the node.
+func (x *TLSIdentity) Certificate() *tls.Certificate {
+	cert, _ := x.cert.Load().(*tls.Certificate)
+	return cert
+}
+
+// ServerConfig returns TLS configuration of the server presenting the node
+// certificate. Client certificates are not requested.
+func (x *TLSIdentity) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			if cert := x.serverCert.Load().(*tls.Certificate); cert != nil && hello.ServerName != NodeServerName {
+				return cert, nil
+			}
+
+			return x.Certificate(), nil
+		},
+	}
+}
+
+// MutualServerConfig returns TLS configuration of the server which requires
+// client certificates bound to one of the keys set with SetPeerKeys.
+func (x *TLSIdentity) MutualServerConfig() *tls.Config {
+	cfg := x.ServerConfig()
+	cfg.ClientAuth = tls.RequireAnyClientCert
+	cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		return VerifyPeerKey(rawCerts, x.peerKeys.Load().([][]byte)...)
+	}
+
+	return cfg
+}
+
+// ClientConfig returns TLS configuration of the connection to the node with
+// the given public key listening on the given address. Server certificate
+// must be bound to this key unless the fallback to the certificate
+// authorities is enabled, see SetCAFallback. The node certificate is
+// presented to the server on request.
+func (x *TLSIdentity) ClientConfig(serverKey []byte, serverAddr Address) *tls.Config {
+	host, _, err := net.SplitHostPort(serverAddr.HostAddr())
+	if err != nil {
+		host = serverAddr.HostAddr()
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: NodeServerName,
+		// server certificate is verified in VerifyPeerCertificate against
+		// the key from the network map first
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			err := VerifyPeerKey(rawCerts, serverKey)
+			if !errors.Is(err, ErrCertificateKeyMismatch) {
+				return err
+			}
+
+			onFallback := x.caFallback.Load().(CAFallbackHandler)
+			if onFallback == nil {
+				return err
+			}
+
+			if err := verifyCertificateChain(rawCerts, host, x.roots); err != nil {
+				return err
+			}
+
+			onFallback(serverKey, host)
+
+			return nil
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return x.Certificate(), nil
+		},
+	}
+}
+
+// VerifyPeerKey checks that the leaf certificate from the raw ones sent by
+// the peer is bound to one of the given public keys. It can be used as
+// tls.Config.VerifyPeerCertificate.
+func VerifyPeerKey(rawCerts [][]byte, pubs ...[]byte) error {
+	if len(rawCerts) == 0 {
+		return errors.New("missing peer certificate")
+	}
+
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return fmt.Errorf("could not parse peer certificate: %w", err)
+	}
+
+	if cert.NotAfter.Before(time.Now()) || cert.NotBefore.After(time.Now()) {
+		return errors.New("peer certificate is expired or not yet valid")
+	}
+
+	for i := range pubs {
+		if checkCertificateKey(cert, pubs[i]) == nil {
+			return nil
+		}
+	}
+
+	return ErrCertificateKeyMismatch
+}
+
+// NewSelfSignedCertificate generates self-signed certificate for the key.
+func NewSelfSignedCertificate(key *keys.PrivateKey) (tls.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("could not generate serial number: %w", err)
+	}
+
+	now := time.Now()
+	tpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: hex.EncodeToString(key.PublicKey().Bytes())},
+		DNSNames:     []string{NodeServerName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(selfSignedLifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PrivateKey.PublicKey, &key.PrivateKey)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("could not create certificate: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("could not parse certificate: %w", err)
+	}
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  &key.PrivateKey,
+		Leaf:        leaf,
+	}, nil
+}
+
+func loadCertificate(certFile, keyFile string) (tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return cert, fmt.Errorf("could not read certificate: %w", err)
+	}
+
+	cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return cert, fmt.Errorf("could not parse certificate: %w", err)
+	}
+
+	return cert, nil
+}
+
+// verifyCertificateChain verifies the raw certificates sent by the peer
+// against the certificate authorities and the host name. System certificate
+// authorities are used if roots is nil.
+func verifyCertificateChain(rawCerts [][]byte, host string, roots *x509.CertPool) error {
+	opts := x509.VerifyOptions{
+		DNSName:       host,
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+
+	for i := range rawCerts {
+		var err error
+
+		certs[i], err = x509.ParseCertificate(rawCerts[i])
+		if err != nil {
+			return fmt.Errorf("could not parse peer certificate: %w", err)
+		}
+
+		if i > 0 {
+			opts.Intermediates.AddCert(certs[i])
+		}
+	}
+
+	if _, err := certs[0].Verify(opts); err != nil {
+		return fmt.Errorf("peer certificate is neither bound to the node key nor trusted: %w", err)
+	}
+
+	return nil
+}
+
+func checkCertificateKey(cert *x509.Certificate, pub []byte) error {
+	certKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok || !bytes.Equal((*keys.PublicKey)(certKey).Bytes(), pub) {
+		return ErrCertificateKeyMismatch
+	}
+
+	return nil
+}
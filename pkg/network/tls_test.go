@@ -1,8 +1,21 @@
 package network
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +37,242 @@ func TestAddress_TLSEnabled(t *testing.T) {
 		err := addr.FromString(test.input)
 		require.NoError(t, err)
 
-		require.Equal(t, test.wantTLS, addr.isTLSEnabled(), test.input)
+		require.Equal(t, test.wantTLS, addr.IsTLSEnabled(), test.input)
 	}
 }
+
+func TestTLSIdentity(t *testing.T) {
+	nodeKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	otherKey, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var addr Address
+	require.NoError(t, addr.FromString("/dns4/localhost/tcp/8080/tls"))
+
+	t.Run("self-signed", func(t *testing.T) {
+		id := NewTLSIdentity(nodeKey)
+		require.NoError(t, id.Load("", ""))
+
+		cert := id.Certificate()
+		require.NotNil(t, cert)
+		require.NoError(t, VerifyPeerKey(cert.Certificate, nodeKey.PublicKey().Bytes()))
+		require.ErrorIs(t, VerifyPeerKey(cert.Certificate, otherKey.PublicKey().Bytes()), ErrCertificateKeyMismatch)
+	})
+
+	t.Run("files", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile := writeCertificate(t, dir, otherKey)
+
+		id := NewTLSIdentity(nodeKey)
+		require.ErrorIs(t, id.Load(certFile, keyFile), ErrCertificateKeyMismatch)
+		require.Nil(t, id.Certificate())
+
+		certFile, keyFile = writeCertificate(t, dir, nodeKey)
+		require.NoError(t, id.Load(certFile, keyFile))
+		require.NoError(t, VerifyPeerKey(id.Certificate().Certificate, nodeKey.PublicKey().Bytes()))
+	})
+
+	t.Run("handshake", func(t *testing.T) {
+		server := NewTLSIdentity(nodeKey)
+		require.NoError(t, server.Load("", ""))
+
+		client := NewTLSIdentity(otherKey)
+		require.NoError(t, client.Load("", ""))
+
+		require.NoError(t, handshake(server.ServerConfig(), client.ClientConfig(nodeKey.PublicKey().Bytes(), addr)))
+		require.Error(t, handshake(server.ServerConfig(), client.ClientConfig(otherKey.PublicKey().Bytes(), addr)))
+	})
+
+	t.Run("certificate authority fallback", func(t *testing.T) {
+		caCert, caKey := newCA(t)
+
+		// node without node TLS serves the certificate issued by the authority
+		serverCert, err := tls.LoadX509KeyPair(writeIssuedCertificate(t, t.TempDir(), caCert, caKey, "localhost"))
+		require.NoError(t, err)
+
+		serverCfg := &tls.Config{Certificates: []tls.Certificate{serverCert}}
+
+		client := NewTLSIdentity(otherKey)
+		require.NoError(t, client.Load("", ""))
+
+		client.roots = x509.NewCertPool()
+		client.roots.AddCert(caCert)
+
+		// fallback is disabled by default
+		require.ErrorIs(t, handshake(serverCfg, client.ClientConfig(nodeKey.PublicKey().Bytes(), addr)), ErrCertificateKeyMismatch)
+
+		var fallbacks []string
+		client.SetCAFallback(func(_ []byte, host string) {
+			fallbacks = append(fallbacks, host)
+		})
+
+		require.NoError(t, handshake(serverCfg, client.ClientConfig(nodeKey.PublicKey().Bytes(), addr)))
+		require.Equal(t, []string{"localhost"}, fallbacks)
+
+		// authority is not trusted
+		client.roots = x509.NewCertPool()
+		require.Error(t, handshake(serverCfg, client.ClientConfig(nodeKey.PublicKey().Bytes(), addr)))
+
+		client.roots.AddCert(caCert)
+
+		var otherAddr Address
+		require.NoError(t, otherAddr.FromString("/dns4/example.com/tcp/8080/tls"))
+		require.Error(t, handshake(serverCfg, client.ClientConfig(nodeKey.PublicKey().Bytes(), otherAddr)))
+	})
+
+	t.Run("server certificate", func(t *testing.T) {
+		caCert, caKey := newCA(t)
+
+		server := NewTLSIdentity(nodeKey)
+		require.NoError(t, server.Load("", ""))
+		require.NoError(t, server.LoadServerCertificate(writeIssuedCertificate(t, t.TempDir(), caCert, caKey, "localhost")))
+
+		getCert := server.ServerConfig().GetCertificate
+
+		cert, err := getCert(&tls.ClientHelloInfo{ServerName: NodeServerName})
+		require.NoError(t, err)
+		require.Equal(t, server.Certificate(), cert)
+
+		cert, err = getCert(&tls.ClientHelloInfo{ServerName: "localhost"})
+		require.NoError(t, err)
+		require.Equal(t, server.serverCert.Load(), cert)
+
+		require.NoError(t, server.LoadServerCertificate("", ""))
+
+		cert, err = getCert(&tls.ClientHelloInfo{ServerName: "localhost"})
+		require.NoError(t, err)
+		require.Equal(t, server.Certificate(), cert)
+	})
+
+	t.Run("mutual", func(t *testing.T) {
+		server := NewTLSIdentity(nodeKey)
+		require.NoError(t, server.Load("", ""))
+
+		client := NewTLSIdentity(otherKey)
+		require.NoError(t, client.Load("", ""))
+
+		clientCfg := client.ClientConfig(nodeKey.PublicKey().Bytes(), addr)
+
+		require.Error(t, handshake(server.MutualServerConfig(), clientCfg))
+
+		server.SetPeerKeys([][]byte{otherKey.PublicKey().Bytes()})
+		require.NoError(t, handshake(server.MutualServerConfig(), clientCfg))
+
+		server.SetPeerKeys([][]byte{nodeKey.PublicKey().Bytes()})
+		require.Error(t, handshake(server.MutualServerConfig(), clientCfg))
+	})
+}
+
+func newCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tpl := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
+
+// writeIssuedCertificate writes the certificate for the host issued by
+// the authority and its key to the files.
+func writeIssuedCertificate(t *testing.T, dir string, ca *x509.Certificate, caKey *ecdsa.PrivateKey, host string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "issued.pem")
+	keyFile := filepath.Join(dir, "issued.key")
+
+	require.NoError(t, os.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rawKey}), 0600))
+
+	return certFile, keyFile
+}
+
+func writeCertificate(t *testing.T, dir string, key *keys.PrivateKey) (string, string) {
+	cert, err := NewSelfSignedCertificate(key)
+	require.NoError(t, err)
+
+	rawKey, err := x509.MarshalECPrivateKey(&key.PrivateKey)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	require.NoError(t, os.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600))
+	require.NoError(t, os.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rawKey}), 0600))
+
+	return certFile, keyFile
+}
+
+func handshake(serverCfg, clientCfg *tls.Config) error {
+	// pipe is not buffered, so the peers block each other writing alerts
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	defer lis.Close()
+
+	srvErr := make(chan error, 1)
+	go func() {
+		srvConn, err := lis.Accept()
+		if err != nil {
+			srvErr <- err
+			return
+		}
+		defer srvConn.Close()
+
+		srvErr <- tls.Server(srvConn, serverCfg).Handshake()
+	}()
+
+	cliConn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		return err
+	}
+
+	err = tls.Client(cliConn, clientCfg).Handshake()
+
+	// in TLS 1.3 client certificate is verified by the server after the
+	// client handshake
+	_ = cliConn.Close()
+
+	if err != nil {
+		return err
+	}
+
+	return <-srvErr
+}
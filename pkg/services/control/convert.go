@@ -184,3 +184,129 @@ func (w *synchronizeTreeResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.SynchronizeTreeResponse = r
 	return nil
 }
+
+type evacuateShardResponseWrapper struct {
+	*EvacuateShardResponse
+}
+
+func (w *evacuateShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.EvacuateShardResponse
+}
+
+func (w *evacuateShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*EvacuateShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*EvacuateShardResponse)(nil))
+	}
+
+	w.EvacuateShardResponse = r
+	return nil
+}
+
+type addShardResponseWrapper struct {
+	*AddShardResponse
+}
+
+func (w *addShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.AddShardResponse
+}
+
+func (w *addShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*AddShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*AddShardResponse)(nil))
+	}
+
+	w.AddShardResponse = r
+	return nil
+}
+
+type detachShardsResponseWrapper struct {
+	*DetachShardsResponse
+}
+
+func (w *detachShardsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.DetachShardsResponse
+}
+
+func (w *detachShardsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*DetachShardsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*DetachShardsResponse)(nil))
+	}
+
+	w.DetachShardsResponse = r
+	return nil
+}
+
+type flushCacheResponseWrapper struct {
+	*FlushCacheResponse
+}
+
+func (w *flushCacheResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.FlushCacheResponse
+}
+
+func (w *flushCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*FlushCacheResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*FlushCacheResponse)(nil))
+	}
+
+	w.FlushCacheResponse = r
+	return nil
+}
+
+type getCacheInfoResponseWrapper struct {
+	*GetCacheInfoResponse
+}
+
+func (w *getCacheInfoResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetCacheInfoResponse
+}
+
+func (w *getCacheInfoResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetCacheInfoResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetCacheInfoResponse)(nil))
+	}
+
+	w.GetCacheInfoResponse = r
+	return nil
+}
+
+type compactTreeResponseWrapper struct {
+	*CompactTreeResponse
+}
+
+func (w *compactTreeResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.CompactTreeResponse
+}
+
+func (w *compactTreeResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*CompactTreeResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*CompactTreeResponse)(nil))
+	}
+
+	w.CompactTreeResponse = r
+	return nil
+}
+
+type policerStatusResponseWrapper struct {
+	*PolicerStatusResponse
+}
+
+func (w *policerStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.PolicerStatusResponse
+}
+
+func (w *policerStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*PolicerStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*PolicerStatusResponse)(nil))
+	}
+
+	w.PolicerStatusResponse = r
+	return nil
+}
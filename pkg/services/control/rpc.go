@@ -17,6 +17,13 @@ const (
 	rpcDumpShard       = "DumpShard"
 	rpcRestoreShard    = "RestoreShard"
 	rpcSynchronizeTree = "SynchronizeTree"
+	rpcEvacuateShard   = "EvacuateShard"
+	rpcAddShard        = "AddShard"
+	rpcDetachShards    = "DetachShards"
+	rpcFlushCache      = "FlushCache"
+	rpcGetCacheInfo    = "GetCacheInfo"
+	rpcCompactTree     = "CompactTree"
+	rpcPolicerStatus   = "PolicerStatus"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -186,3 +193,94 @@ func SynchronizeTree(cli *client.Client, req *SynchronizeTreeRequest, opts ...cl
 
 	return wResp.SynchronizeTreeResponse, nil
 }
+
+// EvacuateShard executes ControlService.EvacuateShard RPC.
+func EvacuateShard(cli *client.Client, req *EvacuateShardRequest, opts ...client.CallOption) (*EvacuateShardResponse, error) {
+	wResp := &evacuateShardResponseWrapper{new(EvacuateShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcEvacuateShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.EvacuateShardResponse, nil
+}
+
+// AddShard executes ControlService.AddShard RPC.
+func AddShard(cli *client.Client, req *AddShardRequest, opts ...client.CallOption) (*AddShardResponse, error) {
+	wResp := &addShardResponseWrapper{new(AddShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcAddShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.AddShardResponse, nil
+}
+
+// DetachShards executes ControlService.DetachShards RPC.
+func DetachShards(cli *client.Client, req *DetachShardsRequest, opts ...client.CallOption) (*DetachShardsResponse, error) {
+	wResp := &detachShardsResponseWrapper{new(DetachShardsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcDetachShards), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.DetachShardsResponse, nil
+}
+
+// FlushCache executes ControlService.FlushCache RPC.
+func FlushCache(cli *client.Client, req *FlushCacheRequest, opts ...client.CallOption) (*FlushCacheResponse, error) {
+	wResp := &flushCacheResponseWrapper{new(FlushCacheResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcFlushCache), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.FlushCacheResponse, nil
+}
+
+// GetCacheInfo executes ControlService.GetCacheInfo RPC.
+func GetCacheInfo(cli *client.Client, req *GetCacheInfoRequest, opts ...client.CallOption) (*GetCacheInfoResponse, error) {
+	wResp := &getCacheInfoResponseWrapper{new(GetCacheInfoResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetCacheInfo), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetCacheInfoResponse, nil
+}
+
+// CompactTree executes ControlService.CompactTree RPC.
+func CompactTree(cli *client.Client, req *CompactTreeRequest, opts ...client.CallOption) (*CompactTreeResponse, error) {
+	wResp := &compactTreeResponseWrapper{new(CompactTreeResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcCompactTree), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.CompactTreeResponse, nil
+}
+
+// PolicerStatus executes ControlService.PolicerStatus RPC.
+func PolicerStatus(cli *client.Client, req *PolicerStatusRequest, opts ...client.CallOption) (*PolicerStatusResponse, error) {
+	wResp := &policerStatusResponseWrapper{new(PolicerStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcPolicerStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.PolicerStatusResponse, nil
+}
@@ -0,0 +1,42 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) AddShard(_ context.Context, req *control.AddShardRequest) (*control.AddShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.shardCfgParser == nil {
+		return nil, status.Error(codes.Unimplemented, "shard configuration parser is not set")
+	}
+
+	opts, err := s.shardCfgParser(req.GetBody().GetConfig())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := s.s.AttachShard(opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := new(control.AddShardResponse_Body)
+	body.SetShardID(*id)
+
+	resp := new(control.AddShardResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,43 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) CompactTree(_ context.Context, req *control.CompactTreeRequest) (*control.CompactTreeResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.treeService == nil {
+		return nil, status.Error(codes.Internal, "tree service is disabled")
+	}
+
+	b := req.GetBody()
+
+	var cnr cid.ID
+	if err := cnr.Decode(b.GetContainerId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.treeService.CompactLog(cnr, b.GetTreeId(), b.GetHeight())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := new(control.CompactTreeResponse)
+	resp.SetBody(new(control.CompactTreeResponse_Body))
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,35 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) DetachShards(_ context.Context, req *control.DetachShardsRequest) (*control.DetachShardsResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	rawIDs := req.GetBody().GetShard_ID()
+	if len(rawIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty shard ID list")
+	}
+
+	err = s.s.DetachShards(getShardIDList(rawIDs))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := new(control.DetachShardsResponse)
+	resp.SetBody(new(control.DetachShardsResponse_Body))
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
@@ -0,0 +1,116 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequest) (*control.EvacuateShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var prm engine.EvacuateShardPrm
+	prm.WithShardIDList(getShardIDList(req.GetBody().GetShard_ID()))
+	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+
+	if s.replicator != nil && s.cnrSrc != nil && s.netMapSrc != nil {
+		prm.WithFaultHandler(s.replicate)
+	}
+
+	res, err := s.s.Evacuate(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	body := new(control.EvacuateShardResponse_Body)
+	body.SetCount(uint32(res.Count()))
+	body.SetFailed(uint32(res.Failed()))
+
+	resp := new(control.EvacuateShardResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func getShardIDList(rawIDs [][]byte) []*shard.ID {
+	ids := make([]*shard.ID, 0, len(rawIDs))
+	for i := range rawIDs {
+		ids = append(ids, shard.NewIDFromBytes(rawIDs[i]))
+	}
+	return ids
+}
+
+// replicate sends the object which cannot be saved locally
+// to the other container nodes.
+func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
+	idCnr, ok := obj.ContainerID()
+	if !ok {
+		// Return nil to prevent situations where a shard can't be evacuated
+		// because of a single bad/corrupted object.
+		return nil
+	}
+
+	cnr, err := s.cnrSrc.Get(idCnr)
+	if err != nil {
+		return fmt.Errorf("can't get container %s: %w", idCnr, err)
+	}
+
+	idObj := addr.Object()
+
+	ns, err := placement.NewNetworkMapSourceBuilder(s.netMapSrc).
+		BuildPlacement(idCnr, &idObj, cnr.Value.PlacementPolicy())
+	if err != nil {
+		return fmt.Errorf("can't build placement for object %s: %w", addr, err)
+	}
+
+	nodes := placement.FlattenNodes(ns)
+	localKey := (*keys.PublicKey)(&s.key.PublicKey).Bytes()
+	for i := 0; i < len(nodes); i++ {
+		if bytes.Equal(nodes[i].PublicKey(), localKey) {
+			nodes = append(nodes[:i], nodes[i+1:]...)
+			i--
+		}
+	}
+
+	var res replicatorResult
+
+	task := new(replicator.Task).
+		WithObjectAddress(addr).
+		WithCopiesNumber(1).
+		WithNodes(nodes)
+
+	s.replicator.HandleTask(context.TODO(), task, &res)
+
+	if res.count == 0 {
+		return errors.New("object was not replicated")
+	}
+	return nil
+}
+
+type replicatorResult struct {
+	count int
+}
+
+// SubmitSuccessfulReplication implements the replicator.TaskResult interface.
+func (r *replicatorResult) SubmitSuccessfulReplication(_ uint64) {
+	r.count++
+}
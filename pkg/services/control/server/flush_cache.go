@@ -0,0 +1,56 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) FlushCache(_ context.Context, req *control.FlushCacheRequest) (*control.FlushCacheResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	for _, id := range s.writeCacheShardIDList(req.GetBody().GetShard_ID()) {
+		var prm engine.FlushWriteCachePrm
+		prm.SetShardID(id)
+		prm.SetDisableWriteCache(req.GetBody().GetDisable())
+
+		_, err = s.s.FlushWriteCache(prm)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "shard %s: %v", id, err)
+		}
+	}
+
+	resp := new(control.FlushCacheResponse)
+	resp.SetBody(new(control.FlushCacheResponse_Body))
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// writeCacheShardIDList returns IDs of the shards from the request
+// or IDs of all the shards with write-cache if the list is empty.
+func (s *Server) writeCacheShardIDList(rawIDs [][]byte) []*shard.ID {
+	if len(rawIDs) != 0 {
+		return getShardIDList(rawIDs)
+	}
+
+	info := s.s.DumpInfo()
+
+	ids := make([]*shard.ID, 0, len(info.Shards))
+	for _, sh := range info.Shards {
+		if sh.WriteCacheInfo.Path != "" {
+			ids = append(ids, sh.ID)
+		}
+	}
+	return ids
+}
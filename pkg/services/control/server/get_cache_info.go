@@ -0,0 +1,49 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetCacheInfo(_ context.Context, req *control.GetCacheInfoRequest) (*control.GetCacheInfoResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	ids := s.writeCacheShardIDList(req.GetBody().GetShard_ID())
+	caches := make([]*control.WriteCacheInfo, 0, len(ids))
+
+	for _, id := range ids {
+		st, err := s.s.WriteCacheStat(id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "shard %s: %v", id, err)
+		}
+
+		wc := new(control.WriteCacheInfo)
+		wc.SetID(*id)
+		wc.SetMemObjects(st.MemObjects)
+		wc.SetMemSize(st.MemSize)
+		wc.SetDBObjects(st.DBObjects)
+		wc.SetFSObjects(st.FSObjects)
+		wc.SetNotFlushed(st.NotFlushed)
+		wc.SetDiskSize(st.DiskSize)
+
+		caches = append(caches, wc)
+	}
+
+	body := new(control.GetCacheInfoResponse_Body)
+	body.SetCaches(caches)
+
+	resp := new(control.GetCacheInfoResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
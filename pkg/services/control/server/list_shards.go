@@ -3,6 +3,8 @@ package control
 import (
 	"context"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"google.golang.org/grpc/codes"
@@ -30,7 +32,7 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 		si.SetID(*sh.ID)
 		si.SetMetabasePath(sh.MetaBaseInfo.Path)
-		si.SetBlobstorPath(sh.BlobStorInfo.RootPath)
+		si.SetBlobstor(blobstorInfoToProto(sh.BlobStorInfo))
 		si.SetWriteCachePath(sh.WriteCacheInfo.Path)
 		si.SetPiloramaPath(sh.PiloramaInfo.Path)
 
@@ -51,6 +53,7 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 		si.SetMode(m)
 		si.SetErrorCount(sh.ErrorCount)
+		si.SetObjectCounters(objectCountersToProto(sh.ObjectCounters))
 
 		shardInfos = append(shardInfos, si)
 	}
@@ -64,3 +67,23 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 	return resp, nil
 }
+
+func objectCountersToProto(c meta.ObjectCounters) *control.ObjectCounters {
+	res := new(control.ObjectCounters)
+	res.SetPhy(c.Phy)
+	res.SetLogic(c.Logic)
+	res.SetTombstones(c.Tombstones)
+	res.SetLocks(c.Locks)
+	res.SetGarbage(c.Garbage)
+	return res
+}
+
+func blobstorInfoToProto(info blobstor.Info) []*control.BlobstorInfo {
+	res := make([]*control.BlobstorInfo, len(info.SubStorages))
+	for i := range info.SubStorages {
+		res[i] = new(control.BlobstorInfo)
+		res[i].SetPath(info.SubStorages[i].Path)
+		res[i].SetType(info.SubStorages[i].Type)
+	}
+	return res
+}
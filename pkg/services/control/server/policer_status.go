@@ -0,0 +1,47 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/policer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Policer is an interface of the object storage policy checker.
+type Policer interface {
+	Status() policer.Status
+}
+
+// PolicerStatus returns the progress of the object storage policy checks.
+func (s *Server) PolicerStatus(_ context.Context, req *control.PolicerStatusRequest) (*control.PolicerStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.policer == nil {
+		return nil, status.Error(codes.Unavailable, "policer is disabled")
+	}
+
+	st := s.policer.Status()
+
+	body := new(control.PolicerStatusResponse_Body)
+	body.SetPass(st.Pass)
+	body.SetPassStartedAt(st.PassStarted.Unix())
+	body.SetObjectsChecked(st.Checked)
+	body.SetReplicasCreated(st.Replicated)
+	body.SetPrioritizedContainers(uint32(st.Prioritized))
+	body.SetEtaSeconds(uint64(st.ETA.Seconds()))
+
+	resp := new(control.PolicerStatusResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -2,10 +2,14 @@ package control
 
 import (
 	"crypto/ecdsa"
+	"sync"
 
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 )
 
 // Server is an entity that serves
@@ -35,12 +39,18 @@ type NodeState interface {
 	SetNetmapStatus(control.NetmapStatus) error
 }
 
+// ShardConfigParser is a function which parses the shard configuration
+// section and returns options to create a shard with.
+type ShardConfigParser func([]byte) ([]shard.Option, error)
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
 type cfg struct {
 	key *ecdsa.PrivateKey
 
+	keysMtx sync.RWMutex
+
 	allowedKeys [][]byte
 
 	healthChecker HealthChecker
@@ -53,6 +63,14 @@ type cfg struct {
 
 	treeService TreeService
 
+	cnrSrc container.Source
+
+	replicator *replicator.Replicator
+
+	policer Policer
+
+	shardCfgParser ShardConfigParser
+
 	s *engine.StorageEngine
 }
 
@@ -73,6 +91,14 @@ func New(opts ...Option) *Server {
 	}
 }
 
+// SetAuthorizedKeys replaces the list of public keys that
+// have rights to use Control service.
+func (s *Server) SetAuthorizedKeys(keys [][]byte) {
+	s.keysMtx.Lock()
+	s.allowedKeys = keys
+	s.keysMtx.Unlock()
+}
+
 // WithKey returns option to set private key
 // used for signing responses.
 func WithKey(key *ecdsa.PrivateKey) Option {
@@ -133,3 +159,33 @@ func WithTreeService(s TreeService) Option {
 		c.treeService = s
 	}
 }
+
+// WithContainerSource returns an option to set container storage.
+func WithContainerSource(cnrSrc container.Source) Option {
+	return func(c *cfg) {
+		c.cnrSrc = cnrSrc
+	}
+}
+
+// WithReplicator returns an option to set replicator used to move
+// objects to the other nodes during shard evacuation.
+func WithReplicator(r *replicator.Replicator) Option {
+	return func(c *cfg) {
+		c.replicator = r
+	}
+}
+
+// WithPolicer returns an option to set the source of the Policer progress.
+func WithPolicer(p Policer) Option {
+	return func(c *cfg) {
+		c.policer = p
+	}
+}
+
+// WithShardConfigParser returns an option to set the parser of the shard
+// configuration used to attach new shards.
+func WithShardConfigParser(p ShardConfigParser) Option {
+	return func(c *cfg) {
+		c.shardCfgParser = p
+	}
+}
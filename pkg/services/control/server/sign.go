@@ -34,12 +34,16 @@ func (s *Server) isValidRequest(req SignedMessage) error {
 	)
 
 	// check if key is allowed
+	s.keysMtx.RLock()
+
 	for i := range s.allowedKeys {
 		if allowed = bytes.Equal(s.allowedKeys[i], key); allowed {
 			break
 		}
 	}
 
+	s.keysMtx.RUnlock()
+
 	if !allowed {
 		return errDisallowedKey
 	}
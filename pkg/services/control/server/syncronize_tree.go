@@ -12,6 +12,7 @@ import (
 // TreeService represents a tree service instance.
 type TreeService interface {
 	Synchronize(ctx context.Context, cnr cid.ID, treeID string) error
+	CompactLog(cnr cid.ID, treeID string, height uint64) error
 }
 
 func (s *Server) SynchronizeTree(ctx context.Context, req *control.SynchronizeTreeRequest) (*control.SynchronizeTreeResponse, error) {
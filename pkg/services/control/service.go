@@ -214,3 +214,186 @@ func (x *SynchronizeTreeResponse) SetBody(v *SynchronizeTreeResponse_Body) {
 		x.Body = v
 	}
 }
+
+// SetShardIDList sets list of shard IDs for the evacuate shard request.
+func (x *EvacuateShardRequest_Body) SetShardIDList(id [][]byte) {
+	x.Shard_ID = id
+}
+
+// SetIgnoreErrors sets ignore errors flag for the evacuate shard request.
+func (x *EvacuateShardRequest_Body) SetIgnoreErrors(ignore bool) {
+	x.IgnoreErrors = ignore
+}
+
+// SetBody sets request body.
+func (x *EvacuateShardRequest) SetBody(v *EvacuateShardRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetCount sets the number of evacuated objects.
+func (x *EvacuateShardResponse_Body) SetCount(c uint32) {
+	x.Count = c
+}
+
+// SetFailed sets the number of objects skipped because of errors.
+func (x *EvacuateShardResponse_Body) SetFailed(c uint32) {
+	x.Failed = c
+}
+
+// SetBody sets response body.
+func (x *EvacuateShardResponse) SetBody(v *EvacuateShardResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetConfig sets shard configuration section for the add shard request.
+func (x *AddShardRequest_Body) SetConfig(cfg []byte) {
+	x.Config = cfg
+}
+
+// SetBody sets request body.
+func (x *AddShardRequest) SetBody(v *AddShardRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetShardID sets ID of the attached shard.
+func (x *AddShardResponse_Body) SetShardID(id []byte) {
+	x.Shard_ID = id
+}
+
+// SetBody sets response body.
+func (x *AddShardResponse) SetBody(v *AddShardResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetShardIDList sets list of shard IDs for the detach shards request.
+func (x *DetachShardsRequest_Body) SetShardIDList(id [][]byte) {
+	x.Shard_ID = id
+}
+
+// SetBody sets request body.
+func (x *DetachShardsRequest) SetBody(v *DetachShardsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets response body.
+func (x *DetachShardsResponse) SetBody(v *DetachShardsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetShardIDList sets list of shard IDs for the flush cache request.
+func (x *FlushCacheRequest_Body) SetShardIDList(id [][]byte) {
+	x.Shard_ID = id
+}
+
+// SetDisable sets flag to switch write-cache off after the flush.
+func (x *FlushCacheRequest_Body) SetDisable(disable bool) {
+	x.Disable = disable
+}
+
+// SetBody sets request body.
+func (x *FlushCacheRequest) SetBody(v *FlushCacheRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets response body.
+func (x *FlushCacheResponse) SetBody(v *FlushCacheResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetShardIDList sets list of shard IDs for the get cache info request.
+func (x *GetCacheInfoRequest_Body) SetShardIDList(id [][]byte) {
+	x.Shard_ID = id
+}
+
+// SetBody sets request body.
+func (x *GetCacheInfoRequest) SetBody(v *GetCacheInfoRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetCaches sets write-cache statistics of the shards.
+func (x *GetCacheInfoResponse_Body) SetCaches(v []*WriteCacheInfo) {
+	x.Caches = v
+}
+
+// SetBody sets response body.
+func (x *GetCacheInfoResponse) SetBody(v *GetCacheInfoResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets compact tree request body.
+func (x *CompactTreeRequest) SetBody(v *CompactTreeRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets compact tree response body.
+func (x *CompactTreeResponse) SetBody(v *CompactTreeResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetBody sets policer status request body.
+func (x *PolicerStatusRequest) SetBody(v *PolicerStatusRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetPass sets number of the current Policer pass.
+func (x *PolicerStatusResponse_Body) SetPass(v uint64) {
+	x.Pass = v
+}
+
+// SetPassStartedAt sets Unix timestamp of the current pass start in seconds.
+func (x *PolicerStatusResponse_Body) SetPassStartedAt(v int64) {
+	x.PassStartedAt = v
+}
+
+// SetObjectsChecked sets number of the objects checked during the current pass.
+func (x *PolicerStatusResponse_Body) SetObjectsChecked(v uint64) {
+	x.ObjectsChecked = v
+}
+
+// SetReplicasCreated sets number of the replicas created during the current pass.
+func (x *PolicerStatusResponse_Body) SetReplicasCreated(v uint64) {
+	x.ReplicasCreated = v
+}
+
+// SetPrioritizedContainers sets number of the containers checked out of turn.
+func (x *PolicerStatusResponse_Body) SetPrioritizedContainers(v uint32) {
+	x.PrioritizedContainers = v
+}
+
+// SetEtaSeconds sets estimated time to finish the current pass in seconds.
+func (x *PolicerStatusResponse_Body) SetEtaSeconds(v uint64) {
+	x.EtaSeconds = v
+}
+
+// SetBody sets policer status response body.
+func (x *PolicerStatusResponse) SetBody(v *PolicerStatusResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
@@ -101,18 +101,38 @@ func equalListShardResponseBodies(b1, b2 *control.ListShardsResponse_Body) bool
 
 	for i := range b1.Shards {
 		if b1.Shards[i].GetMetabasePath() != b2.Shards[i].GetMetabasePath() ||
-			b1.Shards[i].GetBlobstorPath() != b2.Shards[i].GetBlobstorPath() ||
 			b1.Shards[i].GetWritecachePath() != b2.Shards[i].GetWritecachePath() ||
 			b1.Shards[i].GetPiloramaPath() != b2.Shards[i].GetPiloramaPath() ||
 			!bytes.Equal(b1.Shards[i].GetShard_ID(), b2.Shards[i].GetShard_ID()) {
 			return false
 		}
+
+		oc1 := b1.Shards[i].GetObjectCounters()
+		oc2 := b2.Shards[i].GetObjectCounters()
+		if oc1.GetPhy() != oc2.GetPhy() ||
+			oc1.GetLogic() != oc2.GetLogic() ||
+			oc1.GetTombstones() != oc2.GetTombstones() ||
+			oc1.GetLocks() != oc2.GetLocks() ||
+			oc1.GetGarbage() != oc2.GetGarbage() {
+			return false
+		}
+
+		info1 := b1.Shards[i].GetBlobstor()
+		info2 := b2.Shards[i].GetBlobstor()
+		if len(info1) != len(info2) {
+			return false
+		}
+
+		for j := range info1 {
+			if info1[j].GetPath() != info2[j].GetPath() || info1[j].GetType() != info2[j].GetType() {
+				return false
+			}
+		}
 	}
 
 	for i := range b1.Shards {
 		for j := i + 1; j < len(b1.Shards); j++ {
 			if b1.Shards[i].GetMetabasePath() == b2.Shards[j].GetMetabasePath() ||
-				b1.Shards[i].GetBlobstorPath() == b2.Shards[j].GetBlobstorPath() ||
 				b1.Shards[i].GetWritecachePath() == b2.Shards[j].GetWritecachePath() ||
 				bytes.Equal(b1.Shards[i].GetShard_ID(), b2.Shards[j].GetShard_ID()) {
 				return false
@@ -179,3 +199,176 @@ func TestSynchronizeTreeRequest_Body_StableMarshal(t *testing.T) {
 		},
 	)
 }
+
+func TestCompactTreeRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		&control.CompactTreeRequest_Body{
+			ContainerId: []byte{1, 2, 3, 4, 5, 6, 7},
+			TreeId:      "someID",
+			Height:      42,
+		},
+		new(control.CompactTreeRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.CompactTreeRequest_Body)
+			b2 := m2.(*control.CompactTreeRequest_Body)
+			return bytes.Equal(b1.GetContainerId(), b2.GetContainerId()) &&
+				b1.GetTreeId() == b2.GetTreeId() &&
+				b1.GetHeight() == b2.GetHeight()
+		},
+	)
+}
+
+func TestPolicerStatusResponse_Body_StableMarshal(t *testing.T) {
+	body := new(control.PolicerStatusResponse_Body)
+	body.SetPass(3)
+	body.SetPassStartedAt(1660000000)
+	body.SetObjectsChecked(1000)
+	body.SetReplicasCreated(10)
+	body.SetPrioritizedContainers(2)
+	body.SetEtaSeconds(3600)
+
+	testStableMarshal(t, body, new(control.PolicerStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.PolicerStatusResponse_Body)
+			b2 := m2.(*control.PolicerStatusResponse_Body)
+			return b1.GetPass() == b2.GetPass() &&
+				b1.GetPassStartedAt() == b2.GetPassStartedAt() &&
+				b1.GetObjectsChecked() == b2.GetObjectsChecked() &&
+				b1.GetReplicasCreated() == b2.GetReplicasCreated() &&
+				b1.GetPrioritizedContainers() == b2.GetPrioritizedContainers() &&
+				b1.GetEtaSeconds() == b2.GetEtaSeconds()
+		},
+	)
+}
+
+func TestEvacuateShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateEvacuateShardRequestBody(),
+		new(control.EvacuateShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.EvacuateShardRequest_Body)
+			b2 := m2.(*control.EvacuateShardRequest_Body)
+
+			if b1.GetIgnoreErrors() != b2.GetIgnoreErrors() ||
+				len(b1.GetShard_ID()) != len(b2.GetShard_ID()) {
+				return false
+			}
+
+			for i := range b1.GetShard_ID() {
+				if !bytes.Equal(b1.GetShard_ID()[i], b2.GetShard_ID()[i]) {
+					return false
+				}
+			}
+
+			return true
+		},
+	)
+}
+
+func generateEvacuateShardRequestBody() *control.EvacuateShardRequest_Body {
+	body := new(control.EvacuateShardRequest_Body)
+	body.SetShardIDList([][]byte{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}})
+	body.SetIgnoreErrors(true)
+
+	return body
+}
+
+func TestEvacuateShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateEvacuateShardResponseBody(),
+		new(control.EvacuateShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.EvacuateShardResponse_Body)
+			b2 := m2.(*control.EvacuateShardResponse_Body)
+
+			return b1.GetCount() == b2.GetCount() && b1.GetFailed() == b2.GetFailed()
+		},
+	)
+}
+
+func generateEvacuateShardResponseBody() *control.EvacuateShardResponse_Body {
+	body := new(control.EvacuateShardResponse_Body)
+	body.SetCount(42)
+	body.SetFailed(3)
+
+	return body
+}
+
+func TestAddShardRequest_Body_StableMarshal(t *testing.T) {
+	body := new(control.AddShardRequest_Body)
+	body.SetConfig([]byte("metabase:\n  path: /tmp/metabase\n"))
+
+	testStableMarshal(t, body, new(control.AddShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return bytes.Equal(
+				m1.(*control.AddShardRequest_Body).GetConfig(),
+				m2.(*control.AddShardRequest_Body).GetConfig())
+		},
+	)
+}
+
+func TestAddShardResponse_Body_StableMarshal(t *testing.T) {
+	body := new(control.AddShardResponse_Body)
+	body.SetShardID([]byte{0, 1, 2, 3, 4})
+
+	testStableMarshal(t, body, new(control.AddShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return bytes.Equal(
+				m1.(*control.AddShardResponse_Body).GetShard_ID(),
+				m2.(*control.AddShardResponse_Body).GetShard_ID())
+		},
+	)
+}
+
+func TestFlushCacheRequest_Body_StableMarshal(t *testing.T) {
+	body := new(control.FlushCacheRequest_Body)
+	body.SetShardIDList([][]byte{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}})
+	body.SetDisable(true)
+
+	testStableMarshal(t, body, new(control.FlushCacheRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.FlushCacheRequest_Body)
+			b2 := m2.(*control.FlushCacheRequest_Body)
+
+			if b1.GetDisable() != b2.GetDisable() ||
+				len(b1.GetShard_ID()) != len(b2.GetShard_ID()) {
+				return false
+			}
+
+			for i := range b1.GetShard_ID() {
+				if !bytes.Equal(b1.GetShard_ID()[i], b2.GetShard_ID()[i]) {
+					return false
+				}
+			}
+
+			return true
+		},
+	)
+}
+
+func TestGetCacheInfoResponse_Body_StableMarshal(t *testing.T) {
+	body := new(control.GetCacheInfoResponse_Body)
+	body.SetCaches([]*control.WriteCacheInfo{
+		generateWriteCacheInfo(0),
+		generateWriteCacheInfo(1),
+	})
+
+	testStableMarshal(t, body, new(control.GetCacheInfoResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.GetCacheInfoResponse_Body)
+			b2 := m2.(*control.GetCacheInfoResponse_Body)
+
+			if len(b1.GetCaches()) != len(b2.GetCaches()) {
+				return false
+			}
+
+			for i := range b1.GetCaches() {
+				if !equalWriteCacheInfos(b1.GetCaches()[i], b2.GetCaches()[i]) {
+					return false
+				}
+			}
+
+			return true
+		},
+	)
+}
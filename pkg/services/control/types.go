@@ -97,9 +97,9 @@ func (x *ShardInfo) SetMetabasePath(v string) {
 	x.MetabasePath = v
 }
 
-// SetBlobstorPath sets path to shard's blobstor.
-func (x *ShardInfo) SetBlobstorPath(v string) {
-	x.BlobstorPath = v
+// SetBlobstor sets information about the shard's blobstor components.
+func (x *ShardInfo) SetBlobstor(v []*BlobstorInfo) {
+	x.Blobstor = v
 }
 
 // SetWriteCachePath sets path to shard's write-cache.
@@ -121,3 +121,78 @@ func (x *ShardInfo) SetMode(v ShardMode) {
 func (x *ShardInfo) SetErrorCount(count uint32) {
 	x.ErrorCount = count
 }
+
+// SetObjectCounters sets shard's object counters.
+func (x *ShardInfo) SetObjectCounters(v *ObjectCounters) {
+	x.ObjectCounters = v
+}
+
+// SetPhy sets number of physically stored objects.
+func (x *ObjectCounters) SetPhy(v uint64) {
+	x.Phy = v
+}
+
+// SetLogic sets number of logically available objects.
+func (x *ObjectCounters) SetLogic(v uint64) {
+	x.Logic = v
+}
+
+// SetTombstones sets number of stored tombstones.
+func (x *ObjectCounters) SetTombstones(v uint64) {
+	x.Tombstones = v
+}
+
+// SetLocks sets number of stored locks.
+func (x *ObjectCounters) SetLocks(v uint64) {
+	x.Locks = v
+}
+
+// SetGarbage sets number of stored objects waiting for the garbage collector.
+func (x *ObjectCounters) SetGarbage(v uint64) {
+	x.Garbage = v
+}
+
+// SetID sets identificator of the shard.
+func (x *WriteCacheInfo) SetID(v []byte) {
+	x.Shard_ID = v
+}
+
+// SetMemObjects sets number of objects kept in memory.
+func (x *WriteCacheInfo) SetMemObjects(v uint64) {
+	x.MemObjects = v
+}
+
+// SetMemSize sets total size of objects kept in memory.
+func (x *WriteCacheInfo) SetMemSize(v uint64) {
+	x.MemSize = v
+}
+
+// SetDBObjects sets number of objects in the database.
+func (x *WriteCacheInfo) SetDBObjects(v uint64) {
+	x.DbObjects = v
+}
+
+// SetFSObjects sets number of objects in the file system tree.
+func (x *WriteCacheInfo) SetFSObjects(v uint64) {
+	x.FsObjects = v
+}
+
+// SetNotFlushed sets number of objects which are not flushed yet.
+func (x *WriteCacheInfo) SetNotFlushed(v uint64) {
+	x.NotFlushed = v
+}
+
+// SetDiskSize sets total size of the write-cache files on disk.
+func (x *WriteCacheInfo) SetDiskSize(v uint64) {
+	x.DiskSize = v
+}
+
+// SetPath sets path to the blobstor component.
+func (x *BlobstorInfo) SetPath(v string) {
+	x.Path = v
+}
+
+// SetType sets type of the blobstor component.
+func (x *BlobstorInfo) SetType(v string) {
+	x.Type = v
+}
@@ -138,9 +138,44 @@ func generateShardInfo(id int) *control.ShardInfo {
 	si.SetID(bin)
 	si.SetMode(control.ShardMode_READ_WRITE)
 	si.SetMetabasePath(filepath.Join(path, "meta"))
-	si.SetBlobstorPath(filepath.Join(path, "blobstor"))
+	si.SetBlobstor([]*control.BlobstorInfo{
+		{Type: "fstree", Path: filepath.Join(path, "fstree")},
+		{Type: "blobovnicza", Path: filepath.Join(path, "blobtree")},
+	})
 	si.SetWriteCachePath(filepath.Join(path, "writecache"))
 	si.SetPiloramaPath(filepath.Join(path, "pilorama"))
 
+	oc := new(control.ObjectCounters)
+	oc.SetPhy(uint64(id) + 10)
+	oc.SetLogic(uint64(id) + 5)
+	oc.SetTombstones(uint64(id) + 1)
+	oc.SetLocks(uint64(id) + 2)
+	oc.SetGarbage(5)
+	si.SetObjectCounters(oc)
+
 	return si
 }
+
+func generateWriteCacheInfo(id int) *control.WriteCacheInfo {
+	wc := new(control.WriteCacheInfo)
+
+	wc.SetID([]byte{byte(id), 1, 2, 3})
+	wc.SetMemObjects(uint64(id) + 1)
+	wc.SetMemSize(uint64(id) + 2)
+	wc.SetDBObjects(uint64(id) + 3)
+	wc.SetFSObjects(uint64(id) + 4)
+	wc.SetNotFlushed(uint64(id) + 5)
+	wc.SetDiskSize(uint64(id) + 6)
+
+	return wc
+}
+
+func equalWriteCacheInfos(wc1, wc2 *control.WriteCacheInfo) bool {
+	return bytes.Equal(wc1.GetShard_ID(), wc2.GetShard_ID()) &&
+		wc1.GetMemObjects() == wc2.GetMemObjects() &&
+		wc1.GetMemSize() == wc2.GetMemSize() &&
+		wc1.GetDbObjects() == wc2.GetDbObjects() &&
+		wc1.GetFsObjects() == wc2.GetFsObjects() &&
+		wc1.GetNotFlushed() == wc2.GetNotFlushed() &&
+		wc1.GetDiskSize() == wc2.GetDiskSize()
+}
@@ -18,3 +18,11 @@ type NotificationWriter interface {
 	// from an object with a specific topic.
 	Notify(topic string, address oid.Address)
 }
+
+// EventWriter publishes object events to the subscribers.
+type EventWriter interface {
+	// PublishEvent must publish the event with a specific topic.
+	// Returns an error if the event has not been delivered,
+	// in this case the event is published again later.
+	PublishEvent(topic string, ev Event) error
+}
@@ -0,0 +1,86 @@
+package notificator
+
+import (
+	"encoding/hex"
+
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Event describes the change of the object in the local storage
+// of the node. Event is published in JSON.
+type Event struct {
+	// Sequence number of the event assigned by the Outbox. Events
+	// are delivered at least once, subscribers may use the number
+	// to drop the duplicates.
+	Seq uint64 `json:"seq"`
+
+	// Type of the event: put, inhume, delete or lock.
+	Type string `json:"type"`
+
+	// Container ID of the object.
+	Container string `json:"container"`
+
+	// Object ID.
+	Object string `json:"object"`
+
+	// Object ID of the tombstone, set for inhume events only.
+	Tombstone string `json:"tombstone,omitempty"`
+
+	// Object ID of the locker, set for lock events only.
+	Locker string `json:"locker,omitempty"`
+
+	// Header summary of the object, set for put events only.
+	Header *HeaderSummary `json:"header,omitempty"`
+}
+
+// HeaderSummary is a short description of the object header.
+type HeaderSummary struct {
+	Owner         string            `json:"owner,omitempty"`
+	Type          string            `json:"objectType"`
+	CreationEpoch uint64            `json:"creationEpoch"`
+	PayloadSize   uint64            `json:"payloadSize"`
+	PayloadHash   string            `json:"payloadHash,omitempty"`
+	Parent        string            `json:"parent,omitempty"`
+	Attributes    map[string]string `json:"attributes,omitempty"`
+}
+
+// NewEvent creates new Event of the given type for the object.
+func NewEvent(typ string, addr oid.Address) Event {
+	return Event{
+		Type:      typ,
+		Container: addr.Container().EncodeToString(),
+		Object:    addr.Object().EncodeToString(),
+	}
+}
+
+// NewHeaderSummary returns the summary of the object header.
+func NewHeaderSummary(hdr *objectSDK.Object) *HeaderSummary {
+	res := &HeaderSummary{
+		Type:          hdr.Type().String(),
+		CreationEpoch: hdr.CreationEpoch(),
+		PayloadSize:   hdr.PayloadSize(),
+	}
+
+	if owner := hdr.OwnerID(); owner != nil {
+		res.Owner = owner.EncodeToString()
+	}
+
+	if cs, ok := hdr.PayloadChecksum(); ok {
+		res.PayloadHash = hex.EncodeToString(cs.Value())
+	}
+
+	if par, ok := hdr.ParentID(); ok {
+		res.Parent = par.EncodeToString()
+	}
+
+	if attrs := hdr.Attributes(); len(attrs) != 0 {
+		res.Attributes = make(map[string]string, len(attrs))
+
+		for i := range attrs {
+			res.Attributes[attrs[i].Key()] = attrs[i].Value()
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,244 @@
+package notificator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// number of the events read from the Outbox at once
+	feedBatchSize = 100
+
+	feedMinRetryInterval = time.Second
+	feedMaxRetryInterval = time.Minute
+)
+
+// FeedPrm groups EventFeed constructor's
+// parameters. All are required.
+type FeedPrm struct {
+	writer EventWriter
+	outbox *Outbox
+	topic  string
+	logger *zap.Logger
+}
+
+// SetLogger sets a logger.
+func (prm *FeedPrm) SetLogger(v *zap.Logger) *FeedPrm {
+	prm.logger = v
+	return prm
+}
+
+// SetWriter sets event writer.
+func (prm *FeedPrm) SetWriter(v EventWriter) *FeedPrm {
+	prm.writer = v
+	return prm
+}
+
+// SetOutbox sets the storage of the events which have not been
+// published yet.
+func (prm *FeedPrm) SetOutbox(v *Outbox) *FeedPrm {
+	prm.outbox = v
+	return prm
+}
+
+// SetTopic sets the topic of the published events.
+func (prm *FeedPrm) SetTopic(v string) *FeedPrm {
+	prm.topic = v
+	return prm
+}
+
+// EventFeed publishes object events in real time. Every event is saved
+// in the Outbox first and is removed from it after the EventWriter has
+// delivered it, so events are published at least once even if the writer
+// or the node is not available for some time.
+//
+// Events are saved by the pushing routine, concurrent pushes are saved
+// in a single transaction. If the Outbox is full, pushing waits until
+// the published events free the space.
+//
+// EventFeed must be created via constructor NewEventFeed.
+type EventFeed struct {
+	w      EventWriter
+	outbox *Outbox
+	topic  string
+	l      *zap.Logger
+
+	wake chan struct{}
+
+	mtx sync.Mutex
+	// closed and replaced after the published events are removed
+	freed chan struct{}
+	// closed after Run returns
+	stopped chan struct{}
+}
+
+// NewEventFeed creates, initializes and returns the EventFeed instance.
+//
+// Panics if any field of the passed FeedPrm structure is not set/set
+// to nil.
+func NewEventFeed(prm *FeedPrm) *EventFeed {
+	panicOnNil := func(v interface{}, name string) {
+		if v == nil {
+			panic(fmt.Sprintf("EventFeed constructor: %s is nil\n", name))
+		}
+	}
+
+	panicOnNil(prm.writer, "EventWriter")
+	panicOnNil(prm.logger, "Logger")
+
+	if prm.outbox == nil {
+		panic("EventFeed constructor: Outbox is nil\n")
+	}
+
+	return &EventFeed{
+		w:       prm.writer,
+		outbox:  prm.outbox,
+		topic:   prm.topic,
+		l:       prm.logger,
+		wake:    make(chan struct{}, 1),
+		freed:   make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+}
+
+// Push saves the event in the Outbox and schedules its publication. If the
+// Outbox is full, Push blocks until some events are published, so the
+// pushing routines are slowed down to the rate of the publication instead
+// of losing the events. The event is lost only if it can not be saved
+// due to the Outbox failure or if the Outbox is still full after Run has
+// returned, such events are logged with the error level.
+func (f *EventFeed) Push(ev Event) {
+	for {
+		f.mtx.Lock()
+		freed := f.freed
+		f.mtx.Unlock()
+
+		_, err := f.outbox.Push(ev)
+		if err == nil {
+			select {
+			case f.wake <- struct{}{}:
+			default:
+			}
+
+			return
+		}
+
+		if !errors.Is(err, ErrOutboxFull) {
+			f.logLost(ev, err)
+			return
+		}
+
+		select {
+		case <-freed:
+		case <-f.stopped:
+			f.logLost(ev, err)
+			return
+		}
+	}
+}
+
+func (f *EventFeed) logLost(ev Event, err error) {
+	f.l.Error("event feed: could not save object event, event is lost",
+		zap.String("type", ev.Type),
+		zap.String("container", ev.Container),
+		zap.String("object", ev.Object),
+		zap.Error(err),
+	)
+}
+
+// Run publishes the events from the Outbox until the context is done.
+// Events are published in the order they were pushed. If the event can
+// not be published, it is retried with exponential backoff.
+func (f *EventFeed) Run(ctx context.Context) {
+	defer close(f.stopped)
+
+	f.publishLoop(ctx)
+}
+
+// notifyFreed wakes up the routines waiting for the space in the Outbox.
+func (f *EventFeed) notifyFreed() {
+	f.mtx.Lock()
+	close(f.freed)
+	f.freed = make(chan struct{})
+	f.mtx.Unlock()
+}
+
+func (f *EventFeed) publishLoop(ctx context.Context) {
+	retry := feedMinRetryInterval
+
+	for {
+		evs, err := f.outbox.Next(feedBatchSize)
+		if err == nil {
+			var published []uint64
+
+			published, err = f.publish(evs)
+
+			if len(published) != 0 {
+				if err := f.outbox.Remove(published...); err != nil {
+					// events will be published again
+					f.l.Error("event feed: could not remove published object events", zap.Error(err))
+				} else {
+					f.notifyFreed()
+				}
+			}
+		}
+
+		var wait <-chan time.Time
+
+		switch {
+		case err != nil:
+			f.l.Warn("event feed: could not publish object events, will retry",
+				zap.Duration("retry in", retry),
+				zap.Int("pending", f.outbox.Len()),
+				zap.Error(err),
+			)
+
+			wait = time.After(retry)
+
+			if retry *= 2; retry > feedMaxRetryInterval {
+				retry = feedMaxRetryInterval
+			}
+		case len(evs) == feedBatchSize:
+			retry = feedMinRetryInterval
+			continue
+		default:
+			retry = feedMinRetryInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-f.wake:
+			if wait != nil {
+				// do not hammer unavailable writer
+				select {
+				case <-ctx.Done():
+					return
+				case <-wait:
+				}
+			}
+		case <-wait:
+		}
+	}
+}
+
+// publish publishes the events in order and returns the sequence numbers
+// of the delivered ones. Stops at the first failure.
+func (f *EventFeed) publish(evs []Event) ([]uint64, error) {
+	published := make([]uint64, 0, len(evs))
+
+	for i := range evs {
+		if err := f.w.PublishEvent(f.topic, evs[i]); err != nil {
+			return published, fmt.Errorf("event %d: %w", evs[i].Seq, err)
+		}
+
+		published = append(published, evs[i].Seq)
+	}
+
+	return published, nil
+}
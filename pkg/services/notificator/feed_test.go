@@ -0,0 +1,208 @@
+package notificator
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testEventWriter struct {
+	mtx sync.Mutex
+
+	fail bool
+
+	events []Event
+}
+
+func (w *testEventWriter) PublishEvent(_ string, ev Event) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.fail {
+		return errors.New("unavailable")
+	}
+
+	w.events = append(w.events, ev)
+
+	return nil
+}
+
+func (w *testEventWriter) setFail(v bool) {
+	w.mtx.Lock()
+	w.fail = v
+	w.mtx.Unlock()
+}
+
+func (w *testEventWriter) published() []Event {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return append([]Event(nil), w.events...)
+}
+
+func TestOutbox(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox")
+
+	o, err := OpenOutbox(path, 2)
+	require.NoError(t, err)
+
+	ev1, err := o.Push(Event{Type: "put", Object: "1"})
+	require.NoError(t, err)
+	ev2, err := o.Push(Event{Type: "lock", Object: "2"})
+	require.NoError(t, err)
+	require.Less(t, ev1.Seq, ev2.Seq)
+
+	_, err = o.Push(Event{Type: "delete", Object: "3"})
+	require.ErrorIs(t, err, ErrOutboxFull)
+
+	require.NoError(t, o.Close())
+
+	o, err = OpenOutbox(path, 2)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = o.Close() })
+
+	require.Equal(t, 2, o.Len())
+
+	evs, err := o.Next(10)
+	require.NoError(t, err)
+	require.Equal(t, []Event{ev1, ev2}, evs)
+
+	require.NoError(t, o.Remove(ev1.Seq, ev1.Seq))
+	require.Equal(t, 1, o.Len())
+
+	ev3, err := o.Push(Event{Type: "delete", Object: "3"})
+	require.NoError(t, err)
+	require.Less(t, ev2.Seq, ev3.Seq)
+
+	evs, err = o.Next(10)
+	require.NoError(t, err)
+	require.Equal(t, []Event{ev2, ev3}, evs)
+
+	require.NoError(t, o.Remove(ev2.Seq))
+
+	saved, err := o.PushBatch([]Event{{Type: "put", Object: "4"}, {Type: "put", Object: "5"}})
+	require.ErrorIs(t, err, ErrOutboxFull)
+	require.Len(t, saved, 1)
+	require.Equal(t, "4", saved[0].Object)
+	require.Less(t, ev3.Seq, saved[0].Seq)
+	require.Equal(t, 2, o.Len())
+}
+
+func TestEventFeed(t *testing.T) {
+	o, err := OpenOutbox(filepath.Join(t.TempDir(), "outbox"), 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = o.Close() })
+
+	w := new(testEventWriter)
+	w.setFail(true)
+
+	f := NewEventFeed(new(FeedPrm).
+		SetLogger(zap.NewNop()).
+		SetWriter(w).
+		SetOutbox(o).
+		SetTopic("events"))
+
+	f.Push(Event{Type: "put", Object: "1"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		f.Run(ctx)
+		close(done)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	f.Push(Event{Type: "delete", Object: "1"})
+
+	require.Never(t, func() bool {
+		return len(w.published()) != 0
+	}, 200*time.Millisecond, 10*time.Millisecond)
+	require.Equal(t, 2, o.Len(), "undelivered events must be kept")
+
+	w.setFail(false)
+
+	require.Eventually(t, func() bool {
+		return len(w.published()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	evs := w.published()
+	require.Equal(t, "put", evs[0].Type)
+	require.Equal(t, "delete", evs[1].Type)
+
+	require.Eventually(t, func() bool {
+		return o.Len() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestEventFeed_FullOutbox(t *testing.T) {
+	o, err := OpenOutbox(filepath.Join(t.TempDir(), "outbox"), 1)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = o.Close() })
+
+	w := new(testEventWriter)
+	w.setFail(true)
+
+	f := NewEventFeed(new(FeedPrm).
+		SetLogger(zap.NewNop()).
+		SetWriter(w).
+		SetOutbox(o).
+		SetTopic("events"))
+
+	f.Push(Event{Type: "put", Object: "1"})
+	require.Equal(t, 1, o.Len(), "event must be saved on push")
+
+	pushed := make(chan struct{})
+
+	go func() {
+		f.Push(Event{Type: "put", Object: "2"})
+		close(pushed)
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		f.Run(ctx)
+		close(done)
+	}()
+
+	require.Never(t, func() bool {
+		select {
+		case <-pushed:
+			return true
+		default:
+			return false
+		}
+	}, 200*time.Millisecond, 10*time.Millisecond, "push must wait for the space in the outbox")
+
+	w.setFail(false)
+
+	require.Eventually(t, func() bool {
+		return len(w.published()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	evs := w.published()
+	require.Equal(t, "1", evs[0].Object)
+	require.Equal(t, "2", evs[1].Object)
+
+	w.setFail(true)
+	f.Push(Event{Type: "put", Object: "3"})
+
+	cancel()
+	<-done
+
+	// the outbox is full and the feed is stopped
+	f.Push(Event{Type: "put", Object: "4"})
+	require.Equal(t, 1, o.Len())
+}
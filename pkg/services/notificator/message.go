@@ -0,0 +1,38 @@
+package notificator
+
+import (
+	"encoding/json"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Message is a JSON message sent by the writers which do not have
+// own topic routing, e.g. webhook and socket writers. Exactly one of
+// Address and Event is set.
+type Message struct {
+	// Topic of the notification.
+	Topic string `json:"topic"`
+
+	// Address of the object with the notification epoch.
+	Address string `json:"address,omitempty"`
+
+	// Object event.
+	Event *Event `json:"event,omitempty"`
+}
+
+// NotificationMessage returns JSON encoded Message about the object with
+// the notification epoch.
+func NotificationMessage(topic string, addr oid.Address) ([]byte, error) {
+	return json.Marshal(Message{
+		Topic:   topic,
+		Address: addr.EncodeToString(),
+	})
+}
+
+// EventMessage returns JSON encoded Message about the object event.
+func EventMessage(topic string, ev Event) ([]byte, error) {
+	return json.Marshal(Message{
+		Topic: topic,
+		Event: &ev,
+	})
+}
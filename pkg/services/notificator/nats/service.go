@@ -2,19 +2,22 @@ package nats
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 
 	"github.com/nats-io/nats.go"
+	"github.com/nspcc-dev/neofs-node/pkg/services/notificator"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
 // Writer is a NATS object notification writer.
 // It handles NATS JetStream connections and allows
-// sending string representation of the address to
-// the NATS server.
+// sending string representation of the address and
+// object events to the NATS server.
 //
 // For correct operation must be created via New function.
 // new(Writer) or Writer{} construction leads to undefined
@@ -53,29 +56,66 @@ func (n *Writer) Notify(topic string, address oid.Address) error {
 	// message ID for the 'exactly once' delivery
 	messageID := address.Object().EncodeToString()[:4]
 
-	// check if the stream was previously created
+	if err := n.addStream(topic); err != nil {
+		return err
+	}
+
+	_, err := n.js.Publish(topic, []byte(address.EncodeToString()), nats.MsgId(messageID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PublishEvent sends JSON representation of the object event to the
+// provided topic. Uses event type, object address and sequence number
+// as a message ID, so the events redelivered in a short time are dropped
+// by the server.
+//
+// Returns error in the same cases as Notify.
+func (n *Writer) PublishEvent(topic string, ev notificator.Event) error {
+	if !n.nc.IsConnected() {
+		return errConnIsClosed
+	}
+
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("could not encode event: %w", err)
+	}
+
+	if err := n.addStream(topic); err != nil {
+		return err
+	}
+
+	messageID := ev.Type + "/" + ev.Container + "/" + ev.Object + "/" + strconv.FormatUint(ev.Seq, 10)
+
+	_, err = n.js.Publish(topic, data, nats.MsgId(messageID))
+
+	return err
+}
+
+// addStream creates the stream for the topic if it has not been created yet.
+func (n *Writer) addStream(topic string) error {
 	n.m.RLock()
 	_, created := n.createdStreams[topic]
 	n.m.RUnlock()
 
-	if !created {
-		_, err := n.js.AddStream(&nats.StreamConfig{
-			Name: topic,
-		})
-		if err != nil {
-			return fmt.Errorf("could not add stream: %w", err)
-		}
-
-		n.m.Lock()
-		n.createdStreams[topic] = struct{}{}
-		n.m.Unlock()
+	if created {
+		return nil
 	}
 
-	_, err := n.js.Publish(topic, []byte(address.EncodeToString()), nats.MsgId(messageID))
+	_, err := n.js.AddStream(&nats.StreamConfig{
+		Name: topic,
+	})
 	if err != nil {
-		return err
+		return fmt.Errorf("could not add stream: %w", err)
 	}
 
+	n.m.Lock()
+	n.createdStreams[topic] = struct{}{}
+	n.m.Unlock()
+
 	return nil
 }
 
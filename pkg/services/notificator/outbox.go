@@ -0,0 +1,213 @@
+package notificator
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.etcd.io/bbolt"
+)
+
+// Outbox is a persistent queue of the events which have not been
+// published yet. Events are stored in the order of their sequence numbers.
+//
+// Outbox must be created via OpenOutbox.
+type Outbox struct {
+	db *bbolt.DB
+
+	capacity int
+
+	mtx   sync.Mutex
+	count int
+}
+
+// ErrOutboxFull is returned by Outbox.Push when the capacity of the Outbox
+// is reached.
+var ErrOutboxFull = errors.New("event outbox is full")
+
+var outboxBucket = []byte("events")
+
+// OpenOutbox opens the Outbox stored in the file with 0600 rights. Outbox
+// holds at most capacity events, non-positive capacity means no limit.
+func OpenOutbox(path string, capacity int) (*Outbox, error) {
+	db, err := bbolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't open bbolt at %s: %w", path, err)
+	}
+
+	o := &Outbox{
+		db:       db,
+		capacity: capacity,
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(outboxBucket)
+		if err != nil {
+			return err
+		}
+
+		o.count = b.Stats().KeyN
+
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("can't create outbox bucket: %w", err)
+	}
+
+	return o, nil
+}
+
+// Push assigns the next sequence number to the event and saves it.
+//
+// Returns ErrOutboxFull if the capacity is reached.
+func (o *Outbox) Push(ev Event) (Event, error) {
+	evs, err := o.PushBatch([]Event{ev})
+	if len(evs) == 0 {
+		return ev, err
+	}
+
+	return evs[0], err
+}
+
+// PushBatch assigns the next sequence numbers to the events and saves them
+// in a single transaction. Returns the saved events. Concurrent calls are
+// combined into a single transaction.
+//
+// If the capacity is reached, only the first events that fit are saved
+// and ErrOutboxFull is returned.
+func (o *Outbox) PushBatch(evs []Event) ([]Event, error) {
+	n := len(evs)
+
+	o.mtx.Lock()
+	if o.capacity > 0 && o.count+n > o.capacity {
+		n = o.capacity - o.count
+		if n < 0 {
+			n = 0
+		}
+	}
+	o.count += n
+	o.mtx.Unlock()
+
+	if n == 0 {
+		return nil, ErrOutboxFull
+	}
+
+	res := make([]Event, n)
+	copy(res, evs)
+
+	err := o.db.Batch(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(outboxBucket)
+
+		for i := range res {
+			seq, err := b.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			res[i].Seq = seq
+
+			data, err := json.Marshal(res[i])
+			if err != nil {
+				return err
+			}
+
+			if err := b.Put(seqKey(seq), data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		o.mtx.Lock()
+		o.count -= n
+		o.mtx.Unlock()
+
+		return nil, fmt.Errorf("can't save events: %w", err)
+	}
+
+	if n < len(evs) {
+		return res, ErrOutboxFull
+	}
+
+	return res, nil
+}
+
+// Next returns at most limit oldest events.
+func (o *Outbox) Next(limit int) ([]Event, error) {
+	var res []Event
+
+	err := o.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(outboxBucket).Cursor()
+
+		for k, v := c.First(); k != nil && len(res) < limit; k, v = c.Next() {
+			var ev Event
+			if err := json.Unmarshal(v, &ev); err != nil {
+				return fmt.Errorf("can't unmarshal event %d: %w", binary.BigEndian.Uint64(k), err)
+			}
+
+			res = append(res, ev)
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+// Remove removes the events with the given sequence numbers.
+func (o *Outbox) Remove(seqs ...uint64) error {
+	var removed int
+
+	err := o.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(outboxBucket)
+		removed = 0
+
+		for i := range seqs {
+			key := seqKey(seqs[i])
+			if b.Get(key) == nil {
+				continue
+			}
+
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+
+			removed++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("can't remove events: %w", err)
+	}
+
+	o.mtx.Lock()
+	o.count -= removed
+	o.mtx.Unlock()
+
+	return nil
+}
+
+// Len returns the number of the events in the Outbox.
+func (o *Outbox) Len() int {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+
+	return o.count
+}
+
+// Close closes the Outbox.
+func (o *Outbox) Close() error {
+	return o.db.Close()
+}
+
+func seqKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+
+	return key
+}
@@ -0,0 +1,20 @@
+package socket
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WithTimeout sets the timeout of the connection and of a single write.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *opts) {
+		o.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(o *opts) {
+		o.log = logger
+	}
+}
@@ -0,0 +1,166 @@
+package socket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/notificator"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// Writer is a Unix domain socket object notification writer.
+// It writes JSON encoded notificator.Message per line (JSON Lines)
+// to the stream socket. Connection is established on the first
+// message and re-established after the failure. Message is
+// considered delivered once it has been written to the socket.
+//
+// For correct operation must be created via New function.
+// new(Writer) or Writer{} construction leads to undefined
+// behaviour and is not safe.
+type Writer struct {
+	path string
+
+	mtx    sync.Mutex
+	closed bool
+	conn   net.Conn
+
+	opts
+}
+
+type opts struct {
+	log     *zap.Logger
+	timeout time.Duration
+}
+
+type Option func(*opts)
+
+const defaultTimeout = 5 * time.Second
+
+var (
+	errNotConnected = errors.New("socket path is not set")
+	errClosed       = errors.New("writer is closed")
+)
+
+// New creates new Writer.
+func New(oo ...Option) *Writer {
+	w := &Writer{
+		opts: opts{
+			log:     zap.L(),
+			timeout: defaultTimeout,
+		},
+	}
+
+	for _, o := range oo {
+		o(&w.opts)
+	}
+
+	return w
+}
+
+// Connect sets the path of the socket and tries to connect to it.
+// Connection failure is logged and the connection is retried on the next
+// message, so the socket may be listened after the node start. Connection
+// is closed when passed context is done.
+func (w *Writer) Connect(ctx context.Context, path string) error {
+	if path == "" {
+		return errNotConnected
+	}
+
+	w.mtx.Lock()
+	w.path = path
+
+	conn, err := net.DialTimeout("unix", path, w.timeout)
+	if err != nil {
+		w.log.Warn("socket: could not connect, will retry on the next message",
+			zap.String("path", path),
+			zap.Error(err),
+		)
+	} else {
+		w.conn = conn
+	}
+	w.mtx.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		w.log.Info("socket: closing connection as the context is done")
+
+		w.mtx.Lock()
+		w.closed = true
+		w.resetConn()
+		w.mtx.Unlock()
+	}()
+
+	return nil
+}
+
+// Notify writes the object address with the topic to the socket.
+//
+// Returns error if the message has not been written.
+func (w *Writer) Notify(topic string, address oid.Address) error {
+	data, err := notificator.NotificationMessage(topic, address)
+	if err != nil {
+		return fmt.Errorf("could not encode notification: %w", err)
+	}
+
+	return w.write(data)
+}
+
+// PublishEvent writes the object event with the topic to the socket.
+//
+// Returns error in the same cases as Notify.
+func (w *Writer) PublishEvent(topic string, ev notificator.Event) error {
+	data, err := notificator.EventMessage(topic, ev)
+	if err != nil {
+		return fmt.Errorf("could not encode event: %w", err)
+	}
+
+	return w.write(data)
+}
+
+func (w *Writer) write(data []byte) error {
+	line := make([]byte, len(data)+1)
+	copy(line, data)
+	line[len(data)] = '\n'
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	switch {
+	case w.closed:
+		return errClosed
+	case w.path == "":
+		return errNotConnected
+	}
+
+	if w.conn == nil {
+		conn, err := net.DialTimeout("unix", w.path, w.timeout)
+		if err != nil {
+			return fmt.Errorf("could not connect to socket: %w", err)
+		}
+
+		w.conn = conn
+	}
+
+	_ = w.conn.SetWriteDeadline(time.Now().Add(w.timeout))
+
+	if _, err := w.conn.Write(line); err != nil {
+		// partially written line is finished by the broken connection,
+		// so the next line is written to the new one
+		w.resetConn()
+		return fmt.Errorf("could not write to socket: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Writer) resetConn() {
+	if w.conn != nil {
+		_ = w.conn.Close()
+		w.conn = nil
+	}
+}
@@ -0,0 +1,65 @@
+package socket
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/notificator"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.sock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	w := New()
+	require.NoError(t, w.Connect(ctx, path))
+
+	addr := oidtest.Address()
+
+	require.Error(t, w.Notify("topic", addr), "socket is not listened")
+
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	lines := make(chan notificator.Message, 2)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		s := bufio.NewScanner(conn)
+		for s.Scan() {
+			var msg notificator.Message
+			if json.Unmarshal(s.Bytes(), &msg) == nil {
+				lines <- msg
+			}
+		}
+	}()
+
+	ev := notificator.NewEvent("put", addr)
+	ev.Seq = 1
+
+	require.NoError(t, w.Notify("topic", addr))
+	require.NoError(t, w.PublishEvent("events", ev))
+
+	require.Equal(t, notificator.Message{
+		Topic:   "topic",
+		Address: addr.EncodeToString(),
+	}, <-lines)
+	require.Equal(t, notificator.Message{
+		Topic: "events",
+		Event: &ev,
+	}, <-lines)
+}
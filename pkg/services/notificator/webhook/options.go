@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"crypto/tls"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WithSecret sets the key of HMAC-SHA256 signature of the request bodies.
+// Requests are not signed if the key is empty.
+func WithSecret(secret []byte) Option {
+	return func(o *opts) {
+		o.secret = secret
+	}
+}
+
+// WithRetries sets the number of the request retries after the failure.
+func WithRetries(n uint32) Option {
+	return func(o *opts) {
+		o.retries = n
+	}
+}
+
+// WithBackoff sets the delay before the first retry, the delay is doubled
+// for every next retry.
+func WithBackoff(d time.Duration) Option {
+	return func(o *opts) {
+		o.backoff = d
+	}
+}
+
+// WithTimeout sets the timeout of a single request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *opts) {
+		o.client.Timeout = timeout
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of HTTPS requests.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(o *opts) {
+		o.tlsConfig = cfg
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(o *opts) {
+		o.log = logger
+	}
+}
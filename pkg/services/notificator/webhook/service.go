@@ -0,0 +1,210 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/notificator"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader is an HTTP header with hex-encoded HMAC-SHA256
+	// signature of the request body prefixed with "sha256=".
+	SignatureHeader = "X-Neofs-Signature"
+
+	// TopicHeader is an HTTP header with the topic of the notification.
+	TopicHeader = "X-Neofs-Topic"
+)
+
+// Writer is an HTTP webhook object notification writer.
+// It sends JSON encoded notificator.Message in the body of
+// POST requests to the specified URL. Failed requests are
+// retried with exponential backoff.
+//
+// For correct operation must be created via New function.
+// new(Writer) or Writer{} construction leads to undefined
+// behaviour and is not safe.
+type Writer struct {
+	ctx context.Context
+	url string
+
+	opts
+}
+
+type opts struct {
+	log       *zap.Logger
+	client    http.Client
+	tlsConfig *tls.Config
+	secret    []byte
+	retries   uint32
+	backoff   time.Duration
+}
+
+type Option func(*opts)
+
+const (
+	defaultRetries = 3
+	defaultBackoff = 500 * time.Millisecond
+)
+
+var errNotConnected = errors.New("webhook URL is not set")
+
+// errPermanent wraps the errors which are not fixed by the retry.
+type errPermanent struct {
+	error
+}
+
+// New creates new Writer.
+func New(oo ...Option) *Writer {
+	w := &Writer{
+		ctx: context.Background(),
+		opts: opts{
+			log:     zap.L(),
+			retries: defaultRetries,
+			backoff: defaultBackoff,
+		},
+	}
+
+	for _, o := range oo {
+		o(&w.opts)
+	}
+
+	if w.tlsConfig != nil {
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.TLSClientConfig = w.tlsConfig
+		w.client.Transport = tr
+	}
+
+	return w
+}
+
+// Connect sets the URL of the webhook. Retries of the requests
+// are interrupted when passed context is done.
+func (w *Writer) Connect(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported webhook URL scheme %q", u.Scheme)
+	}
+
+	w.ctx = ctx
+	w.url = endpoint
+
+	return nil
+}
+
+// Notify sends the object address with the topic to the webhook.
+//
+// Returns error if the webhook has not accepted the notification
+// after all retries.
+func (w *Writer) Notify(topic string, address oid.Address) error {
+	data, err := notificator.NotificationMessage(topic, address)
+	if err != nil {
+		return fmt.Errorf("could not encode notification: %w", err)
+	}
+
+	return w.send(topic, data)
+}
+
+// PublishEvent sends the object event with the topic to the webhook.
+//
+// Returns error in the same cases as Notify.
+func (w *Writer) PublishEvent(topic string, ev notificator.Event) error {
+	data, err := notificator.EventMessage(topic, ev)
+	if err != nil {
+		return fmt.Errorf("could not encode event: %w", err)
+	}
+
+	return w.send(topic, data)
+}
+
+func (w *Writer) send(topic string, body []byte) error {
+	if w.url == "" {
+		return errNotConnected
+	}
+
+	var signature string
+	if len(w.secret) != 0 {
+		mac := hmac.New(sha256.New, w.secret)
+		mac.Write(body)
+		signature = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	backoff := w.backoff
+
+	for i := uint32(0); ; i++ {
+		err := w.post(topic, body, signature)
+		if err == nil {
+			return nil
+		}
+
+		var errPerm errPermanent
+		if errors.As(err, &errPerm) || i == w.retries {
+			return err
+		}
+
+		w.log.Debug("webhook: request failed, will retry",
+			zap.Duration("retry in", backoff),
+			zap.Error(err),
+		)
+
+		t := time.NewTimer(backoff)
+
+		select {
+		case <-w.ctx.Done():
+			t.Stop()
+			return fmt.Errorf("%w (last error: %v)", w.ctx.Err(), err)
+		case <-t.C:
+		}
+
+		backoff *= 2
+	}
+}
+
+func (w *Writer) post(topic string, body []byte, signature string) error {
+	req, err := http.NewRequestWithContext(w.ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return errPermanent{fmt.Errorf("could not create request: %w", err)}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TopicHeader, topic)
+
+	if signature != "" {
+		req.Header.Set(SignatureHeader, signature)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	// drain the body to reuse the connection
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+	_ = resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusRequestTimeout:
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	default:
+		return errPermanent{fmt.Errorf("notification rejected: %s", resp.Status)}
+	}
+}
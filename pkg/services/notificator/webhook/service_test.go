@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/notificator"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	secret := []byte("secret")
+
+	var (
+		attempts int32
+		status   int32 = http.StatusInternalServerError
+		received       = make(chan notificator.Message, 1)
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			rw.WriteHeader(int(atomic.LoadInt32(&status)))
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(body)
+		require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(SignatureHeader))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var msg notificator.Message
+		require.NoError(t, json.Unmarshal(body, &msg))
+		require.Equal(t, msg.Topic, r.Header.Get(TopicHeader))
+
+		received <- msg
+	}))
+	t.Cleanup(srv.Close)
+
+	w := New(
+		WithSecret(secret),
+		WithBackoff(time.Millisecond),
+		WithTimeout(time.Second),
+	)
+
+	addr := oidtest.Address()
+
+	require.ErrorIs(t, w.Notify("topic", addr), errNotConnected)
+	require.Error(t, w.Connect(context.Background(), "ftp://localhost"))
+	require.NoError(t, w.Connect(context.Background(), srv.URL))
+
+	t.Run("retry", func(t *testing.T) {
+		require.NoError(t, w.Notify("topic", addr))
+		require.EqualValues(t, 2, atomic.LoadInt32(&attempts))
+		require.Equal(t, notificator.Message{
+			Topic:   "topic",
+			Address: addr.EncodeToString(),
+		}, <-received)
+	})
+
+	t.Run("event", func(t *testing.T) {
+		atomic.StoreInt32(&attempts, 1)
+
+		ev := notificator.NewEvent("delete", addr)
+		ev.Seq = 42
+
+		require.NoError(t, w.PublishEvent("events", ev))
+		require.Equal(t, notificator.Message{
+			Topic: "events",
+			Event: &ev,
+		}, <-received)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		atomic.StoreInt32(&attempts, 0)
+		atomic.StoreInt32(&status, http.StatusBadRequest)
+
+		require.Error(t, w.Notify("topic", addr))
+		require.EqualValues(t, 1, atomic.LoadInt32(&attempts), "rejected request must not be retried")
+	})
+
+	t.Run("retries exceeded", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&attempts, 1)
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(srv.Close)
+
+		atomic.StoreInt32(&attempts, 0)
+
+		w := New(WithRetries(2), WithBackoff(time.Millisecond))
+		require.NoError(t, w.Connect(context.Background(), srv.URL))
+
+		require.Error(t, w.Notify("topic", addr))
+		require.EqualValues(t, 3, atomic.LoadInt32(&attempts))
+	})
+}
@@ -93,7 +93,17 @@ func NewChecker(prm *CheckerPrm) *Checker {
 // CheckBasicACL is a main check function for basic ACL.
 func (c *Checker) CheckBasicACL(info v2.RequestInfo) bool {
 	// check basic ACL permissions
-	return info.BasicACL().IsOpAllowed(info.Operation(), info.RequestRole())
+	allowed := info.BasicACL().IsOpAllowed(info.Operation(), info.RequestRole())
+
+	if trace := info.Trace(); trace != nil {
+		if allowed {
+			trace.Addf("basic ACL %s allows %s for %s", info.BasicACL().EncodeToString(), info.Operation(), info.RequestRole())
+		} else {
+			trace.Addf("basic ACL %s denies %s for %s", info.BasicACL().EncodeToString(), info.Operation(), info.RequestRole())
+		}
+	}
+
+	return allowed
 }
 
 // StickyBitCheck validates owner field in the request if sticky bit is enabled.
@@ -109,71 +119,83 @@ func (c *Checker) StickyBitCheck(info v2.RequestInfo, owner user.ID) bool {
 	}
 
 	if len(info.SenderKey()) == 0 {
+		info.Trace().Addf("sticky bit is set, sender key is missing")
 		return false
 	}
 
 	requestSenderKey := unmarshalPublicKey(info.SenderKey())
 
-	return isOwnerFromKey(owner, requestSenderKey)
+	if !isOwnerFromKey(owner, requestSenderKey) {
+		info.Trace().Addf("sticky bit is set, object owner %s is not the sender", owner)
+		return false
+	}
+
+	return true
 }
 
 // CheckEACL is a main check function for extended ACL.
 func (c *Checker) CheckEACL(msg interface{}, reqInfo v2.RequestInfo) error {
+	return c.checkEACL(reqInfo, func() (*eaclSDK.Table, error) {
+		eaclInfo, err := c.eaclSrc.GetEACL(reqInfo.ContainerID())
+		if err != nil {
+			return nil, err
+		}
+
+		return eaclInfo.Value, nil
+	}, func() (eaclSDK.TypedHeaderSource, error) {
+		return c.headerSource(msg, reqInfo)
+	})
+}
+
+func (c *Checker) checkEACL(reqInfo v2.RequestInfo,
+	getTable func() (*eaclSDK.Table, error),
+	getHeaders func() (eaclSDK.TypedHeaderSource, error)) error {
+	trace := reqInfo.Trace()
+
 	basicACL := reqInfo.BasicACL()
 	if !basicACL.Extendable() {
+		trace.Addf("basic ACL is final, eACL is not checked")
 		return nil
 	}
 
 	// if bearer token is not allowed, then ignore it
 	if !basicACL.AllowedBearerRules(reqInfo.Operation()) {
+		if reqInfo.Bearer() != nil {
+			trace.Addf("basic ACL does not allow bearer token rules for %s, bearer token is ignored", reqInfo.Operation())
+		}
+
 		reqInfo.CleanBearer()
 	}
 
 	var table eaclSDK.Table
-	cnr := reqInfo.ContainerID()
 
 	bearerTok := reqInfo.Bearer()
 	if bearerTok == nil {
-		eaclInfo, err := c.eaclSrc.GetEACL(cnr)
+		tbl, err := getTable()
 		if err != nil {
 			if client.IsErrEACLNotFound(err) {
+				trace.Addf("container has no eACL table")
 				return nil
 			}
 			return err
 		}
 
-		table = *eaclInfo.Value
+		table = *tbl
+		trace.Addf("eACL table of the container is used")
 	} else {
 		table = bearerTok.EACLTable()
+		trace.Addf("eACL table of the bearer token is used")
 	}
 
 	// if bearer token is not present, isValidBearer returns true
 	if err := isValidBearer(reqInfo, c.state); err != nil {
+		trace.Addf("bearer token is rejected: %v", err)
 		return err
 	}
 
-	hdrSrcOpts := make([]eaclV2.Option, 0, 3)
-
-	hdrSrcOpts = append(hdrSrcOpts,
-		eaclV2.WithLocalObjectStorage(c.localStorage),
-		eaclV2.WithCID(cnr),
-		eaclV2.WithOID(reqInfo.ObjectID()),
-	)
-
-	if req, ok := msg.(eaclV2.Request); ok {
-		hdrSrcOpts = append(hdrSrcOpts, eaclV2.WithServiceRequest(req))
-	} else {
-		hdrSrcOpts = append(hdrSrcOpts,
-			eaclV2.WithServiceResponse(
-				msg.(eaclV2.Response),
-				reqInfo.Request().(eaclV2.Request),
-			),
-		)
-	}
-
-	hdrSrc, err := eaclV2.NewMessageHeaderSource(hdrSrcOpts...)
+	hdrSrc, err := getHeaders()
 	if err != nil {
-		return fmt.Errorf("can't parse headers: %w", err)
+		return err
 	}
 
 	var eaclRole eaclSDK.Role
@@ -188,6 +210,8 @@ func (c *Checker) CheckEACL(msg interface{}, reqInfo v2.RequestInfo) error {
 		eaclRole = eaclSDK.RoleOthers
 	}
 
+	cnr := reqInfo.ContainerID()
+
 	action, _ := c.validator.CalculateAction(new(eaclSDK.ValidationUnit).
 		WithRole(eaclRole).
 		WithOperation(eaclSDK.Operation(reqInfo.Operation())).
@@ -197,17 +221,80 @@ func (c *Checker) CheckEACL(msg interface{}, reqInfo v2.RequestInfo) error {
 		WithEACLTable(&table),
 	)
 
+	if trace != nil {
+		traceEACL(trace, eaclRole, reqInfo, hdrSrc, &table, action)
+	}
+
 	if action != eaclSDK.ActionAllow {
 		return errEACLDeniedByRule
 	}
 	return nil
 }
 
+// traceEACL writes the evaluation results of the eACL records to the trace.
+// The trace only explains the action calculated by the validator.
+func traceEACL(trace *v2.Trace, role eaclSDK.Role, reqInfo v2.RequestInfo, hdrSrc eaclSDK.TypedHeaderSource, table *eaclSDK.Table, action eaclSDK.Action) {
+	var prm eacl.TracePrm
+
+	prm.SetRole(role)
+	prm.SetOperation(eaclSDK.Operation(reqInfo.Operation()))
+	prm.SetSenderKey(reqInfo.SenderKey())
+	prm.SetHeaderSource(hdrSrc)
+	prm.SetTable(table)
+
+	records, traced, matched := eacl.TraceRecords(prm)
+	for i := range records {
+		trace.Addf("%s", records[i])
+	}
+
+	switch {
+	case traced != action:
+		trace.Addf("eACL records above do not explain the action %s calculated by the validator", action)
+	case matched:
+		trace.Addf("eACL record #%d determines action %s", records[len(records)-1].Index, action)
+	case len(records) != 0 && records[len(records)-1].Result == eacl.RecordHeadersMissing:
+		trace.Addf("eACL check is interrupted because of missing headers, action %s", action)
+	default:
+		trace.Addf("no eACL record matches the request, action %s", action)
+	}
+}
+
+func (c *Checker) headerSource(msg interface{}, reqInfo v2.RequestInfo) (eaclSDK.TypedHeaderSource, error) {
+	cnr := reqInfo.ContainerID()
+
+	hdrSrcOpts := make([]eaclV2.Option, 0, 3)
+
+	hdrSrcOpts = append(hdrSrcOpts,
+		eaclV2.WithLocalObjectStorage(c.localStorage),
+		eaclV2.WithCID(cnr),
+		eaclV2.WithOID(reqInfo.ObjectID()),
+	)
+
+	if req, ok := msg.(eaclV2.Request); ok {
+		hdrSrcOpts = append(hdrSrcOpts, eaclV2.WithServiceRequest(req))
+	} else {
+		hdrSrcOpts = append(hdrSrcOpts,
+			eaclV2.WithServiceResponse(
+				msg.(eaclV2.Response),
+				reqInfo.Request().(eaclV2.Request),
+			),
+		)
+	}
+
+	hdrSrc, err := eaclV2.NewMessageHeaderSource(hdrSrcOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse headers: %w", err)
+	}
+
+	return hdrSrc, nil
+}
+
 // isValidBearer checks whether bearer token was correctly signed by authorized
 // entity. This method might be defined on whole ACL service because it will
 // require fetching current epoch to check lifetime.
 func isValidBearer(reqInfo v2.RequestInfo, st netmap.State) error {
 	ownerCnr := reqInfo.ContainerOwner()
+	trace := reqInfo.Trace()
 
 	token := reqInfo.Bearer()
 
@@ -221,23 +308,33 @@ func isValidBearer(reqInfo v2.RequestInfo, st netmap.State) error {
 		return errBearerExpired
 	}
 
+	trace.Addf("bearer token is valid at epoch %d", st.CurrentEpoch())
+
 	// 2. Then check if bearer token is signed correctly.
 	if !token.VerifySignature() {
 		return errBearerInvalidSignature
 	}
 
+	trace.Addf("bearer token signature is correct")
+
 	// 3. Then check if container is either empty or equal to the container in the request.
 	cnr, isSet := token.EACLTable().CID()
 	if isSet && !cnr.Equals(reqInfo.ContainerID()) {
 		return errBearerInvalidContainerID
 	}
 
+	if isSet {
+		trace.Addf("bearer token is issued for the requested container")
+	}
+
 	// 4. Then check if container owner signed this token.
 	if !bearerSDK.ResolveIssuer(*token).Equals(ownerCnr) {
 		// TODO: #767 in this case we can issue all owner keys from neofs.id and check once again
 		return errBearerNotSignedByOwner
 	}
 
+	trace.Addf("bearer token is issued by the container owner %s", ownerCnr)
+
 	// 5. Then check if request sender has rights to use this token.
 	var keySender neofsecdsa.PublicKey
 
@@ -254,6 +351,8 @@ func isValidBearer(reqInfo v2.RequestInfo, st netmap.State) error {
 		return errBearerInvalidOwner
 	}
 
+	trace.Addf("request sender %s may use the bearer token", usrSender)
+
 	return nil
 }
 
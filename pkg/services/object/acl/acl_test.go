@@ -1,13 +1,16 @@
 package acl
 
 import (
+	"crypto/ecdsa"
 	"testing"
 
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	v2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/v2"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
@@ -88,3 +91,90 @@ func TestStickyCheck(t *testing.T) {
 		assertFn(false, true, true, true)
 	})
 }
+
+func TestExplain(t *testing.T) {
+	var info v2.RequestInfo
+
+	info.SetBasicACL(acl.PublicRWExtended)
+	info.SetRequestRole(acl.RoleOthers)
+	info.SetOperation(acl.OpObjectGet)
+	info.SetContainerID(cidtest.ID())
+	info.SetContainerOwner(*usertest.ID())
+
+	newPrm := func(basicACL acl.Basic, table *eaclSDK.Table) (ExplainPrm, *v2.Trace) {
+		info := info
+		info.SetBasicACL(basicACL)
+
+		trace := new(v2.Trace)
+		info.SetTrace(trace)
+
+		var prm ExplainPrm
+		prm.SetRequestInfo(info)
+		prm.SetEACL(table)
+
+		return prm, trace
+	}
+
+	t.Run("basic ACL", func(t *testing.T) {
+		prm, trace := newPrm(acl.Private, nil)
+
+		require.ErrorIs(t, Explain(prm), errBasicACLDenied)
+		require.Len(t, trace.Steps(), 1)
+	})
+
+	t.Run("no eACL", func(t *testing.T) {
+		prm, trace := newPrm(acl.PublicRWExtended, nil)
+
+		require.NoError(t, Explain(prm))
+		require.Contains(t, trace.Steps(), "container has no eACL table")
+	})
+
+	t.Run("final basic ACL", func(t *testing.T) {
+		prm, trace := newPrm(acl.PublicRW, eaclSDK.NewTable())
+
+		require.NoError(t, Explain(prm))
+		require.Contains(t, trace.Steps(), "basic ACL is final, eACL is not checked")
+	})
+
+	t.Run("eACL", func(t *testing.T) {
+		table := eaclSDK.NewTable()
+
+		r := eaclSDK.CreateRecord(eaclSDK.ActionDeny, eaclSDK.OperationGet)
+		eaclSDK.AddFormedTarget(r, eaclSDK.RoleOthers)
+		table.AddRecord(r)
+
+		prm, trace := newPrm(acl.PublicRWExtended, table)
+
+		require.ErrorIs(t, Explain(prm), errEACLDeniedByRule)
+
+		steps := trace.Steps()
+		require.Equal(t, "eACL record #0 determines action DENY", steps[len(steps)-1])
+	})
+
+	t.Run("sticky bit", func(t *testing.T) {
+		pk, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		var sender user.ID
+		user.IDFromKey(&sender, (ecdsa.PublicKey)(*pk.PublicKey()))
+
+		basicACL := acl.PublicRWExtended
+		basicACL.MakeSticky()
+
+		prm, trace := newPrm(basicACL, nil)
+
+		info := prm.info
+		info.SetOperation(acl.OpObjectPut)
+		info.SetSenderKey(pk.PublicKey().Bytes())
+		prm.SetRequestInfo(info)
+
+		require.NoError(t, Explain(prm))
+		require.Contains(t, trace.Steps(), "sticky bit is set, object owner is not specified, sticky bit is not checked")
+
+		prm.SetObjectOwner(*usertest.ID())
+		require.ErrorIs(t, Explain(prm), errStickyDenied)
+
+		prm.SetObjectOwner(sender)
+		require.NoError(t, Explain(prm))
+	})
+}
@@ -0,0 +1,242 @@
+package eacl
+
+import (
+	"bytes"
+	"fmt"
+
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+)
+
+// RecordResult describes the result of the eACL record evaluation.
+type RecordResult uint8
+
+const (
+	// RecordOperationMismatch means that the record is for another operation.
+	RecordOperationMismatch RecordResult = iota
+	// RecordTargetMismatch means that the record targets neither the role
+	// nor the key of the request sender.
+	RecordTargetMismatch
+	// RecordFiltersMismatch means that at least one filter of the record
+	// matches no header.
+	RecordFiltersMismatch
+	// RecordHeadersMissing means that the headers of at least one filter
+	// of the record can't be obtained. Evaluation stops at such record and
+	// the request is allowed.
+	RecordHeadersMissing
+	// RecordMatched means that the record determines the action.
+	RecordMatched
+)
+
+// String implements fmt.Stringer.
+func (r RecordResult) String() string {
+	switch r {
+	case RecordOperationMismatch:
+		return "operation mismatch"
+	case RecordTargetMismatch:
+		return "target mismatch"
+	case RecordFiltersMismatch:
+		return "filters mismatch"
+	case RecordHeadersMissing:
+		return "headers are missing"
+	case RecordMatched:
+		return "matched"
+	default:
+		return fmt.Sprintf("UNKNOWN#%d", r)
+	}
+}
+
+// RecordTrace describes the evaluation of the single eACL record.
+type RecordTrace struct {
+	// Index is an index of the record in the table.
+	Index int
+	// Record is the evaluated record.
+	Record eaclSDK.Record
+	// Result is the evaluation result.
+	Result RecordResult
+	// Filter is the first filter which matches no header or which headers
+	// are missing. Set for RecordFiltersMismatch and RecordHeadersMissing
+	// results only.
+	Filter *eaclSDK.Filter
+}
+
+// String implements fmt.Stringer.
+func (r RecordTrace) String() string {
+	s := fmt.Sprintf("eACL record #%d (%s %s): %s", r.Index, r.Record.Action(), r.Record.Operation(), r.Result)
+	if r.Filter != nil {
+		s += ", filter " + filterString(*r.Filter)
+	}
+
+	return s
+}
+
+// TracePrm groups parameters of TraceRecords.
+type TracePrm struct {
+	role eaclSDK.Role
+	op   eaclSDK.Operation
+	key  []byte
+	hdrs eaclSDK.TypedHeaderSource
+	tbl  *eaclSDK.Table
+}
+
+// SetRole sets eACL role of the request sender.
+func (p *TracePrm) SetRole(role eaclSDK.Role) {
+	p.role = role
+}
+
+// SetOperation sets requested operation.
+func (p *TracePrm) SetOperation(op eaclSDK.Operation) {
+	p.op = op
+}
+
+// SetSenderKey sets binary public key of the request sender.
+func (p *TracePrm) SetSenderKey(key []byte) {
+	p.key = key
+}
+
+// SetHeaderSource sets source of the request and object headers.
+func (p *TracePrm) SetHeaderSource(hdrs eaclSDK.TypedHeaderSource) {
+	p.hdrs = hdrs
+}
+
+// SetTable sets eACL table to evaluate.
+func (p *TracePrm) SetTable(tbl *eaclSDK.Table) {
+	p.tbl = tbl
+}
+
+// TraceRecords evaluates the records of the eACL table in the same way
+// eaclSDK.Validator does and returns the evaluation results of all the
+// records up to the one which determines the action.
+//
+// Returned action and flag are the same as eaclSDK.Validator.CalculateAction
+// returns for the same input.
+func TraceRecords(prm TracePrm) ([]RecordTrace, eaclSDK.Action, bool) {
+	records := prm.tbl.Records()
+	res := make([]RecordTrace, 0, len(records))
+
+	for i := range records {
+		tr := RecordTrace{
+			Index:  i,
+			Record: records[i],
+		}
+
+		switch {
+		case records[i].Operation() != prm.op:
+			tr.Result = RecordOperationMismatch
+		case !targetMatches(records[i], prm.role, prm.key):
+			tr.Result = RecordTargetMismatch
+		default:
+			tr.Result, tr.Filter = matchFilters(prm.hdrs, records[i].Filters())
+		}
+
+		res = append(res, tr)
+
+		switch tr.Result {
+		case RecordHeadersMissing:
+			return res, eaclSDK.ActionAllow, false
+		case RecordMatched:
+			return res, records[i].Action(), true
+		}
+	}
+
+	return res, eaclSDK.ActionAllow, false
+}
+
+func targetMatches(record eaclSDK.Record, role eaclSDK.Role, key []byte) bool {
+	for _, target := range record.Targets() {
+		if pubs := target.BinaryKeys(); len(pubs) != 0 {
+			for i := range pubs {
+				if bytes.Equal(pubs[i], key) {
+					return true
+				}
+			}
+
+			continue
+		}
+
+		if target.Role() == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchFilters(hdrSrc eaclSDK.TypedHeaderSource, filters []eaclSDK.Filter) (RecordResult, *eaclSDK.Filter) {
+	var mismatched *eaclSDK.Filter
+
+	for i := range filters {
+		headers, ok := hdrSrc.HeadersOfType(filters[i].From())
+		if !ok {
+			return RecordHeadersMissing, &filters[i]
+		}
+
+		if mismatched == nil && !headersMatch(headers, filters[i]) {
+			mismatched = &filters[i]
+		}
+	}
+
+	if mismatched != nil {
+		return RecordFiltersMismatch, mismatched
+	}
+
+	return RecordMatched, nil
+}
+
+// headersMatch checks whether at least one header matches the filter. The
+// filter is evaluated by eaclSDK.Validator within a single-record table, so
+// the set of supported matchers is the same as for the access check.
+func headersMatch(headers []eaclSDK.Header, filter eaclSDK.Filter) bool {
+	record := eaclSDK.CreateRecord(eaclSDK.ActionDeny, eaclSDK.OperationGet)
+	eaclSDK.AddFormedTarget(record, eaclSDK.RoleOthers)
+	record.AddFilter(filter.From(), filter.Matcher(), filter.Key(), filter.Value())
+
+	table := eaclSDK.NewTable()
+	table.AddRecord(record)
+
+	_, matched := eaclSDK.NewValidator().CalculateAction(new(eaclSDK.ValidationUnit).
+		WithRole(eaclSDK.RoleOthers).
+		WithOperation(eaclSDK.OperationGet).
+		WithHeaderSource(filterHeaders{typ: filter.From(), headers: headers}).
+		WithEACLTable(table))
+
+	return matched
+}
+
+// filterHeaders is eaclSDK.TypedHeaderSource providing the headers of
+// the single type.
+type filterHeaders struct {
+	typ     eaclSDK.FilterHeaderType
+	headers []eaclSDK.Header
+}
+
+func (h filterHeaders) HeadersOfType(typ eaclSDK.FilterHeaderType) ([]eaclSDK.Header, bool) {
+	if typ != h.typ {
+		return nil, true
+	}
+
+	return h.headers, true
+}
+
+func filterString(f eaclSDK.Filter) string {
+	var from, match string
+
+	switch f.From() {
+	case eaclSDK.HeaderFromObject:
+		from = "obj"
+	case eaclSDK.HeaderFromRequest:
+		from = "req"
+	default:
+		from = f.From().String()
+	}
+
+	switch f.Matcher() {
+	case eaclSDK.MatchStringEqual:
+		match = "="
+	case eaclSDK.MatchStringNotEqual:
+		match = "!="
+	default:
+		match = " " + f.Matcher().String() + " "
+	}
+
+	return from + ":" + f.Key() + match + f.Value()
+}
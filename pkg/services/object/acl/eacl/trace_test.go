@@ -0,0 +1,184 @@
+package eacl
+
+import (
+	"testing"
+
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/stretchr/testify/require"
+)
+
+type testHeader struct {
+	key, value string
+}
+
+func (h testHeader) Key() string {
+	return h.key
+}
+
+func (h testHeader) Value() string {
+	return h.value
+}
+
+type testHeaderSource struct {
+	obj, req []eaclSDK.Header
+	noObj    bool
+}
+
+func (s testHeaderSource) HeadersOfType(typ eaclSDK.FilterHeaderType) ([]eaclSDK.Header, bool) {
+	switch typ {
+	case eaclSDK.HeaderFromObject:
+		return s.obj, !s.noObj
+	case eaclSDK.HeaderFromRequest:
+		return s.req, true
+	default:
+		return nil, true
+	}
+}
+
+func newRecord(a eaclSDK.Action, op eaclSDK.Operation, role eaclSDK.Role) *eaclSDK.Record {
+	r := eaclSDK.CreateRecord(a, op)
+
+	var tgt eaclSDK.Target
+	tgt.SetRole(role)
+
+	r.SetTargets(tgt)
+
+	return r
+}
+
+func TestTraceRecords(t *testing.T) {
+	key := []byte{1, 2, 3}
+
+	table := eaclSDK.NewTable()
+
+	table.AddRecord(newRecord(eaclSDK.ActionDeny, eaclSDK.OperationPut, eaclSDK.RoleOthers))
+	table.AddRecord(newRecord(eaclSDK.ActionDeny, eaclSDK.OperationGet, eaclSDK.RoleUser))
+
+	r := newRecord(eaclSDK.ActionAllow, eaclSDK.OperationGet, eaclSDK.RoleOthers)
+	r.AddObjectAttributeFilter(eaclSDK.MatchStringEqual, "Type", "public")
+	table.AddRecord(r)
+
+	r = eaclSDK.CreateRecord(eaclSDK.ActionAllow, eaclSDK.OperationGet)
+	var tgt eaclSDK.Target
+	tgt.SetBinaryKeys([][]byte{key})
+	r.SetTargets(tgt)
+	r.AddFilter(eaclSDK.HeaderFromRequest, eaclSDK.MatchStringNotEqual, "X-Tag", "secret")
+	table.AddRecord(r)
+
+	table.AddRecord(newRecord(eaclSDK.ActionDeny, eaclSDK.OperationGet, eaclSDK.RoleOthers))
+
+	validator := eaclSDK.NewValidator()
+
+	check := func(t *testing.T, hdrs testHeaderSource, key []byte, expected ...RecordResult) {
+		var prm TracePrm
+		prm.SetRole(eaclSDK.RoleOthers)
+		prm.SetOperation(eaclSDK.OperationGet)
+		prm.SetSenderKey(key)
+		prm.SetHeaderSource(hdrs)
+		prm.SetTable(table)
+
+		records, action, matched := TraceRecords(prm)
+
+		expAction, expMatched := validator.CalculateAction(new(eaclSDK.ValidationUnit).
+			WithRole(eaclSDK.RoleOthers).
+			WithOperation(eaclSDK.OperationGet).
+			WithSenderKey(key).
+			WithHeaderSource(hdrs).
+			WithEACLTable(table))
+
+		require.Equal(t, expAction, action)
+		require.Equal(t, expMatched, matched)
+		require.Len(t, records, len(expected))
+
+		for i := range expected {
+			require.Equal(t, i, records[i].Index)
+			require.Equal(t, expected[i], records[i].Result, records[i].String())
+		}
+	}
+
+	t.Run("attribute filter", func(t *testing.T) {
+		check(t, testHeaderSource{obj: []eaclSDK.Header{testHeader{"Type", "public"}}}, nil,
+			RecordOperationMismatch, RecordTargetMismatch, RecordMatched)
+	})
+
+	t.Run("key target", func(t *testing.T) {
+		check(t, testHeaderSource{req: []eaclSDK.Header{testHeader{"X-Tag", "other"}}}, key,
+			RecordOperationMismatch, RecordTargetMismatch, RecordFiltersMismatch, RecordMatched)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		check(t, testHeaderSource{req: []eaclSDK.Header{testHeader{"X-Tag", "secret"}}}, key,
+			RecordOperationMismatch, RecordTargetMismatch, RecordFiltersMismatch, RecordFiltersMismatch, RecordMatched)
+	})
+
+	t.Run("missing headers", func(t *testing.T) {
+		check(t, testHeaderSource{noObj: true}, nil,
+			RecordOperationMismatch, RecordTargetMismatch, RecordHeadersMissing)
+	})
+}
+
+func TestTraceRecordsValidatorAgreement(t *testing.T) {
+	key := []byte{1, 2, 3}
+
+	matchers := []eaclSDK.Match{
+		eaclSDK.MatchUnknown,
+		eaclSDK.MatchStringEqual,
+		eaclSDK.MatchStringNotEqual,
+		eaclSDK.MatchStringNotEqual + 1,
+	}
+
+	headerSets := []testHeaderSource{
+		{},
+		{noObj: true},
+		{obj: []eaclSDK.Header{nil, testHeader{"Type", "public"}}},
+		{obj: []eaclSDK.Header{testHeader{"Type", "private"}}, req: []eaclSDK.Header{testHeader{"X-Tag", "secret"}}},
+		{obj: []eaclSDK.Header{testHeader{"Type", "public"}, testHeader{"Type", "private"}}, req: []eaclSDK.Header{testHeader{"X-Tag", "other"}}},
+	}
+
+	validator := eaclSDK.NewValidator()
+
+	for _, m1 := range matchers {
+		for _, m2 := range matchers {
+			table := eaclSDK.NewTable()
+
+			r := newRecord(eaclSDK.ActionDeny, eaclSDK.OperationGet, eaclSDK.RoleOthers)
+			r.AddObjectAttributeFilter(m1, "Type", "public")
+			table.AddRecord(r)
+
+			r = eaclSDK.CreateRecord(eaclSDK.ActionAllow, eaclSDK.OperationGet)
+			var tgt eaclSDK.Target
+			tgt.SetBinaryKeys([][]byte{key})
+			r.SetTargets(tgt)
+			r.AddFilter(eaclSDK.HeaderFromRequest, m2, "X-Tag", "secret")
+			r.AddObjectAttributeFilter(m1, "Type", "private")
+			table.AddRecord(r)
+
+			r = newRecord(eaclSDK.ActionAllow, eaclSDK.OperationGet, eaclSDK.RoleOthers)
+			r.AddFilter(eaclSDK.HeaderFromRequest, m2, "X-Tag", "other")
+			table.AddRecord(r)
+
+			for i, hdrs := range headerSets {
+				for _, sender := range [][]byte{nil, key} {
+					var prm TracePrm
+					prm.SetRole(eaclSDK.RoleOthers)
+					prm.SetOperation(eaclSDK.OperationGet)
+					prm.SetSenderKey(sender)
+					prm.SetHeaderSource(hdrs)
+					prm.SetTable(table)
+
+					_, action, matched := TraceRecords(prm)
+
+					expAction, expMatched := validator.CalculateAction(new(eaclSDK.ValidationUnit).
+						WithRole(eaclSDK.RoleOthers).
+						WithOperation(eaclSDK.OperationGet).
+						WithSenderKey(sender).
+						WithHeaderSource(hdrs).
+						WithEACLTable(table))
+
+					require.Equal(t, expAction, action, "matchers %s/%s, headers #%d, key %v", m1, m2, i, sender)
+					require.Equal(t, expMatched, matched, "matchers %s/%s, headers #%d, key %v", m1, m2, i, sender)
+				}
+			}
+		}
+	}
+}
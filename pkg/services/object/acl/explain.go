@@ -0,0 +1,124 @@
+package acl
+
+import (
+	"errors"
+
+	v2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/v2"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	eaclSDK "github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+var (
+	errBasicACLDenied = errors.New("denied by basic ACL")
+	errStickyDenied   = errors.New("denied by sticky bit")
+)
+
+// ExplainPrm groups parameters of Explain.
+type ExplainPrm struct {
+	info    v2.RequestInfo
+	table   *eaclSDK.Table
+	headers eaclSDK.TypedHeaderSource
+	epoch   uint64
+	owner   *user.ID
+}
+
+// SetRequestInfo sets information about the checked request. Steps of
+// the check are written to its trace.
+func (p *ExplainPrm) SetRequestInfo(info v2.RequestInfo) {
+	p.info = info
+}
+
+// SetEACL sets eACL table of the container. Nil means that the container
+// has no eACL table.
+func (p *ExplainPrm) SetEACL(table *eaclSDK.Table) {
+	p.table = table
+}
+
+// SetHeaderSource sets source of the request and object headers which are
+// matched against eACL filters.
+func (p *ExplainPrm) SetHeaderSource(headers eaclSDK.TypedHeaderSource) {
+	p.headers = headers
+}
+
+// SetObjectOwner sets the owner of the put object which is checked
+// against the sender if the sticky bit is set.
+func (p *ExplainPrm) SetObjectOwner(owner user.ID) {
+	p.owner = &owner
+}
+
+// SetCurrentEpoch sets the epoch to check bearer token lifetime at.
+func (p *ExplainPrm) SetCurrentEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
+type epochState uint64
+
+func (x epochState) CurrentEpoch() uint64 {
+	return uint64(x)
+}
+
+type emptyHeaderSource struct{}
+
+func (emptyHeaderSource) HeadersOfType(eaclSDK.FilterHeaderType) ([]eaclSDK.Header, bool) {
+	return nil, true
+}
+
+// Explain checks the request against the basic ACL, the eACL table and the
+// bearer token in the same way Checker does, but without network and local
+// storage access: all the data are taken from the parameters. Sticky bit is
+// checked for PUT requests only if the object owner is set, otherwise it is
+// reported as not checked.
+//
+// Returns nil if the access is allowed.
+func Explain(prm ExplainPrm) error {
+	c := Checker{
+		validator: eaclSDK.NewValidator(),
+		state:     epochState(prm.epoch),
+	}
+
+	if !c.CheckBasicACL(prm.info) {
+		return errBasicACLDenied
+	}
+
+	if err := explainStickyBit(&c, prm); err != nil {
+		return err
+	}
+
+	return c.checkEACL(prm.info, func() (*eaclSDK.Table, error) {
+		if prm.table == nil {
+			return nil, apistatus.EACLNotFound{}
+		}
+
+		return prm.table, nil
+	}, func() (eaclSDK.TypedHeaderSource, error) {
+		if prm.headers == nil {
+			return emptyHeaderSource{}, nil
+		}
+
+		return prm.headers, nil
+	})
+}
+
+// explainStickyBit checks the sticky bit in the same way the Put service does.
+func explainStickyBit(c *Checker, prm ExplainPrm) error {
+	info := prm.info
+
+	if info.Operation() != acl.OpObjectPut || !info.BasicACL().Sticky() || info.RequestRole() == acl.RoleContainer {
+		return nil
+	}
+
+	if prm.owner == nil {
+		info.Trace().Addf("sticky bit is set, object owner is not specified, sticky bit is not checked")
+		return nil
+	}
+
+	if !c.StickyBitCheck(info, *prm.owner) {
+		return errStickyDenied
+	}
+
+	info.Trace().Addf("sticky bit is set, object owner %s is the sender", prm.owner)
+
+	return nil
+}
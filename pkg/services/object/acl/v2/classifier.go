@@ -26,7 +26,8 @@ type classifyResult struct {
 func (c senderClassifier) classify(
 	req MetaWithToken,
 	idCnr cid.ID,
-	cnr container.Container) (res *classifyResult, err error) {
+	cnr container.Container,
+	trace *Trace) (res *classifyResult, err error) {
 	ownerID, ownerKey, err := req.RequestOwner()
 	if err != nil {
 		return nil, err
@@ -34,10 +35,18 @@ func (c senderClassifier) classify(
 
 	ownerKeyInBytes := ownerKey.Bytes()
 
+	if req.token != nil {
+		trace.Addf("sender %s is the issuer of the session token", ownerID)
+	} else {
+		trace.Addf("sender %s is the signer of the request body", ownerID)
+	}
+
 	// TODO: #767 get owner from neofs.id if present
 
 	// if request owner is the same as container owner, return RoleUser
 	if ownerID.Equals(cnr.Owner()) {
+		trace.Addf("sender is the container owner, role %s", acl.RoleOwner)
+
 		return &classifyResult{
 			role: acl.RoleOwner,
 			key:  ownerKeyInBytes,
@@ -49,7 +58,10 @@ func (c senderClassifier) classify(
 		// do not throw error, try best case matching
 		c.log.Debug("can't check if request from inner ring",
 			zap.String("error", err.Error()))
+		trace.Addf("can't check if sender is an Inner Ring node: %v", err)
 	} else if isInnerRingNode {
+		trace.Addf("sender is an Inner Ring node, role %s", acl.RoleInnerRing)
+
 		return &classifyResult{
 			role: acl.RoleInnerRing,
 			key:  ownerKeyInBytes,
@@ -66,13 +78,18 @@ func (c senderClassifier) classify(
 		// do not throw error, try best case matching
 		c.log.Debug("can't check if request from container node",
 			zap.String("error", err.Error()))
+		trace.Addf("can't check if sender is a container node: %v", err)
 	} else if isContainerNode {
+		trace.Addf("sender is a container node, role %s", acl.RoleContainer)
+
 		return &classifyResult{
 			role: acl.RoleContainer,
 			key:  ownerKeyInBytes,
 		}, nil
 	}
 
+	trace.Addf("sender is neither the container owner nor a system node, role %s", acl.RoleOthers)
+
 	// if none of above, return RoleOthers
 	return &classifyResult{
 		role: acl.RoleOthers,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"go.uber.org/zap"
 )
 
 var (
@@ -18,16 +19,40 @@ var (
 const accessDeniedACLReasonFmt = "access to operation %s is denied by basic ACL check"
 const accessDeniedEACLReasonFmt = "access to operation %s is denied by extended ACL check: %v"
 
-func basicACLErr(info RequestInfo) error {
+func basicACLErr(log *zap.Logger, info RequestInfo) error {
+	logDenied(log, info, nil)
+
 	var errAccessDenied apistatus.ObjectAccessDenied
 	errAccessDenied.WriteReason(fmt.Sprintf(accessDeniedACLReasonFmt, info.operation))
 
 	return errAccessDenied
 }
 
-func eACLErr(info RequestInfo, err error) error {
+func eACLErr(log *zap.Logger, info RequestInfo, err error) error {
+	logDenied(log, info, err)
+
 	var errAccessDenied apistatus.ObjectAccessDenied
 	errAccessDenied.WriteReason(fmt.Sprintf(accessDeniedEACLReasonFmt, info.operation, err))
 
 	return errAccessDenied
 }
+
+// logDenied writes the access check trace of the denied request to the
+// debug log.
+func logDenied(log *zap.Logger, info RequestInfo, err error) {
+	if info.trace == nil {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.Stringer("operation", info.operation),
+		zap.Stringer("container", info.idCnr),
+		zap.Array("trace", info.trace),
+	}
+
+	if err != nil {
+		fields = append(fields, zap.String("error", err.Error()))
+	}
+
+	log.Debug("access denied", fields...)
+}
@@ -33,6 +33,8 @@ type RequestInfo struct {
 	bearer *bearer.Token // bearer token of request
 
 	srcRequest interface{}
+
+	trace *Trace
 }
 
 func (r *RequestInfo) SetBasicACL(basicACL acl.Basic) {
@@ -47,6 +49,37 @@ func (r *RequestInfo) SetSenderKey(senderKey []byte) {
 	r.senderKey = senderKey
 }
 
+func (r *RequestInfo) SetOperation(op acl.Op) {
+	r.operation = op
+}
+
+func (r *RequestInfo) SetContainerOwner(owner user.ID) {
+	r.cnrOwner = owner
+}
+
+func (r *RequestInfo) SetContainerID(id cid.ID) {
+	r.idCnr = id
+}
+
+func (r *RequestInfo) SetObjectID(id *oid.ID) {
+	r.obj = id
+}
+
+func (r *RequestInfo) SetBearer(tok *bearer.Token) {
+	r.bearer = tok
+}
+
+// SetTrace sets the trace of the request access check.
+func (r *RequestInfo) SetTrace(trace *Trace) {
+	r.trace = trace
+}
+
+// Trace returns the trace of the request access check.
+// Returns nil if the check is not traced.
+func (r RequestInfo) Trace() *Trace {
+	return r.trace
+}
+
 // Request returns raw API request.
 func (r RequestInfo) Request() interface{} {
 	return r.srcRequest
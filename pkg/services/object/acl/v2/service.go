@@ -14,6 +14,7 @@ import (
 	sessionSDK "github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // Service checks basic ACL rules.
@@ -29,6 +30,8 @@ type putStreamBasicChecker struct {
 }
 
 type getStreamBasicChecker struct {
+	log *zap.Logger
+
 	checker ACLChecker
 
 	object.GetObjectStream
@@ -37,6 +40,8 @@ type getStreamBasicChecker struct {
 }
 
 type rangeStreamBasicChecker struct {
+	log *zap.Logger
+
 	checker ACLChecker
 
 	object.GetObjectRangeStream
@@ -45,6 +50,8 @@ type rangeStreamBasicChecker struct {
 }
 
 type searchStreamBasicChecker struct {
+	log *zap.Logger
+
 	checker ACLChecker
 
 	object.SearchStream
@@ -143,12 +150,13 @@ func (b Service) Get(request *objectV2.GetRequest, stream object.GetObjectStream
 	useObjectIDFromSession(&reqInfo, sTok)
 
 	if !b.checker.CheckBasicACL(reqInfo) {
-		return basicACLErr(reqInfo)
+		return basicACLErr(b.log, reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return eACLErr(reqInfo, err)
+		return eACLErr(b.log, reqInfo, err)
 	}
 
 	return b.next.Get(request, &getStreamBasicChecker{
+		log:             b.log,
 		GetObjectStream: stream,
 		info:            reqInfo,
 		checker:         b.checker,
@@ -202,15 +210,15 @@ func (b Service) Head(
 	useObjectIDFromSession(&reqInfo, sTok)
 
 	if !b.checker.CheckBasicACL(reqInfo) {
-		return nil, basicACLErr(reqInfo)
+		return nil, basicACLErr(b.log, reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return nil, eACLErr(reqInfo, err)
+		return nil, eACLErr(b.log, reqInfo, err)
 	}
 
 	resp, err := b.next.Head(ctx, request)
 	if err == nil {
 		if err = b.checker.CheckEACL(resp, reqInfo); err != nil {
-			err = eACLErr(reqInfo, err)
+			err = eACLErr(b.log, reqInfo, err)
 		}
 	}
 
@@ -251,12 +259,13 @@ func (b Service) Search(request *objectV2.SearchRequest, stream object.SearchStr
 	}
 
 	if !b.checker.CheckBasicACL(reqInfo) {
-		return basicACLErr(reqInfo)
+		return basicACLErr(b.log, reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return eACLErr(reqInfo, err)
+		return eACLErr(b.log, reqInfo, err)
 	}
 
 	return b.next.Search(request, &searchStreamBasicChecker{
+		log:          b.log,
 		checker:      b.checker,
 		SearchStream: stream,
 		info:         reqInfo,
@@ -301,9 +310,9 @@ func (b Service) Delete(
 	useObjectIDFromSession(&reqInfo, sTok)
 
 	if !b.checker.CheckBasicACL(reqInfo) {
-		return nil, basicACLErr(reqInfo)
+		return nil, basicACLErr(b.log, reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return nil, eACLErr(reqInfo, err)
+		return nil, eACLErr(b.log, reqInfo, err)
 	}
 
 	return b.next.Delete(ctx, request)
@@ -344,12 +353,13 @@ func (b Service) GetRange(request *objectV2.GetRangeRequest, stream object.GetOb
 	useObjectIDFromSession(&reqInfo, sTok)
 
 	if !b.checker.CheckBasicACL(reqInfo) {
-		return basicACLErr(reqInfo)
+		return basicACLErr(b.log, reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return eACLErr(reqInfo, err)
+		return eACLErr(b.log, reqInfo, err)
 	}
 
 	return b.next.GetRange(request, &rangeStreamBasicChecker{
+		log:                  b.log,
 		checker:              b.checker,
 		GetObjectRangeStream: stream,
 		info:                 reqInfo,
@@ -394,9 +404,9 @@ func (b Service) GetRangeHash(
 	useObjectIDFromSession(&reqInfo, sTok)
 
 	if !b.checker.CheckBasicACL(reqInfo) {
-		return nil, basicACLErr(reqInfo)
+		return nil, basicACLErr(b.log, reqInfo)
 	} else if err := b.checker.CheckEACL(request, reqInfo); err != nil {
-		return nil, eACLErr(reqInfo, err)
+		return nil, eACLErr(b.log, reqInfo, err)
 	}
 
 	return b.next.GetRangeHash(ctx, request)
@@ -463,9 +473,9 @@ func (p putStreamBasicChecker) Send(request *objectV2.PutRequest) error {
 		useObjectIDFromSession(&reqInfo, sTok)
 
 		if !p.source.checker.CheckBasicACL(reqInfo) || !p.source.checker.StickyBitCheck(reqInfo, idOwner) {
-			return basicACLErr(reqInfo)
+			return basicACLErr(p.source.log, reqInfo)
 		} else if err := p.source.checker.CheckEACL(request, reqInfo); err != nil {
-			return eACLErr(reqInfo, err)
+			return eACLErr(p.source.log, reqInfo, err)
 		}
 	}
 
@@ -479,7 +489,7 @@ func (p putStreamBasicChecker) CloseAndRecv() (*objectV2.PutResponse, error) {
 func (g *getStreamBasicChecker) Send(resp *objectV2.GetResponse) error {
 	if _, ok := resp.GetBody().GetObjectPart().(*objectV2.GetObjectPartInit); ok {
 		if err := g.checker.CheckEACL(resp, g.info); err != nil {
-			return eACLErr(g.info, err)
+			return eACLErr(g.log, g.info, err)
 		}
 	}
 
@@ -488,7 +498,7 @@ func (g *getStreamBasicChecker) Send(resp *objectV2.GetResponse) error {
 
 func (g *rangeStreamBasicChecker) Send(resp *objectV2.GetRangeResponse) error {
 	if err := g.checker.CheckEACL(resp, g.info); err != nil {
-		return eACLErr(g.info, err)
+		return eACLErr(g.log, g.info, err)
 	}
 
 	return g.GetObjectRangeStream.Send(resp)
@@ -496,7 +506,7 @@ func (g *rangeStreamBasicChecker) Send(resp *objectV2.GetRangeResponse) error {
 
 func (g *searchStreamBasicChecker) Send(resp *objectV2.SearchResponse) error {
 	if err := g.checker.CheckEACL(resp, g.info); err != nil {
-		return eACLErr(g.info, err)
+		return eACLErr(g.log, g.info, err)
 	}
 
 	return g.SearchStream.Send(resp)
@@ -523,8 +533,12 @@ func (b Service) findRequestInfo(req MetaWithToken, idCnr cid.ID, op acl.Op) (in
 		}
 	}
 
+	if b.log.Core().Enabled(zapcore.DebugLevel) {
+		info.trace = new(Trace)
+	}
+
 	// find request role and key
-	res, err := b.c.classify(req, idCnr, cnr.Value)
+	res, err := b.c.classify(req, idCnr, cnr.Value, info.trace)
 	if err != nil {
 		return info, err
 	}
@@ -0,0 +1,47 @@
+package v2
+
+import (
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// maxTraceSteps limits the number of steps kept by Trace, object streams
+// may be checked many times.
+const maxTraceSteps = 256
+
+// Trace collects the steps of the request access check: classification of
+// the sender, basic ACL, bearer token and eACL checks. It explains why the
+// request has been allowed or denied.
+//
+// Nil Trace discards all the steps.
+type Trace struct {
+	steps []string
+}
+
+// Addf formats and appends the check step to the trace.
+func (t *Trace) Addf(format string, args ...interface{}) {
+	if t == nil || len(t.steps) >= maxTraceSteps {
+		return
+	}
+
+	t.steps = append(t.steps, fmt.Sprintf(format, args...))
+}
+
+// Steps returns the steps of the check in the order they have been added.
+func (t *Trace) Steps() []string {
+	if t == nil {
+		return nil
+	}
+
+	return t.steps
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler.
+func (t *Trace) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, s := range t.Steps() {
+		enc.AppendString(s)
+	}
+
+	return nil
+}
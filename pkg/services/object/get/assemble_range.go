@@ -0,0 +1,129 @@
+package getsvc
+
+import (
+	"errors"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// payloadPart is a part of the requested payload of the split object
+// stored in the particular child object.
+type payloadPart struct {
+	id  oid.ID
+	rng objectSDK.Range
+}
+
+// partsFromChain collects the parts of the requested payload going from
+// the last child to the first one. Only the headers of the children are
+// requested, the walk stops when the beginning of the payload is reached.
+func (exec *execCtx) partsFromChain(lastID oid.ID) ([]payloadPart, bool) {
+	child, ok := exec.headChild(lastID)
+	if !ok {
+		return nil, false
+	}
+
+	from, to, ok := exec.initParent(child)
+	if !ok {
+		return nil, false
+	}
+
+	var (
+		id    = lastID
+		off   = exec.collectedObject.PayloadSize()
+		parts []payloadPart
+	)
+
+	for {
+		sz := child.PayloadSize()
+		if sz > off {
+			break
+		}
+
+		off -= sz
+		parts = appendPayloadPart(parts, id, off, sz, from, to)
+
+		if off <= from {
+			// reverse parts collected end-to-start
+			for left, right := 0, len(parts)-1; left < right; left, right = left+1, right-1 {
+				parts[left], parts[right] = parts[right], parts[left]
+			}
+
+			return parts, true
+		}
+
+		if id, ok = child.PreviousID(); !ok {
+			break
+		}
+
+		if child, ok = exec.headChild(id); !ok {
+			return nil, false
+		}
+	}
+
+	exec.inconsistentSplitChain()
+
+	return nil, false
+}
+
+// initParent sets the parent header from the child as the collected object
+// and returns the bounds of the requested payload.
+func (exec *execCtx) initParent(child *objectSDK.Object) (from, to uint64, ok bool) {
+	par := child.Parent()
+	if par == nil {
+		exec.status = statusUndefined
+		exec.err = errors.New("received child with empty parent")
+
+		exec.log.Debug("received child with empty parent")
+
+		return 0, 0, false
+	}
+
+	parSize := par.PayloadSize()
+
+	rng := exec.ctxRange()
+	if rng == nil {
+		to = parSize
+	} else {
+		from = rng.GetOffset()
+		to = from + rng.GetLength()
+
+		if to > parSize {
+			var errOutOfRange apistatus.ObjectOutOfRange
+
+			exec.err = errOutOfRange
+			exec.status = statusOutOfRange
+
+			return 0, 0, false
+		}
+	}
+
+	exec.collectedObject = par
+	exec.collectedObject.SetPayload(nil)
+
+	return from, to, true
+}
+
+// appendPayloadPart appends the intersection of the [from, to) range with the
+// child payload located at the specified offset of the parent payload.
+func appendPayloadPart(parts []payloadPart, id oid.ID, off, sz, from, to uint64) []payloadPart {
+	if sz == 0 || off+sz <= from || off >= to {
+		return parts
+	}
+
+	left, right := off, off+sz
+	if left < from {
+		left = from
+	}
+
+	if right > to {
+		right = to
+	}
+
+	part := payloadPart{id: id}
+	part.rng.SetOffset(left - off)
+	part.rng.SetLength(right - left)
+
+	return append(parts, part)
+}
@@ -0,0 +1,196 @@
+package getsvc
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasure"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// restoreErasureCoded restores the requested object from the parts stored on
+// the container nodes if the container objects are erasure-coded. Container
+// nodes are walked in the object placement order until the number of the
+// parts is enough to restore the object. Execution status is changed only
+// if the object is restored.
+func (exec *execCtx) restoreErasureCoded() {
+	if exec.isLocal() || exec.isRaw() || exec.svc.cnrSrc == nil {
+		return
+	}
+
+	cnr, err := exec.svc.cnrSrc.Get(exec.containerID())
+	if err != nil {
+		exec.log.Debug("could not get container to check erasure coding",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	rule, ok, err := erasure.ContainerRule(cnr.Value)
+	if err != nil {
+		exec.log.Debug("invalid erasure coding rule of the container",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	} else if !ok {
+		return
+	}
+
+	coder, err := erasure.NewCoder(rule.DataParts, rule.ParityParts)
+	if err != nil {
+		exec.log.Debug("could not create erasure coder",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	exec.log.Debug("trying to restore erasure-coded object...",
+		zap.Stringer("rule", rule),
+	)
+
+	parts, ok := exec.collectErasureParts(rule)
+	if !ok {
+		return
+	}
+
+	var obj *objectSDK.Object
+
+	if exec.headOnly() {
+		for i := range parts {
+			if parts[i] != nil {
+				obj, err = erasure.Header(parts[i])
+				break
+			}
+		}
+	} else {
+		obj, err = erasure.Restore(coder, parts)
+	}
+
+	if err != nil {
+		exec.log.Debug("could not restore erasure-coded object",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	if rng := exec.ctxRange(); rng != nil {
+		from, to := rng.GetOffset(), rng.GetOffset()+rng.GetLength()
+		if to < from || to > obj.PayloadSize() {
+			var errOutOfRange apistatus.ObjectOutOfRange
+
+			exec.status = statusOutOfRange
+			exec.err = errOutOfRange
+
+			return
+		}
+
+		obj.SetPayload(obj.Payload()[from:to])
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+	exec.collectedObject = obj
+	exec.writeCollectedObject()
+}
+
+// collectErasureParts reads the parts of the object from the local storage
+// and requests the rest from the container nodes. Parts are returned placed
+// by their indices. Returns false if there are less parts than needed to
+// restore the object.
+func (exec *execCtx) collectErasureParts(rule erasure.Rule) ([]*objectSDK.Object, bool) {
+	if ok := exec.initEpoch(); !ok {
+		return nil, false
+	}
+
+	traverser, ok := exec.generateTraverser(exec.address())
+	if !ok {
+		return nil, false
+	}
+
+	var (
+		id     = exec.address().Object()
+		parts  = make([]*objectSDK.Object, rule.Parts())
+		needed = rule.DataParts
+	)
+
+	if exec.headOnly() {
+		// header is stored in each part
+		needed = 1
+	}
+
+	// placement vectors don't contain the local node
+	localParts, err := exec.svc.localStorage.erasureParts(exec, id)
+	if err != nil {
+		exec.log.Debug("could not get erasure-coded parts from the local storage",
+			zap.String("error", err.Error()),
+		)
+	}
+
+	found := addErasureParts(exec, parts, id, localParts)
+
+	for found < needed {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			exec.log.Debug("not enough erasure-coded parts",
+				zap.Int("found", found),
+				zap.Int("needed", needed),
+			)
+
+			return nil, false
+		}
+
+		for i := 0; i < len(addrs) && found < needed; i++ {
+			var info client.NodeInfo
+
+			client.NodeInfoFromNetmapElement(&info, addrs[i])
+
+			c, err := exec.svc.clientCache.get(info)
+			if err != nil {
+				exec.log.Debug("could not construct remote node client",
+					zap.String("error", err.Error()),
+				)
+
+				continue
+			}
+
+			nodeParts, err := c.erasureParts(exec, info, id)
+			if err != nil {
+				exec.log.Debug("could not get erasure-coded parts from the node",
+					zap.String("error", err.Error()),
+				)
+
+				continue
+			}
+
+			found += addErasureParts(exec, parts, id, nodeParts)
+		}
+	}
+
+	return parts, true
+}
+
+// addErasureParts places the parts of the object by their indices and
+// returns the number of the new parts.
+func addErasureParts(exec *execCtx, parts []*objectSDK.Object, id oid.ID, newParts []*objectSDK.Object) int {
+	var added int
+
+	for _, part := range newParts {
+		partInfo, ok, err := erasure.ReadPartInfo(part)
+		if err != nil || !ok || !partInfo.Parent.Equals(id) || partInfo.Index >= len(parts) {
+			exec.log.Debug("invalid erasure-coded part received")
+			continue
+		}
+
+		if parts[partInfo.Index] == nil {
+			parts[partInfo.Index] = part
+			added++
+		}
+	}
+
+	return added
+}
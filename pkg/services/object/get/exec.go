@@ -36,8 +36,6 @@ type execCtx struct {
 
 	collectedObject *objectSDK.Object
 
-	curOff uint64
-
 	head bool
 
 	curProcEpoch uint64
@@ -189,35 +187,35 @@ func (exec *execCtx) generateTraverser(addr oid.Address) (*placement.Traverser,
 	}
 }
 
-func (exec *execCtx) getChild(id oid.ID, rng *objectSDK.Range, withHdr bool) (*objectSDK.Object, bool) {
-	w := NewSimpleObjectWriter()
+func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
+	exec.prm.common = exec.prm.common.WithLocalOnly(false)
 
-	p := exec.prm
-	p.common = p.common.WithLocalOnly(false)
-	p.objWriter = w
-	p.SetRange(rng)
+	child, err := exec.headChildObject(exec.context(), id)
 
-	p.addr.SetContainer(exec.containerID())
-	p.addr.SetObject(id)
-
-	exec.statusError = exec.svc.get(exec.context(), p.commonPrm, withPayloadRange(rng))
+	switch {
+	default:
+		exec.status = statusUndefined
+		exec.err = err
 
-	child := w.Object()
-	ok := exec.status == statusOK
+		exec.log.Debug("could not get child object header",
+			zap.Stringer("child ID", id),
+			zap.String("error", err.Error()),
+		)
 
-	if ok && withHdr && !exec.isChild(child) {
-		exec.status = statusUndefined
-		exec.err = errors.New("wrong child header")
+		return nil, false
+	case err == nil:
+		exec.status = statusOK
+		exec.err = nil
 
-		exec.log.Debug("parent address in child object differs")
+		return child, true
 	}
-
-	return child, ok
 }
 
-func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
+// headChildObject reads the header of the child object. It does not change
+// the execution status, so it can be called concurrently. Common parameters
+// must not be local-only.
+func (exec *execCtx) headChildObject(ctx context.Context, id oid.ID) (*objectSDK.Object, error) {
 	p := exec.prm
-	p.common = p.common.WithLocalOnly(false)
 	p.addr.SetContainer(exec.containerID())
 	p.addr.SetObject(id)
 
@@ -228,33 +226,18 @@ func (exec *execCtx) headChild(id oid.ID) (*objectSDK.Object, bool) {
 	w := NewSimpleObjectWriter()
 	prm.SetHeaderWriter(w)
 
-	err := exec.svc.Head(exec.context(), prm)
-
-	switch {
-	default:
-		exec.status = statusUndefined
-		exec.err = err
-
-		exec.log.Debug("could not get child object header",
-			zap.Stringer("child ID", id),
-			zap.String("error", err.Error()),
-		)
-
-		return nil, false
-	case err == nil:
-		child := w.Object()
-
-		if _, ok := child.ParentID(); ok && !exec.isChild(child) {
-			exec.status = statusUndefined
+	err := exec.svc.Head(ctx, prm)
+	if err != nil {
+		return nil, err
+	}
 
-			exec.log.Debug("parent address in child object differs")
-		} else {
-			exec.status = statusOK
-			exec.err = nil
-		}
+	child := w.Object()
 
-		return child, true
+	if _, ok := child.ParentID(); ok && !exec.isChild(child) {
+		return nil, errors.New("parent address in child object differs")
 	}
+
+	return child, nil
 }
 
 func (exec execCtx) remoteClient(info clientcore.NodeInfo) (getClient, bool) {
@@ -111,6 +111,11 @@ func (exec *execCtx) analyzeStatus(execCnr bool) {
 
 		if execCnr {
 			exec.executeOnContainer()
+
+			if exec.status == statusUndefined {
+				exec.restoreErasureCoded()
+			}
+
 			exec.analyzeStatus(false)
 		}
 	}
@@ -2,22 +2,31 @@ package getsvc
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha512"
 	"errors"
 	"fmt"
 	"strconv"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	containercore "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasure"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
@@ -32,6 +41,9 @@ type testStorage struct {
 	virtual map[string]*objectSDK.SplitInfo
 
 	phy map[string]*objectSDK.Object
+
+	// erasure-coded parts by the address of the coded object
+	ecParts map[string][]*objectSDK.Object
 }
 
 type testTraverserGenerator struct {
@@ -52,6 +64,83 @@ type testClient struct {
 		obj *objectSDK.Object
 		err error
 	}
+
+	mtx *sync.Mutex
+	// payload ranges requested by GET and RANGE requests
+	rngReqs map[string][]objectSDK.Range
+
+	// counts objects returned by GET and RANGE requests if set
+	fetched *inFlightCounter
+
+	// number of the HEAD requests
+	heads int
+
+	// erasure-coded parts by the address of the coded object
+	ecParts map[string][]*objectSDK.Object
+}
+
+type testContainerSource struct {
+	cnr container.Container
+}
+
+func (s testContainerSource) Get(cid.ID) (*containercore.Container, error) {
+	return &containercore.Container{Value: s.cnr}, nil
+}
+
+// inFlightCounter tracks the number of the objects fetched but not written yet.
+type inFlightCounter struct {
+	mtx      sync.Mutex
+	cur, max int
+}
+
+func (c *inFlightCounter) inc() {
+	c.mtx.Lock()
+	c.cur++
+	if c.cur > c.max {
+		c.max = c.cur
+	}
+	c.mtx.Unlock()
+}
+
+func (c *inFlightCounter) dec() {
+	c.mtx.Lock()
+	c.cur--
+	c.mtx.Unlock()
+}
+
+// slowWriter writes payload chunks with a delay, so the children
+// are fetched ahead of the writer.
+type slowWriter struct {
+	*SimpleObjectWriter
+
+	fetched *inFlightCounter
+}
+
+func (w slowWriter) WriteChunk(p []byte) error {
+	time.Sleep(time.Millisecond)
+	w.fetched.dec()
+
+	return w.SimpleObjectWriter.WriteChunk(p)
+}
+
+// firstChunkWriter remembers the number of the HEAD requests made by the
+// client before the first payload chunk is written.
+type firstChunkWriter struct {
+	*SimpleObjectWriter
+
+	c *testClient
+
+	heads *int
+}
+
+func (w firstChunkWriter) WriteChunk(p []byte) error {
+	if *w.heads < 0 {
+		w.c.mtx.Lock()
+		*w.heads = w.c.heads
+		w.c.mtx.Unlock()
+	}
+
+	return w.SimpleObjectWriter.WriteChunk(p)
 }
 
 type testEpochReceiver uint64
@@ -65,6 +154,7 @@ func newTestStorage() *testStorage {
 		inhumed: make(map[string]struct{}),
 		virtual: make(map[string]*objectSDK.SplitInfo),
 		phy:     make(map[string]*objectSDK.Object),
+		ecParts: make(map[string][]*objectSDK.Object),
 	}
 }
 
@@ -114,10 +204,20 @@ func newTestClient() *testClient {
 			obj *objectSDK.Object
 			err error
 		}{},
+		rngReqs: make(map[string][]objectSDK.Range),
+		mtx:     new(sync.Mutex),
+		ecParts: make(map[string][]*objectSDK.Object),
 	}
 }
 
 func (c *testClient) getObject(exec *execCtx, _ client.NodeInfo) (*objectSDK.Object, error) {
+	if rng := exec.ctxRange(); rng != nil && !exec.headOnly() {
+		c.mtx.Lock()
+		sAddr := exec.address().EncodeToString()
+		c.rngReqs[sAddr] = append(c.rngReqs[sAddr], *rng)
+		c.mtx.Unlock()
+	}
+
 	v, ok := c.results[exec.address().EncodeToString()]
 	if !ok {
 		var errNotFound apistatus.ObjectNotFound
@@ -129,9 +229,27 @@ func (c *testClient) getObject(exec *execCtx, _ client.NodeInfo) (*objectSDK.Obj
 		return nil, v.err
 	}
 
+	if c.fetched != nil && !exec.headOnly() {
+		c.fetched.inc()
+	}
+
+	if exec.headOnly() {
+		c.mtx.Lock()
+		c.heads++
+		c.mtx.Unlock()
+	}
+
 	return cutToRange(v.obj, exec.ctxRange()), nil
 }
 
+func (c *testClient) erasureParts(exec *execCtx, _ client.NodeInfo, parent oid.ID) ([]*objectSDK.Object, error) {
+	var addr oid.Address
+	addr.SetContainer(exec.containerID())
+	addr.SetObject(parent)
+
+	return c.ecParts[addr.EncodeToString()], nil
+}
+
 func (c *testClient) addResult(addr oid.Address, obj *objectSDK.Object, err error) {
 	c.results[addr.EncodeToString()] = struct {
 		obj *objectSDK.Object
@@ -165,6 +283,14 @@ func (s *testStorage) get(exec *execCtx) (*objectSDK.Object, error) {
 	return nil, errNotFound
 }
 
+func (s *testStorage) erasureParts(exec *execCtx, parent oid.ID) ([]*objectSDK.Object, error) {
+	var addr oid.Address
+	addr.SetContainer(exec.containerID())
+	addr.SetObject(parent)
+
+	return s.ecParts[addr.EncodeToString()], nil
+}
+
 func cutToRange(o *objectSDK.Object, rng *objectSDK.Range) *objectSDK.Object {
 	if rng == nil {
 		return o
@@ -215,6 +341,8 @@ func TestGetLocalOnly(t *testing.T) {
 		svc.log = test.NewLogger(false)
 		svc.localStorage = storage
 		svc.assembly = true
+		svc.assemblyPrefetch = DefaultAssemblyPrefetch
+		svc.assemblyMemLimit = DefaultAssemblyMemoryLimit
 
 		return svc
 	}
@@ -477,6 +605,8 @@ func TestGetRemoteSmall(t *testing.T) {
 		svc.log = test.NewLogger(false)
 		svc.localStorage = newTestStorage()
 		svc.assembly = true
+		svc.assemblyPrefetch = DefaultAssemblyPrefetch
+		svc.assemblyMemLimit = DefaultAssemblyMemoryLimit
 
 		const curEpoch = 13
 
@@ -747,7 +877,7 @@ func TestGetRemoteSmall(t *testing.T) {
 				addr.SetObject(oidtest.ID())
 
 				srcObj := generateObject(addr, nil, nil)
-				srcObj.SetPayloadSize(10)
+				srcObj.SetPayloadSize(20)
 
 				ns, as := testNodeMatrix(t, []int{2})
 
@@ -809,7 +939,7 @@ func TestGetRemoteSmall(t *testing.T) {
 				err := svc.Get(ctx, p)
 				require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
 
-				rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 0, 1)
+				rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 15, 1)
 				rngPrm.WithAddress(addr)
 
 				err = svc.GetRange(ctx, rngPrm)
@@ -902,6 +1032,328 @@ func TestGetRemoteSmall(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, payload[off:off+ln], w.Object().Payload())
 			})
+
+			t.Run("range of many children", func(t *testing.T) {
+				const childNum = 3*DefaultAssemblyPrefetch + 1
+
+				addr := oidtest.Address()
+				addr.SetContainer(idCnr)
+				addr.SetObject(oidtest.ID())
+
+				srcObj := generateObject(addr, nil, nil)
+
+				ns, as := testNodeMatrix(t, []int{1})
+
+				splitInfo := objectSDK.NewSplitInfo()
+				splitInfo.SetLink(oidtest.ID())
+
+				children, childIDs, payload := generateChain(childNum, idCnr)
+				srcObj.SetPayloadSize(uint64(len(payload)))
+
+				var linkAddr oid.Address
+				linkAddr.SetContainer(idCnr)
+				idLink, _ := splitInfo.Link()
+				linkAddr.SetObject(idLink)
+
+				linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+				linkingObj.SetParentID(addr.Object())
+				linkingObj.SetParent(srcObj)
+
+				c := newTestClient()
+				c.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+				c.addResult(linkAddr, linkingObj, nil)
+
+				builder := &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString():     ns,
+						linkAddr.EncodeToString(): ns,
+					},
+				}
+
+				for i := range children {
+					c.addResult(object.AddressOf(children[i]), children[i], nil)
+					builder.vectors[object.AddressOf(children[i]).EncodeToString()] = ns
+				}
+
+				svc := newSvc(builder, &testClientCache{
+					clients: map[string]*testClient{
+						as[0][0]: c,
+					},
+				})
+
+				// children payload size is 10
+				for _, tc := range []struct {
+					off, ln uint64
+					// child index -> requested child range
+					exp map[int][2]uint64
+				}{
+					{off: 0, ln: 10, exp: map[int][2]uint64{0: {0, 10}}},
+					{off: 13, ln: 4, exp: map[int][2]uint64{1: {3, 4}}},
+					{off: 75, ln: 30, exp: map[int][2]uint64{7: {5, 5}, 8: {0, 10}, 9: {0, 10}, 10: {0, 5}}},
+					{off: 10*childNum - 1, ln: 1, exp: map[int][2]uint64{childNum - 1: {9, 1}}},
+					{off: 0, ln: 10 * childNum, exp: func() map[int][2]uint64 {
+						m := make(map[int][2]uint64, childNum)
+						for i := 0; i < childNum; i++ {
+							m[i] = [2]uint64{0, 10}
+						}
+						return m
+					}()},
+				} {
+					c.rngReqs = make(map[string][]objectSDK.Range)
+
+					w := NewSimpleObjectWriter()
+
+					rngPrm := newRngPrm(false, w, tc.off, tc.ln)
+					rngPrm.WithAddress(addr)
+
+					err := svc.GetRange(ctx, rngPrm)
+					require.NoError(t, err)
+					require.Equal(t, payload[tc.off:tc.off+tc.ln], w.Object().Payload())
+
+					for i := range children {
+						reqs := c.rngReqs[object.AddressOf(children[i]).EncodeToString()]
+
+						exp, ok := tc.exp[i]
+						if !ok {
+							require.Empty(t, reqs, "child %d", i)
+							continue
+						}
+
+						require.Len(t, reqs, 1, "child %d", i)
+						require.Equal(t, exp[0], reqs[0].GetOffset(), "child %d", i)
+						require.Equal(t, exp[1], reqs[0].GetLength(), "child %d", i)
+					}
+				}
+
+				rngPrm := newRngPrm(false, NewSimpleObjectWriter(), 10*childNum-1, 2)
+				rngPrm.WithAddress(addr)
+
+				err := svc.GetRange(ctx, rngPrm)
+				require.ErrorAs(t, err, new(apistatus.ObjectOutOfRange))
+			})
+
+			t.Run("prefetch limits", func(t *testing.T) {
+				const childNum = 20
+
+				addr := oidtest.Address()
+				addr.SetContainer(idCnr)
+				addr.SetObject(oidtest.ID())
+
+				srcObj := generateObject(addr, nil, nil)
+
+				ns, as := testNodeMatrix(t, []int{1})
+
+				splitInfo := objectSDK.NewSplitInfo()
+				splitInfo.SetLink(oidtest.ID())
+
+				children, childIDs, payload := generateChain(childNum, idCnr)
+				srcObj.SetPayloadSize(uint64(len(payload)))
+
+				var linkAddr oid.Address
+				linkAddr.SetContainer(idCnr)
+				idLink, _ := splitInfo.Link()
+				linkAddr.SetObject(idLink)
+
+				linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+				linkingObj.SetParentID(addr.Object())
+				linkingObj.SetParent(srcObj)
+
+				c := newTestClient()
+				c.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+				c.addResult(linkAddr, linkingObj, nil)
+
+				builder := &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString():     ns,
+						linkAddr.EncodeToString(): ns,
+					},
+				}
+
+				for i := range children {
+					c.addResult(object.AddressOf(children[i]), children[i], nil)
+					builder.vectors[object.AddressOf(children[i]).EncodeToString()] = ns
+				}
+
+				svc := newSvc(builder, &testClientCache{
+					clients: map[string]*testClient{
+						as[0][0]: c,
+					},
+				})
+
+				// children payload size is 10
+				for _, tc := range []struct {
+					prefetch int
+					memLimit uint64
+					expMax   int
+				}{
+					{prefetch: 1, memLimit: 1000, expMax: 1},
+					{prefetch: 3, memLimit: 1000, expMax: 3},
+					{prefetch: 10, memLimit: 25, expMax: 2},
+					// single child bigger than the limit is fetched alone
+					{prefetch: 10, memLimit: 5, expMax: 1},
+				} {
+					svc.assemblyPrefetch = tc.prefetch
+					svc.assemblyMemLimit = tc.memLimit
+
+					c.fetched = new(inFlightCounter)
+
+					w := slowWriter{
+						SimpleObjectWriter: NewSimpleObjectWriter(),
+						fetched:            c.fetched,
+					}
+
+					p := newPrm(false, w)
+					p.WithAddress(addr)
+
+					err := svc.Get(ctx, p)
+					require.NoError(t, err)
+					require.Equal(t, payload, w.Object().Payload())
+					require.LessOrEqual(t, c.fetched.max, tc.expMax)
+				}
+			})
+
+			t.Run("stream before all children are resolved", func(t *testing.T) {
+				const (
+					childNum = 20
+					prefetch = 2
+				)
+
+				addr := oidtest.Address()
+				addr.SetContainer(idCnr)
+				addr.SetObject(oidtest.ID())
+
+				srcObj := generateObject(addr, nil, nil)
+
+				ns, as := testNodeMatrix(t, []int{1})
+
+				splitInfo := objectSDK.NewSplitInfo()
+				splitInfo.SetLink(oidtest.ID())
+
+				children, childIDs, payload := generateChain(childNum, idCnr)
+				srcObj.SetPayloadSize(uint64(len(payload)))
+
+				var linkAddr oid.Address
+				linkAddr.SetContainer(idCnr)
+				idLink, _ := splitInfo.Link()
+				linkAddr.SetObject(idLink)
+
+				linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+				linkingObj.SetParentID(addr.Object())
+				linkingObj.SetParent(srcObj)
+
+				c := newTestClient()
+				c.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+				c.addResult(linkAddr, linkingObj, nil)
+
+				builder := &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString():     ns,
+						linkAddr.EncodeToString(): ns,
+					},
+				}
+
+				for i := range children {
+					c.addResult(object.AddressOf(children[i]), children[i], nil)
+					builder.vectors[object.AddressOf(children[i]).EncodeToString()] = ns
+				}
+
+				svc := newSvc(builder, &testClientCache{
+					clients: map[string]*testClient{
+						as[0][0]: c,
+					},
+				})
+				svc.assemblyPrefetch = prefetch
+
+				heads := -1
+				w := firstChunkWriter{
+					SimpleObjectWriter: NewSimpleObjectWriter(),
+					c:                  c,
+					heads:              &heads,
+				}
+
+				p := newPrm(false, w)
+				p.WithAddress(addr)
+
+				err := svc.Get(ctx, p)
+				require.NoError(t, err)
+				require.Equal(t, payload, w.Object().Payload())
+
+				// whole children are fetched without the headers
+				require.Equal(t, 1, heads)
+				require.Equal(t, 1, c.heads)
+
+				c.heads = 0
+				heads = -1
+				w.SimpleObjectWriter = NewSimpleObjectWriter()
+
+				rngPrm := newRngPrm(false, w, 1, uint64(len(payload)-1))
+				rngPrm.WithAddress(addr)
+
+				err = svc.GetRange(ctx, rngPrm)
+				require.NoError(t, err)
+				require.Equal(t, payload[1:], w.Object().Payload())
+
+				// linking object and up to two windows of children:
+				// the next window is resolved while the first one is fetched
+				require.LessOrEqual(t, heads, 1+2*prefetch)
+				require.Equal(t, 1+childNum, c.heads)
+			})
+
+			t.Run("children of the wrong size", func(t *testing.T) {
+				addr := oidtest.Address()
+				addr.SetContainer(idCnr)
+				addr.SetObject(oidtest.ID())
+
+				srcObj := generateObject(addr, nil, nil)
+
+				ns, as := testNodeMatrix(t, []int{1})
+
+				splitInfo := objectSDK.NewSplitInfo()
+				splitInfo.SetLink(oidtest.ID())
+
+				children, childIDs, payload := generateChain(3, idCnr)
+
+				var linkAddr oid.Address
+				linkAddr.SetContainer(idCnr)
+				idLink, _ := splitInfo.Link()
+				linkAddr.SetObject(idLink)
+
+				linkingObj := generateObject(linkAddr, nil, nil, childIDs...)
+				linkingObj.SetParentID(addr.Object())
+				linkingObj.SetParent(srcObj)
+
+				c := newTestClient()
+				c.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+				c.addResult(linkAddr, linkingObj, nil)
+
+				builder := &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString():     ns,
+						linkAddr.EncodeToString(): ns,
+					},
+				}
+
+				for i := range children {
+					c.addResult(object.AddressOf(children[i]), children[i], nil)
+					builder.vectors[object.AddressOf(children[i]).EncodeToString()] = ns
+				}
+
+				svc := newSvc(builder, &testClientCache{
+					clients: map[string]*testClient{
+						as[0][0]: c,
+					},
+				})
+
+				for _, sz := range []int{len(payload) - 1, len(payload) + 1} {
+					srcObj.SetPayloadSize(uint64(sz))
+
+					p := newPrm(false, NewSimpleObjectWriter())
+					p.WithAddress(addr)
+
+					err := svc.Get(ctx, p)
+					require.ErrorIs(t, err, errInconsistentSplitChain)
+				}
+			})
 		})
 
 		t.Run("right child", func(t *testing.T) {
@@ -963,7 +1415,7 @@ func TestGetRemoteSmall(t *testing.T) {
 				addr.SetObject(oidtest.ID())
 
 				srcObj := generateObject(addr, nil, nil)
-				srcObj.SetPayloadSize(10)
+				srcObj.SetPayloadSize(20)
 
 				ns, as := testNodeMatrix(t, []int{2})
 
@@ -1244,3 +1696,178 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, obj.CutPayload(), w.Object())
 }
+
+func TestGetErasureCoded(t *testing.T) {
+	ctx := context.Background()
+
+	var cnr container.Container
+	cnr.SetPlacementPolicy(netmaptest.PlacementPolicy())
+	cnr.SetAttribute(erasure.ContainerAttribute, "2.1")
+
+	var idCnr cid.ID
+	container.CalculateID(&idCnr, cnr)
+
+	payload := make([]byte, 11)
+	_, _ = rand.Read(payload)
+
+	obj := objectSDK.New()
+	obj.SetContainerID(idCnr)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	objectSDK.CalculateAndSetPayloadChecksum(obj)
+	require.NoError(t, objectSDK.CalculateAndSetID(obj))
+	signObject(t, obj)
+
+	addr := object.AddressOf(obj)
+
+	coder, err := erasure.NewCoder(2, 1)
+	require.NoError(t, err)
+
+	parts, err := erasure.FormParts(coder, obj, true)
+	require.NoError(t, err)
+
+	ns, as := testNodeMatrix(t, []int{4})
+
+	newSvc := func(parts ...*objectSDK.Object) *Service {
+		const curEpoch = 13
+
+		svc := &Service{cfg: new(cfg)}
+		svc.log = test.NewLogger(false)
+		svc.localStorage = newTestStorage()
+		svc.assembly = true
+		svc.cnrSrc = testContainerSource{cnr: cnr}
+		svc.traverserGenerator = &testTraverserGenerator{
+			c: cnr,
+			b: map[uint64]placement.Builder{
+				curEpoch: &testPlacementBuilder{
+					vectors: map[string][][]netmap.NodeInfo{
+						addr.EncodeToString(): ns,
+					},
+				},
+			},
+		}
+		svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+		// first node stores nothing, other ones store a part each
+		clients := map[string]*testClient{
+			as[0][0]: newTestClient(),
+		}
+
+		for i := range parts {
+			c := newTestClient()
+			if parts[i] != nil {
+				c.ecParts[addr.EncodeToString()] = []*objectSDK.Object{parts[i]}
+			}
+
+			clients[as[0][i+1]] = c
+		}
+
+		svc.clientCache = &testClientCache{clients: clients}
+
+		return svc
+	}
+
+	newCommonPrm := func() commonPrm {
+		var p commonPrm
+		p.WithAddress(addr)
+		p.common = new(util.CommonPrm).WithLocalOnly(false)
+
+		return p
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		// data part is lost
+		svc := newSvc(nil, parts[1], parts[2])
+
+		w := NewSimpleObjectWriter()
+
+		p := Prm{commonPrm: newCommonPrm()}
+		p.SetObjectWriter(w)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, payload, w.Object().Payload())
+
+		restoredID, _ := w.Object().ID()
+		require.Equal(t, addr.Object(), restoredID)
+
+		w = NewSimpleObjectWriter()
+
+		rng := objectSDK.NewRange()
+		rng.SetOffset(3)
+		rng.SetLength(7)
+
+		rp := RangePrm{commonPrm: newCommonPrm()}
+		rp.SetChunkWriter(w)
+		rp.SetRange(rng)
+
+		require.NoError(t, svc.GetRange(ctx, rp))
+		require.Equal(t, payload[3:10], w.Object().Payload())
+
+		rng.SetLength(9)
+		require.ErrorAs(t, svc.GetRange(ctx, rp), new(apistatus.ObjectOutOfRange))
+
+		w = NewSimpleObjectWriter()
+
+		hp := HeadPrm{commonPrm: newCommonPrm()}
+		hp.SetHeaderWriter(w)
+
+		require.NoError(t, svc.Head(ctx, hp))
+		require.Equal(t, obj.CutPayload(), w.Object())
+	})
+
+	t.Run("local part", func(t *testing.T) {
+		// local node is not in the placement vectors passed to the service
+		svc := newSvc(nil, nil, parts[2])
+		svc.localStorage.(*testStorage).ecParts[addr.EncodeToString()] = []*objectSDK.Object{parts[0]}
+
+		w := NewSimpleObjectWriter()
+
+		p := Prm{commonPrm: newCommonPrm()}
+		p.SetObjectWriter(w)
+
+		require.NoError(t, svc.Get(ctx, p))
+		require.Equal(t, payload, w.Object().Payload())
+	})
+
+	t.Run("not enough parts", func(t *testing.T) {
+		svc := newSvc(nil, parts[1], nil)
+
+		p := Prm{commonPrm: newCommonPrm()}
+		p.SetObjectWriter(NewSimpleObjectWriter())
+
+		require.ErrorAs(t, svc.Get(ctx, p), new(apistatus.ObjectNotFound))
+	})
+}
+
+// signObject signs the object ID with a random key in the same format as
+// objectSDK.CalculateAndSetSignature does.
+func signObject(t *testing.T, obj *objectSDK.Object) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	id, ok := obj.ID()
+	require.True(t, ok)
+
+	var idV2 refs.ObjectID
+	id.WriteToV2(&idV2)
+
+	h := sha512.Sum512(idV2.StableMarshal(nil))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, h[:])
+	require.NoError(t, err)
+
+	sign := make([]byte, 65)
+	sign[0] = 0x04
+	r.FillBytes(sign[1:33])
+	s.FillBytes(sign[33:])
+
+	var sigV2 refs.Signature
+	sigV2.SetKey(elliptic.MarshalCompressed(key.Curve, key.X, key.Y))
+	sigV2.SetSign(sign)
+	sigV2.SetScheme(refs.ECDSA_SHA512)
+
+	var sig neofscrypto.Signature
+	require.NoError(t, sig.ReadFromV2(sigV2))
+
+	obj.SetSignature(&sig)
+}
@@ -0,0 +1,387 @@
+package getsvc
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+type payloadPartRes struct {
+	obj *objectSDK.Object
+	st  statusError
+}
+
+// fetchedPart is the part of the payload queued for writing. The error is
+// set if the part can not be resolved.
+type fetchedPart struct {
+	part payloadPart
+	res  chan payloadPartRes
+	err  error
+}
+
+// payloadParts provides the parts of the requested payload in order.
+type payloadParts interface {
+	// next returns the next part of the payload, false is returned after the
+	// last part. It is not called concurrently.
+	next(ctx context.Context) (payloadPart, bool, error)
+}
+
+// partList is a list of the parts resolved in advance.
+type partList []payloadPart
+
+func (l *partList) next(context.Context) (payloadPart, bool, error) {
+	if len(*l) == 0 {
+		return payloadPart{}, false, nil
+	}
+
+	part := (*l)[0]
+	*l = (*l)[1:]
+
+	return part, true, nil
+}
+
+// linkParts resolves the parts of the requested payload using the list of
+// the children from the linking object. Children headers are requested from
+// left to right by windows of the prefetch size as the parts are consumed,
+// so the payload is streamed once the first window is resolved.
+type linkParts struct {
+	// copy of the execution context, children are requested concurrently
+	// with the payload writing which changes the execution status
+	exec execCtx
+
+	children []oid.ID
+
+	from, to uint64
+
+	// offset of the first unresolved child in the parent payload
+	off uint64
+
+	resolved []payloadPart
+}
+
+func (l *linkParts) next(ctx context.Context) (payloadPart, bool, error) {
+	for len(l.resolved) == 0 {
+		if l.off >= l.to {
+			return payloadPart{}, false, nil
+		}
+
+		if len(l.children) == 0 {
+			return payloadPart{}, false, errInconsistentSplitChain
+		}
+
+		window := l.exec.svc.assemblyPrefetch
+		if window > len(l.children) {
+			window = len(l.children)
+		}
+
+		heads, err := l.exec.headChildren(ctx, l.children[:window])
+		if err != nil {
+			return payloadPart{}, false, err
+		}
+
+		for i := range heads {
+			sz := heads[i].PayloadSize()
+			l.resolved = appendPayloadPart(l.resolved, l.children[i], l.off, sz, l.from, l.to)
+			l.off += sz
+		}
+
+		l.children = l.children[window:]
+	}
+
+	part := l.resolved[0]
+	l.resolved = l.resolved[1:]
+
+	return part, true, nil
+}
+
+// partsFromLink returns the parts of the requested payload listed in the
+// linking object. The whole payload consists of the whole children, so they
+// are fetched without the headers requested in advance. Otherwise, children
+// headers are requested during the iteration to find the requested range.
+func (exec *execCtx) partsFromLink(linkID oid.ID) (payloadParts, bool) {
+	link, ok := exec.headChild(linkID)
+	if !ok {
+		return nil, false
+	}
+
+	from, to, ok := exec.initParent(link)
+	if !ok {
+		return nil, false
+	}
+
+	if exec.ctxRange() == nil {
+		children := link.Children()
+		list := make(partList, len(children))
+
+		for i := range children {
+			list[i].id = children[i]
+		}
+
+		return &list, true
+	}
+
+	return &linkParts{
+		exec:     *exec,
+		children: link.Children(),
+		from:     from,
+		to:       to,
+	}, true
+}
+
+// headChildren requests the headers of the children in parallel. It does
+// not change the execution status.
+func (exec *execCtx) headChildren(ctx context.Context, ids []oid.ID) ([]*objectSDK.Object, error) {
+	var (
+		wg    sync.WaitGroup
+		heads = make([]*objectSDK.Object, len(ids))
+		errs  = make([]error, len(ids))
+	)
+
+	for i := range ids {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			heads[i], errs[i] = exec.headChildObject(ctx, ids[i])
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i := range errs {
+		if errs[i] != nil {
+			exec.log.Debug("could not get child object header",
+				zap.Stringer("child ID", ids[i]),
+				zap.String("error", errs[i].Error()),
+			)
+
+			return nil, errs[i]
+		}
+	}
+
+	return heads, nil
+}
+
+// overtakePayloadParts fetches the parts of the payload and writes them in
+// the original order. Up to the configured number of parts are prefetched
+// concurrently, children are placed independently, so requests are spread
+// over the container nodes. Total size of the parts fetched ahead of the
+// writer does not exceed the memory limit, the only exception is a single
+// part bigger than the limit which is fetched alone.
+//
+// Parts with zero length are the whole children of unknown size. Their
+// headers are checked and the total size of the written payload is compared
+// with the parent one.
+func (exec *execCtx) overtakePayloadParts(parts payloadParts) {
+	ctx, cancel := context.WithCancel(exec.context())
+
+	var (
+		window = exec.svc.assemblyPrefetch
+		// no more than window parts are in flight, so neither the prefetcher
+		// nor the writer blocks on these channels
+		queue    = make(chan fetchedPart, window)
+		released = make(chan uint64, window)
+		done     = make(chan struct{})
+	)
+
+	// parameters are copied since fetching goroutines may outlive
+	// the execution context in case of failure
+	prm := exec.prm.commonPrm
+	withRange := exec.ctxRange() != nil
+
+	var (
+		parSize = exec.collectedObject.PayloadSize()
+		written uint64
+		// whole children are written
+		whole = !withRange
+	)
+
+	go func() {
+		exec.svc.prefetchPayloadParts(ctx, prm, withRange, parts, queue, released)
+		close(done)
+	}()
+
+	// parts may be resolved using the execution context,
+	// so the prefetcher is stopped before return
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	for fp := range queue {
+		if fp.err != nil {
+			exec.status = statusUndefined
+			exec.err = fp.err
+
+			exec.log.Debug("could not resolve payload parts of the object",
+				zap.String("error", fp.err.Error()),
+			)
+
+			return
+		}
+
+		var res payloadPartRes
+
+		select {
+		case <-ctx.Done():
+			exec.status = statusUndefined
+			exec.err = ctx.Err()
+
+			return
+		case res = <-fp.res:
+		}
+
+		if res.st.status != statusOK {
+			exec.statusError = res.st
+
+			exec.log.Debug("could not get payload of the child object",
+				zap.Stringer("child ID", fp.part.id),
+			)
+
+			return
+		}
+
+		sz := uint64(len(res.obj.Payload()))
+
+		if fp.part.rng.GetLength() == 0 {
+			if _, ok := res.obj.ParentID(); ok && !exec.isChild(res.obj) {
+				exec.status = statusUndefined
+				exec.err = errors.New("parent address in child object differs")
+
+				return
+			}
+		}
+
+		if whole {
+			written += sz
+			if written > parSize {
+				exec.inconsistentSplitChain()
+				return
+			}
+		}
+
+		if !exec.writeObjectPayload(res.obj) {
+			return
+		}
+
+		released <- sz
+	}
+
+	// prefetcher stops on context cancellation only
+	if err := ctx.Err(); err != nil {
+		exec.status = statusUndefined
+		exec.err = err
+
+		return
+	}
+
+	if whole && written != parSize {
+		exec.inconsistentSplitChain()
+		return
+	}
+
+	exec.status = statusOK
+	exec.err = nil
+}
+
+// prefetchPayloadParts resolves the parts in order and starts fetching them
+// as long as the number and the total size of the parts which are not
+// written yet fit the limits. Started parts are queued for writing, the
+// queue is closed when all the parts are queued or on failure.
+//
+// Size of the whole children is not known in advance, so the first one is
+// fetched alone and the biggest written child is used as the size of the
+// following ones: children of the split chain have the same size except
+// the last one.
+func (s *Service) prefetchPayloadParts(ctx context.Context, prm commonPrm, withRange bool, parts payloadParts,
+	queue chan<- fetchedPart, released <-chan uint64) {
+	defer close(queue)
+
+	var (
+		// sizes reserved for the parts which are not written yet in order
+		inFlight []uint64
+		size     uint64
+		// size of the biggest written part
+		maxSize uint64
+	)
+
+	for {
+		part, ok, err := parts.next(ctx)
+		if err != nil {
+			select {
+			case <-ctx.Done():
+			case queue <- fetchedPart{err: err}:
+			}
+
+			return
+		} else if !ok {
+			return
+		}
+
+		for {
+			partSize := part.rng.GetLength()
+			if partSize == 0 {
+				partSize = maxSize
+			}
+
+			if len(inFlight) == 0 ||
+				len(inFlight) < s.assemblyPrefetch && partSize != 0 && size+partSize <= s.assemblyMemLimit {
+				inFlight = append(inFlight, partSize)
+				size += partSize
+
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case sz := <-released:
+				size -= inFlight[0]
+				inFlight = inFlight[1:]
+
+				if sz > maxSize {
+					maxSize = sz
+				}
+			}
+		}
+
+		fp := fetchedPart{
+			part: part,
+			res:  make(chan payloadPartRes, 1),
+		}
+
+		go func() {
+			obj, st := s.getPayloadPart(ctx, prm, withRange, fp.part)
+			fp.res <- payloadPartRes{obj: obj, st: st}
+		}()
+
+		select {
+		case <-ctx.Done():
+			return
+		case queue <- fp:
+		}
+	}
+}
+
+// getPayloadPart requests the part of the child payload. Payload range is
+// requested for RANGE requests only, so the access rules of the original
+// request are kept. Common parameters must not be local-only.
+func (s *Service) getPayloadPart(ctx context.Context, prm commonPrm, withRange bool, part payloadPart) (*objectSDK.Object, statusError) {
+	w := NewSimpleObjectWriter()
+
+	prm.objWriter = w
+	prm.addr.SetObject(part.id)
+
+	var rng *objectSDK.Range
+	if withRange {
+		rng = &part.rng
+	}
+
+	st := s.get(ctx, prm, withPayloadRange(rng))
+
+	return w.Object(), st
+}
@@ -2,6 +2,7 @@ package getsvc
 
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
@@ -23,15 +24,27 @@ type Option func(*cfg)
 
 type getClient interface {
 	getObject(*execCtx, client.NodeInfo) (*object.Object, error)
+
+	// erasureParts returns the parts of the erasure-coded object stored
+	// on the node. Payloads are not read for HEAD requests.
+	erasureParts(*execCtx, client.NodeInfo, oid.ID) ([]*object.Object, error)
 }
 
 type cfg struct {
 	assembly bool
 
+	assemblyPrefetch int
+
+	assemblyMemLimit uint64
+
 	log *logger.Logger
 
 	localStorage interface {
 		get(*execCtx) (*object.Object, error)
+
+		// erasureParts returns the parts of the erasure-coded object
+		// stored locally.
+		erasureParts(*execCtx, oid.ID) ([]*object.Object, error)
 	}
 
 	clientCache interface {
@@ -47,14 +60,29 @@ type cfg struct {
 	}
 
 	keyStore *util.KeyStorage
+
+	// nil disables restoring of the erasure-coded objects
+	cnrSrc container.Source
 }
 
+const (
+	// DefaultAssemblyPrefetch is a default number of the children
+	// of the big object requested concurrently during the assembly.
+	DefaultAssemblyPrefetch = 8
+
+	// DefaultAssemblyMemoryLimit is a default limit of the payload
+	// size fetched ahead during the assembly of the big object.
+	DefaultAssemblyMemoryLimit = 256 << 20
+)
+
 func defaultCfg() *cfg {
 	return &cfg{
-		assembly:     true,
-		log:          zap.L(),
-		localStorage: new(storageEngineWrapper),
-		clientCache:  new(clientCacheWrapper),
+		assembly:         true,
+		assemblyPrefetch: DefaultAssemblyPrefetch,
+		assemblyMemLimit: DefaultAssemblyMemoryLimit,
+		log:              zap.L(),
+		localStorage:     new(storageEngineWrapper),
+		clientCache:      new(clientCacheWrapper),
 	}
 }
 
@@ -86,6 +114,29 @@ func WithoutAssembly() Option {
 	}
 }
 
+// WithAssemblyPrefetch returns option to set the number of the children
+// requested concurrently during the assembly of the big object. Non-positive
+// values are ignored.
+func WithAssemblyPrefetch(n int) Option {
+	return func(c *cfg) {
+		if n > 0 {
+			c.assemblyPrefetch = n
+		}
+	}
+}
+
+// WithAssemblyMemoryLimit returns option to limit the total payload size of
+// the children fetched ahead of the client during the assembly of the big
+// object. A single child bigger than the limit is still fetched. Zero value
+// is ignored.
+func WithAssemblyMemoryLimit(size uint64) Option {
+	return func(c *cfg) {
+		if size > 0 {
+			c.assemblyMemLimit = size
+		}
+	}
+}
+
 // WithLocalStorageEngine returns option to set local storage
 // instance.
 func WithLocalStorageEngine(e *engine.StorageEngine) Option {
@@ -130,3 +181,12 @@ func WithKeyStorage(store *util.KeyStorage) Option {
 		c.keyStore = store
 	}
 }
+
+// WithContainerSource returns option to set container source used to
+// determine erasure-coded containers. Without the source, objects are not
+// restored from the erasure-coded parts.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.cnrSrc = src
+	}
+}
@@ -1,6 +1,7 @@
 package getsvc
 
 import (
+	"fmt"
 	"io"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -8,7 +9,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	internal "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasure"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 type SimpleObjectWriter struct {
@@ -169,6 +172,82 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	return res.Object(), nil
 }
 
+func (c *clientWrapper) erasureParts(exec *execCtx, _ coreclient.NodeInfo, parent oid.ID) ([]*object.Object, error) {
+	// parts are saved by the container nodes, so they are
+	// requested on behalf of the node
+	key, err := exec.svc.keyStore.GetKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fs object.SearchFilters
+	fs.AddFilter(erasure.AttributeParent, parent.EncodeToString(), object.MatchStringEqual)
+
+	var searchPrm internalclient.SearchObjectsPrm
+
+	searchPrm.SetContext(exec.context())
+	searchPrm.SetClient(c.client)
+	searchPrm.SetTTL(1)
+	searchPrm.SetPrivateKey(key)
+	searchPrm.SetContainerID(exec.containerID())
+	searchPrm.SetFilters(fs)
+
+	res, err := internalclient.SearchObjects(searchPrm)
+	if err != nil {
+		return nil, fmt.Errorf("could not search parts: %w", err)
+	}
+
+	var (
+		ids   = res.IDList()
+		parts = make([]*object.Object, 0, len(ids))
+		addr  oid.Address
+	)
+
+	addr.SetContainer(exec.containerID())
+
+	for i := range ids {
+		addr.SetObject(ids[i])
+
+		var part *object.Object
+
+		if exec.headOnly() {
+			var prm internalclient.HeadObjectPrm
+
+			prm.SetContext(exec.context())
+			prm.SetClient(c.client)
+			prm.SetTTL(1)
+			prm.SetPrivateKey(key)
+			prm.SetAddress(addr)
+
+			res, err := internalclient.HeadObject(prm)
+			if err != nil {
+				return nil, fmt.Errorf("could not get part header %s: %w", ids[i], err)
+			}
+
+			part = res.Header()
+		} else {
+			var prm internalclient.GetObjectPrm
+
+			prm.SetContext(exec.context())
+			prm.SetClient(c.client)
+			prm.SetTTL(1)
+			prm.SetPrivateKey(key)
+			prm.SetAddress(addr)
+
+			res, err := internalclient.GetObject(prm)
+			if err != nil {
+				return nil, fmt.Errorf("could not get part %s: %w", ids[i], err)
+			}
+
+			part = res.Object()
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
 func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	if exec.headOnly() {
 		var headPrm engine.HeadPrm
@@ -205,6 +284,36 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	}
 }
 
+func (e *storageEngineWrapper) erasureParts(exec *execCtx, parent oid.ID) ([]*object.Object, error) {
+	var fs object.SearchFilters
+	fs.AddFilter(erasure.AttributeParent, parent.EncodeToString(), object.MatchStringEqual)
+
+	addrs, err := engine.Select(e.engine, exec.containerID(), fs)
+	if err != nil {
+		return nil, fmt.Errorf("could not select parts: %w", err)
+	}
+
+	parts := make([]*object.Object, 0, len(addrs))
+
+	for i := range addrs {
+		var part *object.Object
+
+		if exec.headOnly() {
+			part, err = engine.Head(e.engine, addrs[i])
+		} else {
+			part, err = engine.Get(e.engine, addrs[i])
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not get part %s: %w", addrs[i].Object(), err)
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
 func (w *partWriter) WriteChunk(p []byte) error {
 	return w.chunkWriter.WriteChunk(p)
 }
@@ -345,6 +345,8 @@ type PutObjectPrm struct {
 	commonPrm
 
 	obj *object.Object
+
+	payload io.Reader
 }
 
 // SetObject sets object to be stored.
@@ -354,6 +356,12 @@ func (x *PutObjectPrm) SetObject(obj *object.Object) {
 	x.obj = obj
 }
 
+// SetPayloadReader sets the reader of the object payload. If set, the payload
+// of the object is read from r chunk by chunk instead of the object itself.
+func (x *PutObjectPrm) SetPayloadReader(r io.Reader) {
+	x.payload = r
+}
+
 // PutObjectRes groups the resulting values of PutObject operation.
 type PutObjectRes struct {
 	id oid.ID
@@ -393,11 +401,21 @@ func PutObject(prm PutObjectPrm) (*PutObjectRes, error) {
 
 	w.WithXHeaders(prm.xHeaders...)
 
+	var errRead error
+
 	if w.WriteHeader(*prm.obj) {
-		w.WritePayloadChunk(prm.obj.Payload())
+		if prm.payload != nil {
+			errRead = writePayload(w, prm.payload)
+		} else {
+			w.WritePayloadChunk(prm.obj.Payload())
+		}
 	}
 
 	cliRes, err := w.Close()
+	if errRead != nil {
+		return nil, fmt.Errorf("read payload: %w", errRead)
+	}
+
 	if err == nil {
 		err = apistatus.ErrFromStatus(cliRes.Status())
 	}
@@ -415,6 +433,30 @@ func PutObject(prm PutObjectPrm) (*PutObjectRes, error) {
 	return &res, nil
 }
 
+// payloadChunkSize is a size of the payload chunk read from the payload
+// reader and sent to the remote node in a single message.
+const payloadChunkSize = 256 << 10
+
+// writePayload writes the payload read from r to w until EOF or
+// writing failure. Returns reading error only, writing errors are
+// returned by w.Close.
+func writePayload(w *client.ObjectWriter, r io.Reader) error {
+	buf := make([]byte, payloadChunkSize)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 && !w.WritePayloadChunk(buf[:n]) {
+			return nil
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
 // SearchObjectsPrm groups parameters of SearchObjects operation.
 type SearchObjectsPrm struct {
 	readPrmCommon
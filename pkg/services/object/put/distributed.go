@@ -7,6 +7,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasure"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
@@ -35,6 +36,9 @@ type distributedTarget struct {
 
 	relay func(nodeDesc) error
 
+	// nil if the object is replicated
+	erasure *erasureCoding
+
 	fmt *object.FormatValidator
 
 	log *logger.Logger
@@ -124,6 +128,10 @@ func (t *distributedTarget) Close() (*transformer.AccessIdentifiers, error) {
 		return nil, fmt.Errorf("(%T) could not validate payload content: %w", t, err)
 	}
 
+	if t.erasure != nil && erasure.Applicable(t.obj) {
+		return t.saveErasureCoded()
+	}
+
 	return t.iteratePlacement(t.sendObject)
 }
 
@@ -0,0 +1,222 @@
+package putsvc
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasure"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// erasureCoding groups the parameters of the object saving
+// in the container with erasure coding rule.
+type erasureCoding struct {
+	coder *erasure.Coder
+
+	withoutHomomorphicHash bool
+
+	// key of the node which signs the parts
+	key *ecdsa.PrivateKey
+
+	networkState netmap.State
+
+	// parts are sent with node's own requests
+	nodeTargetInitializer func(nodeDesc) preparedObjectTarget
+}
+
+// saveErasureCoded encodes the object and saves the parts on the distinct
+// container nodes: part is sent to the next unused node of the object
+// placement if the previous one failed to save it. If the request can be
+// relayed and the local node is not a container node, the request is
+// relayed to the container node instead, which encodes the object itself.
+func (t *distributedTarget) saveErasureCoded() (*transformer.AccessIdentifiers, error) {
+	id, _ := t.obj.ID()
+
+	if t.relay != nil {
+		relayed, err := t.relayErasureCoded(id)
+		if err != nil {
+			return nil, err
+		} else if relayed {
+			return new(transformer.AccessIdentifiers).
+				WithSelfID(id), nil
+		}
+	}
+
+	parts, err := t.erasure.formParts(t.obj)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not form erasure-coded parts: %w", t, err)
+	}
+
+	traverser, err := placement.NewTraverser(append(t.traversal.opts,
+		placement.ForObject(id),
+		placement.SuccessAfter(uint32(len(parts))),
+		placement.UniqueNodes(),
+	)...)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not create object placement traverser: %w", t, err)
+	}
+
+	var (
+		resErr error
+		// indices of the parts which are not saved yet
+		pending = make([]int, len(parts))
+	)
+
+	for i := range pending {
+		pending[i] = i
+	}
+
+	for len(pending) > 0 {
+		nodes := traverser.Next()
+		if len(nodes) == 0 {
+			break
+		}
+
+		var (
+			wg     sync.WaitGroup
+			mtx    sync.Mutex
+			failed []int
+		)
+
+		for i := range nodes {
+			if i >= len(pending) {
+				break
+			}
+
+			wg.Add(1)
+
+			node := nodeDesc{
+				local: t.isLocalKey(nodes[i].PublicKey()),
+				info:  nodes[i],
+			}
+
+			part := parts[pending[i]]
+			idx := pending[i]
+
+			var workerPool util.WorkerPool
+
+			if node.local {
+				workerPool = t.localPool
+			} else {
+				workerPool = t.remotePool
+			}
+
+			if err := workerPool.Submit(func() {
+				defer wg.Done()
+
+				err := t.erasure.sendPart(node, part)
+				if err != nil {
+					svcutil.LogServiceError(t.log, "PUT", node.info.Addresses(), err)
+
+					mtx.Lock()
+					resErr = err
+					failed = append(failed, idx)
+					mtx.Unlock()
+
+					return
+				}
+
+				traverser.SubmitSuccess()
+			}); err != nil {
+				wg.Done()
+
+				svcutil.LogWorkerPoolError(t.log, "PUT", err)
+
+				mtx.Lock()
+				failed = append(failed, pending[i:]...)
+				mtx.Unlock()
+
+				break
+			}
+		}
+
+		wg.Wait()
+
+		sort.Ints(failed)
+		pending = failed
+	}
+
+	if len(pending) > 0 {
+		return nil, errIncompletePut{singleErr: resErr}
+	}
+
+	return new(transformer.AccessIdentifiers).
+		WithSelfID(id), nil
+}
+
+// relayErasureCoded relays the request to the container nodes of the object
+// one by one until one of them accepts it. Returns false if the local node is
+// a container node.
+func (t *distributedTarget) relayErasureCoded(id oid.ID) (bool, error) {
+	traverser, err := placement.NewTraverser(append(t.traversal.opts,
+		placement.ForObject(id),
+		placement.WithoutSuccessTracking(),
+		placement.UniqueNodes(),
+	)...)
+	if err != nil {
+		return false, fmt.Errorf("(%T) could not create object placement traverser: %w", t, err)
+	}
+
+	var nodes []placement.Node
+
+	for batch := traverser.Next(); len(batch) > 0; batch = traverser.Next() {
+		for i := range batch {
+			if t.isLocalKey(batch[i].PublicKey()) {
+				return false, nil
+			}
+		}
+
+		nodes = append(nodes, batch...)
+	}
+
+	var resErr error
+
+	for i := range nodes {
+		err := t.relay(nodeDesc{info: nodes[i]})
+		if err == nil {
+			return true, nil
+		}
+
+		svcutil.LogServiceError(t.log, "PUT", nodes[i].Addresses(), err)
+
+		resErr = err
+	}
+
+	return true, errIncompletePut{singleErr: resErr}
+}
+
+// formParts encodes the object and finalizes the part headers on behalf of the node.
+func (x *erasureCoding) formParts(obj *object.Object) ([]*object.Object, error) {
+	parts, err := erasure.FormParts(x.coder, obj, x.withoutHomomorphicHash)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range parts {
+		if err := erasure.SignPart(parts[i], *x.key, x.networkState.CurrentEpoch()); err != nil {
+			return nil, err
+		}
+	}
+
+	return parts, nil
+}
+
+func (x *erasureCoding) sendPart(node nodeDesc, part *object.Object) error {
+	target := x.nodeTargetInitializer(node)
+
+	if err := target.WriteHeader(part); err != nil {
+		return fmt.Errorf("could not write header: %w", err)
+	} else if _, err := target.Close(); err != nil {
+		return fmt.Errorf("could not close object stream: %w", err)
+	}
+
+	return nil
+}
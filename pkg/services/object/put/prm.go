@@ -17,6 +17,9 @@ type PutInitPrm struct {
 
 	traverseOpts []placement.Option
 
+	// nil if the object is replicated
+	erasure *erasureCoding
+
 	relay func(client.NodeInfo, client.MultiAddressClient) error
 }
 
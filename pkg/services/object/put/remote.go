@@ -3,6 +3,7 @@ package putsvc
 import (
 	"context"
 	"fmt"
+	"io"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
@@ -24,6 +25,8 @@ type remoteTarget struct {
 
 	obj *object.Object
 
+	payload io.Reader
+
 	clientConstructor ClientConstructor
 }
 
@@ -40,6 +43,8 @@ type RemotePutPrm struct {
 	node netmap.NodeInfo
 
 	obj *object.Object
+
+	payload io.Reader
 }
 
 func (t *remoteTarget) WriteHeader(obj *object.Object) error {
@@ -78,6 +83,10 @@ func (t *remoteTarget) Close() (*transformer.AccessIdentifiers, error) {
 	prm.SetXHeaders(t.commonPrm.XHeaders())
 	prm.SetObject(t.obj)
 
+	if t.payload != nil {
+		prm.SetPayloadReader(t.payload)
+	}
+
 	res, err := internalclient.PutObject(prm)
 	if err != nil {
 		return nil, fmt.Errorf("(%T) could not put object to %s: %w", t, t.nodeInfo.AddressGroup(), err)
@@ -113,12 +122,24 @@ func (p *RemotePutPrm) WithObject(v *object.Object) *RemotePutPrm {
 	return p
 }
 
+// WithPayloadReader sets the reader of the transferred object payload.
+// If set, the payload is streamed from the reader and the object set
+// via WithObject is used as a header only.
+func (p *RemotePutPrm) WithPayloadReader(r io.Reader) *RemotePutPrm {
+	if p != nil {
+		p.payload = r
+	}
+
+	return p
+}
+
 // PutObject sends object to remote node.
 func (s *RemoteSender) PutObject(ctx context.Context, p *RemotePutPrm) error {
 	t := &remoteTarget{
 		ctx:               ctx,
 		keyStorage:        s.keyStorage,
 		clientConstructor: s.clientConstructor,
+		payload:           p.payload,
 	}
 
 	err := clientcore.NodeInfoFromRawNetmapElement(&t.nodeInfo, netmapCore.Node(p.node))
@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasure"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
 	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
@@ -184,9 +185,59 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 	// set placement builder
 	prm.traverseOpts = append(prm.traverseOpts, placement.UseBuilder(builder))
 
+	if !prm.common.LocalOnly() {
+		prm.erasure, err = p.erasureCoding(prm.cnr)
+		if err != nil {
+			return fmt.Errorf("(%T) could not prepare erasure coding: %w", p, err)
+		}
+	}
+
 	return nil
 }
 
+// erasureCoding returns the parameters of the erasure coding of the container
+// objects. Returns nil if the container objects are replicated.
+func (p *Streamer) erasureCoding(cnr containerSDK.Container) (*erasureCoding, error) {
+	rule, ok, err := erasure.ContainerRule(cnr)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	coder, err := erasure.NewCoder(rule.DataParts, rule.ParityParts)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := p.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not receive node key: %w", err)
+	}
+
+	return &erasureCoding{
+		coder:                  coder,
+		withoutHomomorphicHash: containerSDK.IsHomomorphicHashingDisabled(cnr),
+		key:                    key,
+		networkState:           p.networkState,
+		nodeTargetInitializer: func(node nodeDesc) preparedObjectTarget {
+			if node.local {
+				return &localTarget{
+					storage: p.localStore,
+				}
+			}
+
+			rt := &remoteTarget{
+				ctx:               p.ctx,
+				keyStorage:        p.keyStorage,
+				clientConstructor: p.clientConstructor,
+			}
+
+			client.NodeInfoFromNetmapElement(&rt.nodeInfo, node.info)
+
+			return rt
+		},
+	}, nil
+}
+
 func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 	var relay func(nodeDesc) error
 	if p.relay != nil {
@@ -235,9 +286,10 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 
 			return rt
 		},
-		relay: relay,
-		fmt:   p.fmtValidator,
-		log:   p.log,
+		relay:   relay,
+		erasure: prm.erasure,
+		fmt:     p.fmtValidator,
+		log:     p.log,
 
 		isLocalKey: p.netmapKeys.IsLocalKey,
 	}
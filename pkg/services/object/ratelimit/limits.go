@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+)
+
+// Names of the object service methods.
+const (
+	MethodGet       = "get"
+	MethodPut       = "put"
+	MethodHead      = "head"
+	MethodSearch    = "search"
+	MethodDelete    = "delete"
+	MethodRange     = "range"
+	MethodRangeHash = "range_hash"
+)
+
+// Methods is a list of the object service methods which can be limited.
+var Methods = []string{
+	MethodGet,
+	MethodPut,
+	MethodHead,
+	MethodSearch,
+	MethodDelete,
+	MethodRange,
+	MethodRangeHash,
+}
+
+// Limits groups the limits of the object service. Zero value of
+// any limit means no limit.
+type Limits struct {
+	// Max number of the in-flight Get, GetRange, Search and Put streams.
+	MaxStreams uint32
+
+	// Max number of the payload bytes per second received in Put
+	// and sent in Get and GetRange streams. Streams exceeding the
+	// limit are slowed down.
+	MaxPayloadBandwidth uint64
+
+	// Max number of the requests per second signed by the same key.
+	MaxOwnerOpsPerSecond uint32
+
+	// Max number of the requests per second to the same container.
+	MaxContainerOpsPerSecond uint32
+
+	// Max number of the requests per second of the method.
+	MaxMethodOpsPerSecond map[string]uint32
+}
+
+// keyPruneInterval is an interval of removal of the unused limiters.
+// Limiter accumulates the resource for a second at most, so the limiter
+// unused for a second is equal to the new one.
+const keyPruneInterval = 10 * time.Second
+
+// keyedLimiters is a set of the request rate limiters with the same limit
+// for the different keys, e.g. request owners or containers.
+type keyedLimiters struct {
+	mtx sync.Mutex
+
+	limit float64
+
+	m map[string]*keyedLimiter
+
+	lastPrune time.Time
+}
+
+type keyedLimiter struct {
+	lim *rate.Limiter
+
+	used time.Time
+}
+
+func (x *keyedLimiters) setLimit(limit uint32) {
+	x.mtx.Lock()
+	x.limit = float64(limit)
+	x.m = make(map[string]*keyedLimiter)
+	x.mtx.Unlock()
+}
+
+// get returns the limiter of the requests with the key. Returns nil if
+// the requests are not limited.
+func (x *keyedLimiters) get(key string) *rate.Limiter {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.limit <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	if now.Sub(x.lastPrune) >= keyPruneInterval {
+		for k, l := range x.m {
+			if now.Sub(l.used) >= time.Second {
+				delete(x.m, k)
+			}
+		}
+
+		x.lastPrune = now
+	}
+
+	l, ok := x.m[key]
+	if !ok {
+		l = &keyedLimiter{lim: rate.NewLimiter(x.limit)}
+		x.m[key] = l
+	}
+
+	l.used = now
+
+	return l.lim
+}
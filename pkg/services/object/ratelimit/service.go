@@ -0,0 +1,429 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	objectSvc "github.com/nspcc-dev/neofs-node/pkg/services/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"go.uber.org/atomic"
+)
+
+// Reasons of the request rejection.
+const (
+	ReasonMethod    = "method"
+	ReasonOwner     = "owner"
+	ReasonContainer = "container"
+	ReasonStreams   = "streams"
+)
+
+// MetricRegister tracks the rejected requests.
+type MetricRegister interface {
+	AddRejectedRequest(method, reason string)
+}
+
+// SystemKeyChecker checks if the request is sent by the system node.
+type SystemKeyChecker interface {
+	// IsSystemKey checks if the public key belongs to the Inner Ring
+	// node or to the node of the container.
+	IsSystemKey(key []byte, cnr cid.ID) bool
+}
+
+// Service is an object service wrapper which enforces the request rate
+// limits per method, request owner key and container, limits the number
+// of the in-flight streams and the payload bandwidth.
+//
+// Requests of the system nodes (policer checks, replication, request
+// forwarding) are not limited if SystemKeyChecker is set.
+//
+// Rejected requests are answered with the LimitExceeded status, so Service
+// must be placed behind the signing service.
+type Service struct {
+	next objectSvc.ServiceServer
+
+	metrics MetricRegister
+
+	systemKeys SystemKeyChecker
+
+	methods map[string]*keyedLimiters
+
+	owners, containers keyedLimiters
+
+	// serializes the checks of the request rate limits
+	checkMtx sync.Mutex
+
+	maxStreams atomic.Uint32
+	streams    atomic.Uint32
+
+	payload *rate.Limiter
+}
+
+// Option is a Service's constructor option.
+type Option func(*Service)
+
+// WithMetrics returns an option to track the rejected requests.
+func WithMetrics(m MetricRegister) Option {
+	return func(s *Service) {
+		s.metrics = m
+	}
+}
+
+// WithSystemKeyChecker returns an option to exclude the requests of the
+// system nodes from the limits.
+func WithSystemKeyChecker(c SystemKeyChecker) Option {
+	return func(s *Service) {
+		s.systemKeys = c
+	}
+}
+
+// New creates, initializes and returns the Service with the given limits.
+func New(next objectSvc.ServiceServer, limits Limits, opts ...Option) *Service {
+	s := &Service{
+		next:    next,
+		methods: make(map[string]*keyedLimiters, len(Methods)),
+		payload: rate.NewLimiter(0),
+	}
+
+	for i := range Methods {
+		s.methods[Methods[i]] = new(keyedLimiters)
+	}
+
+	for i := range opts {
+		opts[i](s)
+	}
+
+	s.SetLimits(limits)
+
+	return s
+}
+
+// SetLimits changes the limits of the Service. Request rate counters
+// are reset.
+func (s *Service) SetLimits(limits Limits) {
+	for method, l := range s.methods {
+		l.setLimit(limits.MaxMethodOpsPerSecond[method])
+	}
+
+	s.owners.setLimit(limits.MaxOwnerOpsPerSecond)
+	s.containers.setLimit(limits.MaxContainerOpsPerSecond)
+	s.maxStreams.Store(limits.MaxStreams)
+	s.payload.SetLimit(float64(limits.MaxPayloadBandwidth))
+}
+
+func (s *Service) reject(method, reason string) error {
+	if s.metrics != nil {
+		s.metrics.AddRejectedRequest(method, reason)
+	}
+
+	return LimitExceeded{method: method, reason: reason}
+}
+
+// isSystem checks if the request is sent by the system node. Forwarded
+// requests are checked by the key of the forwarding node.
+func (s *Service) isSystem(verify *session.RequestVerificationHeader, cnr *refs.ContainerID) bool {
+	if s.systemKeys == nil || cnr == nil {
+		return false
+	}
+
+	key := verify.GetMetaSignature().GetKey()
+	if key == nil {
+		return false
+	}
+
+	var id cid.ID
+	if err := id.ReadFromV2(*cnr); err != nil {
+		return false
+	}
+
+	return s.systemKeys.IsSystemKey(key, id)
+}
+
+// check checks the request rate limits. Request consumes the resource of
+// all its limiters only if it fits all of them, so the rejected request
+// does not reduce the rate of other requests with the same method, owner
+// or container.
+func (s *Service) check(method string, verify *session.RequestVerificationHeader, cnr *refs.ContainerID) error {
+	var (
+		lims    [3]*rate.Limiter
+		reasons = [3]string{ReasonMethod, ReasonOwner, ReasonContainer}
+	)
+
+	lims[0] = s.methods[method].get(method)
+
+	if key := originalSenderKey(verify); key != nil {
+		lims[1] = s.owners.get(string(key))
+	}
+
+	if cnr != nil {
+		lims[2] = s.containers.get(string(cnr.GetValue()))
+	}
+
+	// limiters are consumed by check only, so the resource available
+	// under the lock can be consumed without the re-check
+	s.checkMtx.Lock()
+	defer s.checkMtx.Unlock()
+
+	for i := range lims {
+		if lims[i] != nil && !lims[i].Available(1) {
+			return s.reject(method, reasons[i])
+		}
+	}
+
+	for i := range lims {
+		if lims[i] != nil {
+			lims[i].Allow(1)
+		}
+	}
+
+	return nil
+}
+
+// acquireStream occupies the stream slot. Returned function must be
+// called to free the slot.
+func (s *Service) acquireStream(method string) (func(), error) {
+	max := s.maxStreams.Load()
+
+	if n := s.streams.Inc(); max > 0 && n > max {
+		s.streams.Dec()
+		return nil, s.reject(method, ReasonStreams)
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			s.streams.Dec()
+		})
+	}, nil
+}
+
+func originalSenderKey(v *session.RequestVerificationHeader) []byte {
+	if v == nil {
+		return nil
+	}
+
+	for v.GetOrigin() != nil {
+		v = v.GetOrigin()
+	}
+
+	return v.GetBodySignature().GetKey()
+}
+
+func (s *Service) Get(req *object.GetRequest, stream objectSvc.GetObjectStream) error {
+	verify, cnr := req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID()
+	if s.isSystem(verify, cnr) {
+		return s.next.Get(req, stream)
+	}
+
+	err := s.check(MethodGet, verify, cnr)
+	if err != nil {
+		return err
+	}
+
+	release, err := s.acquireStream(MethodGet)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return s.next.Get(req, &getStream{
+		GetObjectStream: stream,
+		payload:         s.payload,
+	})
+}
+
+func (s *Service) Put(ctx context.Context) (objectSvc.PutObjectStream, error) {
+	stream, err := s.next.Put(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &putStream{
+		ctx:    ctx,
+		svc:    s,
+		stream: stream,
+	}, nil
+}
+
+func (s *Service) Head(ctx context.Context, req *object.HeadRequest) (*object.HeadResponse, error) {
+	verify, cnr := req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID()
+	if !s.isSystem(verify, cnr) {
+		err := s.check(MethodHead, verify, cnr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.next.Head(ctx, req)
+}
+
+func (s *Service) Search(req *object.SearchRequest, stream objectSvc.SearchStream) error {
+	verify, cnr := req.GetVerificationHeader(), req.GetBody().GetContainerID()
+	if s.isSystem(verify, cnr) {
+		return s.next.Search(req, stream)
+	}
+
+	err := s.check(MethodSearch, verify, cnr)
+	if err != nil {
+		return err
+	}
+
+	release, err := s.acquireStream(MethodSearch)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return s.next.Search(req, stream)
+}
+
+func (s *Service) Delete(ctx context.Context, req *object.DeleteRequest) (*object.DeleteResponse, error) {
+	verify, cnr := req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID()
+	if !s.isSystem(verify, cnr) {
+		err := s.check(MethodDelete, verify, cnr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.next.Delete(ctx, req)
+}
+
+func (s *Service) GetRange(req *object.GetRangeRequest, stream objectSvc.GetObjectRangeStream) error {
+	verify, cnr := req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID()
+	if s.isSystem(verify, cnr) {
+		return s.next.GetRange(req, stream)
+	}
+
+	err := s.check(MethodRange, verify, cnr)
+	if err != nil {
+		return err
+	}
+
+	release, err := s.acquireStream(MethodRange)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return s.next.GetRange(req, &getRangeStream{
+		GetObjectRangeStream: stream,
+		payload:              s.payload,
+	})
+}
+
+func (s *Service) GetRangeHash(ctx context.Context, req *object.GetRangeHashRequest) (*object.GetRangeHashResponse, error) {
+	verify, cnr := req.GetVerificationHeader(), req.GetBody().GetAddress().GetContainerID()
+	if !s.isSystem(verify, cnr) {
+		err := s.check(MethodRangeHash, verify, cnr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.next.GetRangeHash(ctx, req)
+}
+
+type getStream struct {
+	objectSvc.GetObjectStream
+
+	payload *rate.Limiter
+}
+
+func (s *getStream) Send(resp *object.GetResponse) error {
+	if chunk, ok := resp.GetBody().GetObjectPart().(*object.GetObjectPartChunk); ok {
+		if err := s.payload.Wait(s.Context(), float64(len(chunk.GetChunk()))); err != nil {
+			return err
+		}
+	}
+
+	return s.GetObjectStream.Send(resp)
+}
+
+type getRangeStream struct {
+	objectSvc.GetObjectRangeStream
+
+	payload *rate.Limiter
+}
+
+func (s *getRangeStream) Send(resp *object.GetRangeResponse) error {
+	if chunk, ok := resp.GetBody().GetRangePart().(*object.GetRangePartChunk); ok {
+		if err := s.payload.Wait(s.Context(), float64(len(chunk.GetChunk()))); err != nil {
+			return err
+		}
+	}
+
+	return s.GetObjectRangeStream.Send(resp)
+}
+
+type putStream struct {
+	ctx context.Context
+
+	svc *Service
+
+	stream objectSvc.PutObjectStream
+
+	// limited is set if the stream is sent by the non-system node
+	limited bool
+
+	// release frees the stream slot, it is nil until the slot is occupied
+	release func()
+}
+
+func (s *putStream) Send(req *object.PutRequest) error {
+	switch part := req.GetBody().GetObjectPart().(type) {
+	case *object.PutObjectPartInit:
+		if err := s.init(req.GetVerificationHeader(), part.GetHeader().GetContainerID()); err != nil {
+			return err
+		}
+	case *object.PutObjectPartChunk:
+		if s.limited {
+			if err := s.svc.payload.Wait(s.ctx, float64(len(part.GetChunk()))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.stream.Send(req)
+}
+
+// init checks the request rate limits and occupies the stream slot when
+// the header of the object is received.
+func (s *putStream) init(verify *session.RequestVerificationHeader, cnr *refs.ContainerID) error {
+	if s.release != nil || s.svc.isSystem(verify, cnr) {
+		return nil
+	}
+
+	s.limited = true
+
+	err := s.svc.check(MethodPut, verify, cnr)
+	if err != nil {
+		return err
+	}
+
+	s.release, err = s.svc.acquireStream(MethodPut)
+	if err != nil {
+		return err
+	}
+
+	// stream may be abandoned without CloseAndRecv call,
+	// its context is done after the request in this case
+	go func(release func()) {
+		<-s.ctx.Done()
+		release()
+	}(s.release)
+
+	return nil
+}
+
+func (s *putStream) CloseAndRecv() (*object.PutResponse, error) {
+	if s.release != nil {
+		defer s.release()
+	}
+
+	return s.stream.CloseAndRecv()
+}
@@ -0,0 +1,286 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+	objectSvc "github.com/nspcc-dev/neofs-node/pkg/services/object"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct {
+	objectSvc.ServiceServer
+
+	searchCh chan struct{}
+}
+
+func (x *testService) Head(context.Context, *object.HeadRequest) (*object.HeadResponse, error) {
+	return new(object.HeadResponse), nil
+}
+
+func (x *testService) Search(*object.SearchRequest, objectSvc.SearchStream) error {
+	<-x.searchCh
+	return nil
+}
+
+func (x *testService) Put(context.Context) (objectSvc.PutObjectStream, error) {
+	return new(testPutStream), nil
+}
+
+type testPutStream struct{}
+
+func (testPutStream) Send(*object.PutRequest) error { return nil }
+
+func (testPutStream) CloseAndRecv() (*object.PutResponse, error) {
+	return new(object.PutResponse), nil
+}
+
+type testMetrics map[string]int
+
+func (m testMetrics) AddRejectedRequest(method, reason string) {
+	m[method+"/"+reason]++
+}
+
+func verificationHeader(key string) *session.RequestVerificationHeader {
+	var sig refs.Signature
+	sig.SetKey([]byte(key))
+
+	var v session.RequestVerificationHeader
+	v.SetBodySignature(&sig)
+	v.SetMetaSignature(&sig)
+
+	return &v
+}
+
+// forwardedHeader returns the verification header of the request of the
+// owner forwarded by the node.
+func forwardedHeader(node, owner string) *session.RequestVerificationHeader {
+	var sig refs.Signature
+	sig.SetKey([]byte(node))
+
+	var v session.RequestVerificationHeader
+	v.SetMetaSignature(&sig)
+	v.SetOrigin(verificationHeader(owner))
+
+	return &v
+}
+
+func headRequest(owner, cnr string) *object.HeadRequest {
+	var id refs.ContainerID
+	id.SetValue([]byte(cnr))
+
+	var addr refs.Address
+	addr.SetContainerID(&id)
+
+	var body object.HeadRequestBody
+	body.SetAddress(&addr)
+
+	var req object.HeadRequest
+	req.SetBody(&body)
+	req.SetVerificationHeader(verificationHeader(owner))
+
+	return &req
+}
+
+func putInitRequest(owner, cnr string) *object.PutRequest {
+	var id refs.ContainerID
+	id.SetValue([]byte(cnr))
+
+	var hdr object.Header
+	hdr.SetContainerID(&id)
+
+	var init object.PutObjectPartInit
+	init.SetHeader(&hdr)
+
+	var body object.PutRequestBody
+	body.SetObjectPart(&init)
+
+	var req object.PutRequest
+	req.SetBody(&body)
+	req.SetVerificationHeader(verificationHeader(owner))
+
+	return &req
+}
+
+func requireRejected(t *testing.T, err error) {
+	var st LimitExceeded
+	require.ErrorAs(t, err, &st)
+
+	stV2 := apistatus.ToStatusV2(apistatus.ErrToStatus(err))
+	require.EqualValues(t, 1024, stV2.Code())
+	require.True(t, strings.HasPrefix(stV2.Message(), MessageLimitExceeded))
+
+	var reason []byte
+	stV2.IterateDetails(func(d *status.Detail) bool {
+		if d.ID() == DetailIDLimitExceeded {
+			reason = d.Value()
+		}
+		return false
+	})
+	require.Equal(t, st.Reason(), string(reason))
+}
+
+func TestService_Rates(t *testing.T) {
+	ctx := context.Background()
+	m := make(testMetrics)
+
+	s := New(new(testService), Limits{
+		MaxOwnerOpsPerSecond:     2,
+		MaxContainerOpsPerSecond: 3,
+	}, WithMetrics(m))
+
+	_, err := s.Head(ctx, headRequest("owner1", "cnr1"))
+	require.NoError(t, err)
+	_, err = s.Head(ctx, headRequest("owner1", "cnr1"))
+	require.NoError(t, err)
+
+	_, err = s.Head(ctx, headRequest("owner1", "cnr2"))
+	requireRejected(t, err)
+	require.Equal(t, 1, m["head/owner"])
+
+	_, err = s.Head(ctx, headRequest("owner2", "cnr1"))
+	require.NoError(t, err)
+
+	_, err = s.Head(ctx, headRequest("owner3", "cnr1"))
+	requireRejected(t, err)
+	require.Equal(t, 1, m["head/container"])
+
+	for i := 0; i < 2; i++ {
+		_, err = s.Head(ctx, headRequest("owner3", "cnr2"))
+		require.NoError(t, err, "rejected request must not consume the owner limit")
+	}
+
+	t.Run("method", func(t *testing.T) {
+		s.SetLimits(Limits{
+			MaxMethodOpsPerSecond: map[string]uint32{MethodHead: 1},
+		})
+
+		_, err := s.Head(ctx, headRequest("owner1", "cnr1"))
+		require.NoError(t, err, "owner and container limits must be reloaded")
+
+		_, err = s.Head(ctx, headRequest("owner2", "cnr2"))
+		requireRejected(t, err)
+		require.Equal(t, 1, m["head/method"])
+
+		time.Sleep(time.Second)
+
+		_, err = s.Head(ctx, headRequest("owner2", "cnr2"))
+		require.NoError(t, err)
+	})
+}
+
+func TestService_Streams(t *testing.T) {
+	next := &testService{searchCh: make(chan struct{})}
+	m := make(testMetrics)
+
+	s := New(next, Limits{MaxStreams: 1}, WithMetrics(m))
+
+	var req object.SearchRequest
+
+	done := make(chan error)
+	go func() {
+		done <- s.Search(&req, nil)
+	}()
+
+	require.Eventually(t, func() bool {
+		return s.streams.Load() == 1
+	}, time.Second, time.Millisecond)
+
+	requireRejected(t, s.Search(&req, nil))
+	require.Equal(t, 1, m["search/streams"])
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	putReq := putInitRequest("owner", "cnr")
+
+	stream, err := s.Put(ctx)
+	require.NoError(t, err)
+	requireRejected(t, stream.Send(putReq))
+
+	close(next.searchCh)
+	require.NoError(t, <-done)
+
+	t.Run("put", func(t *testing.T) {
+		stream, err := s.Put(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(putReq))
+
+		other, err := s.Put(ctx)
+		require.NoError(t, err)
+		requireRejected(t, other.Send(putReq))
+
+		_, err = stream.CloseAndRecv()
+		require.NoError(t, err)
+
+		stream, err = s.Put(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(putReq))
+
+		// abandoned stream
+		cancel()
+
+		require.Eventually(t, func() bool {
+			return s.streams.Load() == 0
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestService_PutInit(t *testing.T) {
+	s := New(new(testService), Limits{MaxOwnerOpsPerSecond: 1})
+
+	req := putInitRequest("owner", "cnr")
+
+	for i, ok := range []bool{true, false} {
+		stream, err := s.Put(context.Background())
+		require.NoError(t, err)
+
+		err = stream.Send(req)
+		if ok {
+			require.NoError(t, err, i)
+		} else {
+			requireRejected(t, err)
+		}
+	}
+}
+
+type testSystemKeys string
+
+func (x testSystemKeys) IsSystemKey(key []byte, _ cid.ID) bool {
+	return string(key) == string(x)
+}
+
+func TestService_SystemKeys(t *testing.T) {
+	ctx := context.Background()
+	cnr := string(make([]byte, sha256.Size))
+
+	s := New(new(testService), Limits{
+		MaxOwnerOpsPerSecond:     1,
+		MaxContainerOpsPerSecond: 1,
+	}, WithSystemKeyChecker(testSystemKeys("node")))
+
+	for i := 0; i < 3; i++ {
+		_, err := s.Head(ctx, headRequest("node", cnr))
+		require.NoError(t, err)
+
+		req := headRequest("", cnr)
+		req.SetVerificationHeader(forwardedHeader("node", "owner"))
+
+		_, err = s.Head(ctx, req)
+		require.NoError(t, err)
+	}
+
+	_, err := s.Head(ctx, headRequest("owner", cnr))
+	require.NoError(t, err, "requests of the system nodes must not be counted")
+
+	_, err = s.Head(ctx, headRequest("owner", cnr))
+	requireRejected(t, err)
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+)
+
+// MessageLimitExceeded is a prefix of the message of the status returned
+// for the requests rejected by the limits. Full message has the format
+// "<MessageLimitExceeded>: <method>: <reason>".
+const MessageLimitExceeded = "request rate limit exceeded"
+
+// DetailIDLimitExceeded is an identifier of the status detail carrying
+// the reason of the request rejection (one of the Reason* constants).
+const DetailIDLimitExceeded = 0x524c // "RL"
+
+// LimitExceeded describes failure status of the request rejected by the
+// limits of the Service. NeoFS API has no dedicated code for such failure,
+// so the status has INTERNAL code and is distinguished by the message
+// prefixed with MessageLimitExceeded and the DetailIDLimitExceeded detail.
+//
+// LimitExceeded implements StatusV2 interface of the apistatus package.
+type LimitExceeded struct {
+	method, reason string
+}
+
+// Method returns name of the rejected method (one of the Method* constants).
+func (x LimitExceeded) Method() string {
+	return x.method
+}
+
+// Reason returns reason of the rejection (one of the Reason* constants).
+func (x LimitExceeded) Reason() string {
+	return x.reason
+}
+
+func (x LimitExceeded) message() string {
+	return fmt.Sprintf("%s: %s: %s", MessageLimitExceeded, x.method, x.reason)
+}
+
+func (x LimitExceeded) Error() string {
+	return fmt.Sprintf("status: code = %v message = %s", internalCode(), x.message())
+}
+
+// ToStatusV2 returns the status message with
+//   - code: INTERNAL;
+//   - string message: MessageLimitExceeded, method and reason;
+//   - details: DetailIDLimitExceeded with the reason as a value.
+func (x LimitExceeded) ToStatusV2() *status.Status {
+	var detail status.Detail
+	detail.SetID(DetailIDLimitExceeded)
+	detail.SetValue([]byte(x.reason))
+
+	var st status.Status
+	st.SetCode(internalCode())
+	st.SetMessage(x.message())
+	st.AppendDetails(detail)
+
+	return &st
+}
+
+func internalCode() status.Code {
+	code := status.Internal
+	status.GlobalizeCommonFail(&code)
+
+	return code
+}
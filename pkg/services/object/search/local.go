@@ -1,11 +1,17 @@
 package searchsvc
 
 import (
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
 func (exec *execCtx) executeLocal() {
-	ids, err := exec.svc.localStorage.search(exec)
+	// identifiers are written as soon as they are selected
+	// in order not to keep the whole result in memory
+	err := exec.svc.localStorage.search(exec, func(ids []oid.ID) error {
+		exec.writeIDList(ids)
+		return exec.err
+	})
 
 	if err != nil {
 		exec.status = statusUndefined
@@ -14,9 +20,5 @@ func (exec *execCtx) executeLocal() {
 		exec.log.Debug("local operation failed",
 			zap.String("error", err.Error()),
 		)
-
-		return
 	}
-
-	exec.writeIDList(ids)
 }
@@ -0,0 +1,103 @@
+package searchsvc
+
+import (
+	"context"
+	"fmt"
+
+	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// RemoteSearcher represents utility for selecting
+// the objects stored on a remote host.
+type RemoteSearcher struct {
+	keyStorage *util.KeyStorage
+
+	clientConstructor ClientConstructor
+}
+
+// RemoteSearchPrm groups remote search operation parameters.
+type RemoteSearchPrm struct {
+	node netmap.NodeInfo
+
+	cnr cid.ID
+
+	filters object.SearchFilters
+}
+
+// NewRemoteSearcher creates, initializes and returns new RemoteSearcher instance.
+func NewRemoteSearcher(keyStorage *util.KeyStorage, cons ClientConstructor) *RemoteSearcher {
+	return &RemoteSearcher{
+		keyStorage:        keyStorage,
+		clientConstructor: cons,
+	}
+}
+
+// WithNodeInfo sets information about the remote node.
+func (p *RemoteSearchPrm) WithNodeInfo(v netmap.NodeInfo) *RemoteSearchPrm {
+	if p != nil {
+		p.node = v
+	}
+
+	return p
+}
+
+// WithContainerID sets identifier of the container to search the objects.
+func (p *RemoteSearchPrm) WithContainerID(v cid.ID) *RemoteSearchPrm {
+	if p != nil {
+		p.cnr = v
+	}
+
+	return p
+}
+
+// WithSearchFilters sets search filters.
+func (p *RemoteSearchPrm) WithSearchFilters(v object.SearchFilters) *RemoteSearchPrm {
+	if p != nil {
+		p.filters = v
+	}
+
+	return p
+}
+
+// Search selects the objects stored on the remote node on behalf of the local node.
+func (s *RemoteSearcher) Search(ctx context.Context, prm *RemoteSearchPrm) ([]oid.ID, error) {
+	key, err := s.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not receive private key: %w", s, err)
+	}
+
+	var info clientcore.NodeInfo
+
+	err = clientcore.NodeInfoFromRawNetmapElement(&info, netmapCore.Node(prm.node))
+	if err != nil {
+		return nil, fmt.Errorf("parse client node info: %w", err)
+	}
+
+	c, err := s.clientConstructor.Get(info)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not create SDK client %s: %w", s, info.AddressGroup(), err)
+	}
+
+	var searchPrm internalclient.SearchObjectsPrm
+
+	searchPrm.SetContext(ctx)
+	searchPrm.SetClient(c)
+	searchPrm.SetPrivateKey(key)
+	searchPrm.SetTTL(1)
+	searchPrm.SetContainerID(prm.cnr)
+	searchPrm.SetFilters(prm.filters)
+
+	res, err := internalclient.SearchObjects(searchPrm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not search objects in %s: %w", s, info.AddressGroup(), err)
+	}
+
+	return res.IDList(), nil
+}
@@ -102,13 +102,17 @@ func (c *testClientCache) get(info clientcore.NodeInfo) (searchClient, error) {
 	return v, nil
 }
 
-func (s *testStorage) search(exec *execCtx) ([]oid.ID, error) {
+func (s *testStorage) search(exec *execCtx, f func([]oid.ID) error) error {
 	v, ok := s.items[exec.containerID().EncodeToString()]
 	if !ok {
-		return nil, nil
+		return f(nil)
 	}
 
-	return v.ids, v.err
+	if v.err != nil {
+		return v.err
+	}
+
+	return f(v.ids)
 }
 
 func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oid.ID, error) {
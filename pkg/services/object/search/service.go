@@ -33,7 +33,10 @@ type cfg struct {
 	log *logger.Logger
 
 	localStorage interface {
-		search(*execCtx) ([]oid.ID, error)
+		// search passes the identifiers of the selected
+		// objects to the handler in batches. The handler
+		// is called at least once.
+		search(*execCtx, func([]oid.ID) error) error
 	}
 
 	clientConstructor interface {
@@ -119,17 +119,32 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 	return res.IDList(), nil
 }
 
-func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
+// localSearchBatchSize is the maximum number of object identifiers
+// selected from the local storage at once.
+const localSearchBatchSize = 1000
+
+func (e *storageEngineWrapper) search(exec *execCtx, f func([]oid.ID) error) error {
 	var selectPrm engine.SelectPrm
 	selectPrm.WithFilters(exec.searchFilters())
 	selectPrm.WithContainerID(exec.containerID())
+	selectPrm.WithLimit(localSearchBatchSize)
 
-	r, err := (*engine.StorageEngine)(e).Select(selectPrm)
-	if err != nil {
-		return nil, err
-	}
+	for {
+		r, err := (*engine.StorageEngine)(e).Select(selectPrm)
+		if err != nil {
+			return err
+		}
 
-	return idsFromAddresses(r.AddressList()), nil
+		if err = f(idsFromAddresses(r.AddressList())); err != nil {
+			return err
+		}
+
+		if r.Cursor() == nil {
+			return nil
+		}
+
+		selectPrm.WithCursor(r.Cursor())
+	}
 }
 
 func idsFromAddresses(addrs []oid.Address) []oid.ID {
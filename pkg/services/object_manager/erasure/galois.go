@@ -0,0 +1,130 @@
+package erasure
+
+import (
+	"errors"
+)
+
+// GF(2^8) with x^8 + x^4 + x^3 + x^2 + 1 reducing polynomial.
+const gfPolynomial = 0x11d
+
+var (
+	gfExp [510]byte
+	gfLog [256]int
+
+	// gfMulTable[a][b] = a*b
+	gfMulTable [256][256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfExp[i+255] = byte(x)
+		gfLog[x] = i
+
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= gfPolynomial
+		}
+	}
+
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			gfMulTable[a][b] = gfMul(byte(a), byte(b))
+		}
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return gfExp[gfLog[a]+gfLog[b]]
+}
+
+func gfInv(a byte) byte {
+	return gfExp[255-gfLog[a]]
+}
+
+func gfPow(a byte, n int) byte {
+	if n == 0 {
+		return 1
+	} else if a == 0 {
+		return 0
+	}
+
+	return gfExp[gfLog[a]*n%255]
+}
+
+var errSingularMatrix = errors.New("matrix is singular")
+
+// invertMatrix inverts the square matrix using Gauss-Jordan elimination.
+func invertMatrix(m [][]byte) ([][]byte, error) {
+	n := len(m)
+
+	// augmented [m | I]
+	work := make([][]byte, n)
+	for i := range work {
+		work[i] = make([]byte, 2*n)
+		copy(work[i], m[i])
+		work[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && work[pivot][col] == 0 {
+			pivot++
+		}
+
+		if pivot == n {
+			return nil, errSingularMatrix
+		}
+
+		work[col], work[pivot] = work[pivot], work[col]
+
+		if v := work[col][col]; v != 1 {
+			inv := gfInv(v)
+			for j := range work[col] {
+				work[col][j] = gfMul(work[col][j], inv)
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			if i == col || work[i][col] == 0 {
+				continue
+			}
+
+			f := work[i][col]
+			for j := range work[i] {
+				work[i][j] ^= gfMul(f, work[col][j])
+			}
+		}
+	}
+
+	res := make([][]byte, n)
+	for i := range res {
+		res[i] = work[i][n:]
+	}
+
+	return res, nil
+}
+
+func multiplyMatrices(a, b [][]byte) [][]byte {
+	res := make([][]byte, len(a))
+
+	for i := range a {
+		res[i] = make([]byte, len(b[0]))
+
+		for j := range res[i] {
+			var v byte
+			for k := range b {
+				v ^= gfMul(a[i][k], b[k][j])
+			}
+
+			res[i][j] = v
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,277 @@
+package erasure
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// Attributes of the object parts.
+const (
+	// AttributeParent is an ID of the erasure-coded object.
+	AttributeParent = "__NEOFS__EC_PARENT"
+	// AttributeIndex is an index of the part: data parts go first.
+	AttributeIndex = "__NEOFS__EC_INDEX"
+	// AttributeHeader is a base64-encoded header of the erasure-coded object.
+	AttributeHeader = "__NEOFS__EC_HEADER"
+)
+
+// Applicable checks if the object is erasure-coded in the container with
+// erasure coding rule. Only regular objects are coded. The objects carrying
+// the parent header (last child and linking object of the split-chain) are
+// replicated, so the split info of the parent object can be found by the
+// container nodes.
+func Applicable(obj *object.Object) bool {
+	if obj.Type() != object.TypeRegular || obj.Parent() != nil {
+		return false
+	}
+
+	// parts are not coded again
+	for _, a := range obj.Attributes() {
+		if a.Key() == AttributeParent {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PartInfo describes the part of the erasure-coded object.
+type PartInfo struct {
+	// ID of the erasure-coded object.
+	Parent oid.ID
+	// Index of the part.
+	Index int
+}
+
+// ReadPartInfo reads the part information from the object attributes.
+// Returns false if the object is not a part of the erasure-coded object.
+func ReadPartInfo(obj *object.Object) (PartInfo, bool, error) {
+	var (
+		res                PartInfo
+		withPar, withIndex bool
+	)
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeParent:
+			if err := res.Parent.DecodeString(a.Value()); err != nil {
+				return res, false, fmt.Errorf("invalid parent ID: %w", err)
+			}
+
+			withPar = true
+		case AttributeIndex:
+			var err error
+
+			res.Index, err = strconv.Atoi(a.Value())
+			if err != nil || res.Index < 0 || res.Index >= MaxParts {
+				return res, false, fmt.Errorf("invalid part index '%s'", a.Value())
+			}
+
+			withIndex = true
+		}
+	}
+
+	switch {
+	case !withPar && !withIndex:
+		return res, false, nil
+	case !withPar:
+		return res, false, errors.New("missing parent ID")
+	case !withIndex:
+		return res, false, errors.New("missing part index")
+	}
+
+	return res, true, nil
+}
+
+// FormParts encodes the object and returns the parts in the index order.
+// Part headers contain container and attributes, the payload is
+// set along with its size and checksums. The caller is responsible for
+// the rest of the header fields, ID and signature. Expiration epoch of
+// the object is inherited by the parts.
+func FormParts(c *Coder, obj *object.Object, withoutHomomorphicHash bool) ([]*object.Object, error) {
+	id, ok := obj.ID()
+	if !ok {
+		return nil, errors.New("missing object ID")
+	}
+
+	cnr, ok := obj.ContainerID()
+	if !ok {
+		return nil, errors.New("missing container ID")
+	}
+
+	hdr, err := obj.CutPayload().Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode object header: %w", err)
+	}
+
+	commonAttrs := make([]object.Attribute, 2, 3)
+	commonAttrs[0].SetKey(AttributeParent)
+	commonAttrs[0].SetValue(id.EncodeToString())
+	commonAttrs[1].SetKey(AttributeHeader)
+	commonAttrs[1].SetValue(base64.StdEncoding.EncodeToString(hdr))
+
+	for _, a := range obj.Attributes() {
+		if a.Key() == objectV2.SysAttributeExpEpoch {
+			commonAttrs = append(commonAttrs, a)
+			break
+		}
+	}
+
+	payloads := c.Encode(obj.Payload())
+	parts := make([]*object.Object, len(payloads))
+
+	for i := range payloads {
+		var idx object.Attribute
+		idx.SetKey(AttributeIndex)
+		idx.SetValue(strconv.Itoa(i))
+
+		part := object.New()
+		part.SetContainerID(cnr)
+		part.SetAttributes(append(commonAttrs[:len(commonAttrs):len(commonAttrs)], idx)...)
+		part.SetPayload(payloads[i])
+		part.SetPayloadSize(uint64(len(payloads[i])))
+
+		var cs checksum.Checksum
+
+		checksum.Calculate(&cs, checksum.SHA256, payloads[i])
+		part.SetPayloadChecksum(cs)
+
+		if !withoutHomomorphicHash {
+			checksum.Calculate(&cs, checksum.TZ, payloads[i])
+			part.SetPayloadHomomorphicHash(cs)
+		}
+
+		parts[i] = part
+	}
+
+	return parts, nil
+}
+
+// SignPart completes the part header on behalf of the node which saves the
+// part: the node becomes the owner, the part is identified and signed.
+func SignPart(part *object.Object, key ecdsa.PrivateKey, epoch uint64) error {
+	var owner user.ID
+	user.IDFromKey(&owner, key.PublicKey)
+
+	ver := version.Current()
+
+	part.SetVersion(&ver)
+	part.SetOwnerID(&owner)
+	part.SetCreationEpoch(epoch)
+
+	if err := object.CalculateAndSetID(part); err != nil {
+		return fmt.Errorf("could not calculate part ID: %w", err)
+	}
+
+	if err := object.CalculateAndSetSignature(key, part); err != nil {
+		return fmt.Errorf("could not sign part: %w", err)
+	}
+
+	return nil
+}
+
+// Restore restores the erasure-coded object from the parts placed by their
+// indices, missing parts must be nil. Returns ErrTooFewParts if there are
+// not enough parts to restore the payload.
+func Restore(c *Coder, parts []*object.Object) (*object.Object, error) {
+	if len(parts) != c.DataParts()+c.ParityParts() {
+		return nil, fmt.Errorf("wrong number of parts: expected %d, has %d", c.DataParts()+c.ParityParts(), len(parts))
+	}
+
+	var (
+		obj      *object.Object
+		payloads = make([][]byte, len(parts))
+	)
+
+	for i := range parts {
+		if parts[i] == nil {
+			continue
+		}
+
+		if obj == nil {
+			var err error
+
+			obj, err = Header(parts[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		info, ok, err := ReadPartInfo(parts[i])
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("invalid part #%d: %w", i, err)
+		case !ok:
+			return nil, fmt.Errorf("object #%d is not a part of the erasure-coded object", i)
+		case info.Index != i:
+			return nil, fmt.Errorf("part #%d is placed at %d", info.Index, i)
+		}
+
+		if id, _ := obj.ID(); !info.Parent.Equals(id) {
+			return nil, fmt.Errorf("part #%d belongs to another object %s", i, info.Parent)
+		}
+
+		// payload is reconstructed in place
+		payloads[i] = append([]byte(nil), parts[i].Payload()...)
+	}
+
+	if obj == nil {
+		return nil, ErrTooFewParts
+	}
+
+	if err := c.Reconstruct(payloads); err != nil {
+		return nil, err
+	}
+
+	payload, err := c.Join(payloads, int(obj.PayloadSize()))
+	if err != nil {
+		return nil, err
+	}
+
+	obj.SetPayload(payload)
+
+	if err := object.VerifyPayloadChecksum(obj); err != nil {
+		return nil, fmt.Errorf("restored payload: %w", err)
+	}
+
+	return obj, nil
+}
+
+// Header decodes the header of the erasure-coded object stored in the
+// part and checks its ID and signature, so the parts formed by a container
+// node can't substitute the header of the original object.
+func Header(part *object.Object) (*object.Object, error) {
+	for _, a := range part.Attributes() {
+		if a.Key() != AttributeHeader {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(a.Value())
+		if err != nil {
+			return nil, fmt.Errorf("invalid object header encoding: %w", err)
+		}
+
+		obj := object.New()
+		if err := obj.Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("invalid object header: %w", err)
+		}
+
+		if err := object.CheckHeaderVerificationFields(obj); err != nil {
+			return nil, fmt.Errorf("object header: %w", err)
+		}
+
+		return obj, nil
+	}
+
+	return nil, errors.New("missing object header in the part")
+}
@@ -0,0 +1,223 @@
+package erasure
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
+	"strconv"
+	"testing"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRule(t *testing.T) {
+	r, err := ParseRule("3.2")
+	require.NoError(t, err)
+	require.Equal(t, Rule{DataParts: 3, ParityParts: 2}, r)
+	require.Equal(t, "3.2", r.String())
+	require.Equal(t, 5, r.Parts())
+
+	for _, s := range []string{"", "3", "3.2.1", "a.2", "3.b", "0.2", "3.0", "-1.2", "250.10"} {
+		_, err := ParseRule(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestFormParts(t *testing.T) {
+	const expEpoch = "100"
+
+	var exp, attr object.Attribute
+
+	exp.SetKey(objectV2.SysAttributeExpEpoch)
+	exp.SetValue(expEpoch)
+	attr.SetKey("key")
+	attr.SetValue("value")
+
+	payload := make([]byte, 1000)
+	_, _ = rand.Read(payload)
+
+	obj := object.New()
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwnerID(usertest.ID())
+	obj.SetAttributes(attr, exp)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+	object.CalculateAndSetPayloadChecksum(obj)
+	require.NoError(t, object.CalculateAndSetID(obj))
+	signObject(t, obj)
+
+	require.True(t, Applicable(obj))
+
+	c, err := NewCoder(3, 2)
+	require.NoError(t, err)
+
+	parts, err := FormParts(c, obj, false)
+	require.NoError(t, err)
+	require.Len(t, parts, 5)
+
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+
+	for i, part := range parts {
+		require.False(t, Applicable(part))
+
+		partCnr, _ := part.ContainerID()
+		require.Equal(t, cnr, partCnr)
+		require.EqualValues(t, c.PartSize(len(payload)), part.PayloadSize())
+		require.NoError(t, object.VerifyPayloadChecksum(part))
+
+		_, ok := part.PayloadHomomorphicHash()
+		require.True(t, ok)
+
+		info, ok, err := ReadPartInfo(part)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, PartInfo{Parent: id, Index: i}, info)
+
+		var partExp string
+		for _, a := range part.Attributes() {
+			if a.Key() == objectV2.SysAttributeExpEpoch {
+				partExp = a.Value()
+			}
+		}
+		require.Equal(t, expEpoch, partExp)
+	}
+
+	_, ok, err := ReadPartInfo(obj)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err := Restore(c, []*object.Object{nil, parts[1], nil, parts[3], parts[4]})
+		require.NoError(t, err)
+
+		restoredID, ok := restored.ID()
+		require.True(t, ok)
+		require.Equal(t, id, restoredID)
+		require.Equal(t, payload, restored.Payload())
+		require.Equal(t, obj.Attributes(), restored.Attributes())
+
+		_, err = Restore(c, []*object.Object{nil, parts[1], nil, parts[3], nil})
+		require.ErrorIs(t, err, ErrTooFewParts)
+
+		_, err = Restore(c, []*object.Object{parts[1], parts[0], parts[2], nil, nil})
+		require.Error(t, err)
+	})
+
+	t.Run("corrupted part", func(t *testing.T) {
+		corrupted := object.New()
+		corrupted.SetAttributes(parts[0].Attributes()...)
+		corrupted.SetPayload(make([]byte, parts[0].PayloadSize()))
+
+		_, err := Restore(c, []*object.Object{corrupted, parts[1], parts[2], nil, nil})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid header signature", func(t *testing.T) {
+		other := object.New()
+		other.SetID(oidtest.ID())
+		signObject(t, other)
+
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		forged := object.New()
+		require.NoError(t, forged.Unmarshal(data))
+		forged.SetSignature(other.Signature())
+
+		forgedParts, err := FormParts(c, forged, false)
+		require.NoError(t, err)
+
+		_, err = Header(forgedParts[0])
+		require.Error(t, err)
+
+		forged.SetSignature(nil)
+
+		forgedParts, err = FormParts(c, forged, false)
+		require.NoError(t, err)
+
+		_, err = Restore(c, []*object.Object{forgedParts[0], forgedParts[1], forgedParts[2], nil, nil})
+		require.Error(t, err)
+	})
+
+	t.Run("not applicable", func(t *testing.T) {
+		tomb := object.New()
+		tomb.SetType(object.TypeTombstone)
+		require.False(t, Applicable(tomb))
+
+		child := object.New()
+		child.SetParent(obj)
+		require.False(t, Applicable(child))
+	})
+
+	t.Run("invalid part info", func(t *testing.T) {
+		var par, idx object.Attribute
+
+		par.SetKey(AttributeParent)
+		par.SetValue(id.EncodeToString())
+		idx.SetKey(AttributeIndex)
+
+		for _, tc := range []struct {
+			name  string
+			attrs []object.Attribute
+		}{
+			{name: "missing index", attrs: []object.Attribute{par}},
+			{name: "invalid index", attrs: []object.Attribute{par, setValue(idx, "-1")}},
+			{name: "big index", attrs: []object.Attribute{par, setValue(idx, strconv.Itoa(MaxParts))}},
+			{name: "missing parent", attrs: []object.Attribute{setValue(idx, "1")}},
+			{name: "invalid parent", attrs: []object.Attribute{setValue(par, "parent"), setValue(idx, "1")}},
+		} {
+			part := object.New()
+			part.SetAttributes(tc.attrs...)
+
+			_, _, err := ReadPartInfo(part)
+			require.Error(t, err, tc.name)
+		}
+	})
+}
+
+func setValue(a object.Attribute, v string) object.Attribute {
+	a.SetValue(v)
+	return a
+}
+
+// signObject signs the object ID with a random key in the same format as
+// object.CalculateAndSetSignature does.
+func signObject(t *testing.T, obj *object.Object) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	id, ok := obj.ID()
+	require.True(t, ok)
+
+	var idV2 refs.ObjectID
+	id.WriteToV2(&idV2)
+
+	h := sha512.Sum512(idV2.StableMarshal(nil))
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, h[:])
+	require.NoError(t, err)
+
+	sign := make([]byte, 65)
+	sign[0] = 0x04
+	r.FillBytes(sign[1:33])
+	s.FillBytes(sign[33:])
+
+	var sigV2 refs.Signature
+	sigV2.SetKey(elliptic.MarshalCompressed(key.Curve, key.X, key.Y))
+	sigV2.SetSign(sign)
+	sigV2.SetScheme(refs.ECDSA_SHA512)
+
+	var sig neofscrypto.Signature
+	require.NoError(t, sig.ReadFromV2(sigV2))
+
+	obj.SetSignature(&sig)
+}
@@ -0,0 +1,211 @@
+package erasure
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxParts is a maximum total number of the data and parity parts.
+const MaxParts = 256
+
+// ErrTooFewParts is returned if there are not enough parts to restore the data.
+var ErrTooFewParts = errors.New("too few parts to restore the data")
+
+// Coder implements systematic Reed-Solomon code over GF(2^8): data parts
+// are stored as is, parity parts allow to restore any missing parts from
+// any set of the parts which is not smaller than the number of the data parts.
+//
+// Coder is safe for concurrent use.
+type Coder struct {
+	data, parity int
+
+	// (data+parity) x data matrix, top data rows form the identity matrix
+	matrix [][]byte
+}
+
+// NewCoder creates the coder with the specified number of data and parity parts.
+func NewCoder(data, parity int) (*Coder, error) {
+	switch {
+	case data <= 0:
+		return nil, errors.New("number of data parts must be positive")
+	case parity <= 0:
+		return nil, errors.New("number of parity parts must be positive")
+	case data+parity > MaxParts:
+		return nil, fmt.Errorf("total number of parts must not exceed %d", MaxParts)
+	}
+
+	vm := make([][]byte, data+parity)
+	for i := range vm {
+		vm[i] = make([]byte, data)
+		for j := range vm[i] {
+			vm[i][j] = gfPow(byte(i), j)
+		}
+	}
+
+	// any data rows of Vandermonde matrix are linearly independent,
+	// multiplication by the inverted top keeps it so
+	top, err := invertMatrix(vm[:data])
+	if err != nil {
+		// Vandermonde matrix with distinct points is not singular
+		panic(fmt.Sprintf("invert Vandermonde matrix: %v", err))
+	}
+
+	return &Coder{
+		data:   data,
+		parity: parity,
+		matrix: multiplyMatrices(vm, top),
+	}, nil
+}
+
+// DataParts returns the number of data parts.
+func (c *Coder) DataParts() int {
+	return c.data
+}
+
+// ParityParts returns the number of parity parts.
+func (c *Coder) ParityParts() int {
+	return c.parity
+}
+
+// PartSize returns the size of each part of the data of the specified size.
+func (c *Coder) PartSize(size int) int {
+	return (size + c.data - 1) / c.data
+}
+
+// Encode splits the data into the data parts padding the last one with zeros
+// and calculates the parity parts. The data parts share the memory with
+// the data if it is not padded.
+func (c *Coder) Encode(data []byte) [][]byte {
+	partSize := c.PartSize(len(data))
+
+	if len(data) < partSize*c.data {
+		padded := make([]byte, partSize*c.data)
+		copy(padded, data)
+		data = padded
+	}
+
+	parts := make([][]byte, c.data+c.parity)
+	for i := 0; i < c.data; i++ {
+		parts[i] = data[i*partSize : (i+1)*partSize : (i+1)*partSize]
+	}
+
+	for i := c.data; i < len(parts); i++ {
+		parts[i] = make([]byte, partSize)
+		c.encodeRow(c.matrix[i], parts[:c.data], parts[i])
+	}
+
+	return parts
+}
+
+// Reconstruct restores nil parts in place from the present ones. All
+// present parts must have the same size. Returns ErrTooFewParts if there
+// are less present parts than the data parts.
+func (c *Coder) Reconstruct(parts [][]byte) error {
+	if len(parts) != c.data+c.parity {
+		return fmt.Errorf("wrong number of parts: expected %d, has %d", c.data+c.parity, len(parts))
+	}
+
+	var (
+		partSize = -1
+		rows     = make([]int, 0, c.data)
+	)
+
+	for i := range parts {
+		if parts[i] == nil {
+			continue
+		}
+
+		if partSize < 0 {
+			partSize = len(parts[i])
+		} else if len(parts[i]) != partSize {
+			return fmt.Errorf("part #%d size %d differs from %d", i, len(parts[i]), partSize)
+		}
+
+		if len(rows) < c.data {
+			rows = append(rows, i)
+		}
+	}
+
+	if len(rows) < c.data {
+		return ErrTooFewParts
+	}
+
+	if rows[c.data-1] >= c.data {
+		// some data parts are missing
+		sub := make([][]byte, c.data)
+		present := make([][]byte, c.data)
+
+		for i, row := range rows {
+			sub[i] = c.matrix[row]
+			present[i] = parts[row]
+		}
+
+		dec, err := invertMatrix(sub)
+		if err != nil {
+			// any data rows of the coding matrix are linearly independent
+			panic(fmt.Sprintf("invert coding submatrix: %v", err))
+		}
+
+		for i := 0; i < c.data; i++ {
+			if parts[i] == nil {
+				parts[i] = make([]byte, partSize)
+				c.encodeRow(dec[i], present, parts[i])
+			}
+		}
+	}
+
+	for i := c.data; i < len(parts); i++ {
+		if parts[i] == nil {
+			parts[i] = make([]byte, partSize)
+			c.encodeRow(c.matrix[i], parts[:c.data], parts[i])
+		}
+	}
+
+	return nil
+}
+
+// Join writes the data of the specified size from the data parts.
+func (c *Coder) Join(parts [][]byte, size int) ([]byte, error) {
+	if len(parts) < c.data {
+		return nil, ErrTooFewParts
+	}
+
+	res := make([]byte, 0, size)
+
+	for i := 0; i < c.data && len(res) < size; i++ {
+		if parts[i] == nil {
+			return nil, fmt.Errorf("missing data part #%d", i)
+		}
+
+		part := parts[i]
+		if rem := size - len(res); len(part) > rem {
+			part = part[:rem]
+		}
+
+		res = append(res, part...)
+	}
+
+	if len(res) < size {
+		return nil, fmt.Errorf("data parts are shorter than %d", size)
+	}
+
+	return res, nil
+}
+
+// encodeRow writes linear combination of the parts with the row coefficients to dst.
+func (c *Coder) encodeRow(row []byte, parts [][]byte, dst []byte) {
+	for i := range dst {
+		dst[i] = 0
+	}
+
+	for j, coef := range row {
+		if coef == 0 {
+			continue
+		}
+
+		mulTable := gfMulTable[coef]
+		for i, b := range parts[j] {
+			dst[i] ^= mulTable[b]
+		}
+	}
+}
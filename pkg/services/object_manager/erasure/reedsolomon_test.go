@@ -0,0 +1,89 @@
+package erasure
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoder(t *testing.T) {
+	t.Run("invalid parameters", func(t *testing.T) {
+		_, err := NewCoder(0, 1)
+		require.Error(t, err)
+
+		_, err = NewCoder(1, 0)
+		require.Error(t, err)
+
+		_, err = NewCoder(200, 57)
+		require.Error(t, err)
+	})
+
+	for _, tc := range []struct {
+		data, parity, size int
+	}{
+		{data: 1, parity: 1, size: 10},
+		{data: 3, parity: 2, size: 1000},
+		{data: 4, parity: 2, size: 1001},
+		{data: 6, parity: 3, size: 5},
+		{data: 10, parity: 4, size: 0},
+		{data: 200, parity: 56, size: 4096},
+	} {
+		c, err := NewCoder(tc.data, tc.parity)
+		require.NoError(t, err)
+
+		data := make([]byte, tc.size)
+		_, _ = rand.Read(data)
+
+		parts := c.Encode(data)
+		require.Len(t, parts, tc.data+tc.parity)
+
+		for i := range parts {
+			require.Len(t, parts[i], c.PartSize(tc.size))
+		}
+
+		joined, err := c.Join(parts, tc.size)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(data, joined))
+
+		// lose parity parts from both ends to touch every row
+		for _, lost := range [][]int{firstN(tc.parity), lastN(tc.data+tc.parity, tc.parity)} {
+			damaged := make([][]byte, len(parts))
+			copy(damaged, parts)
+
+			for _, i := range lost {
+				damaged[i] = nil
+			}
+
+			require.NoError(t, c.Reconstruct(damaged))
+
+			for i := range parts {
+				require.True(t, bytes.Equal(parts[i], damaged[i]), "%d.%d part #%d", tc.data, tc.parity, i)
+			}
+		}
+
+		damaged := make([][]byte, len(parts))
+		copy(damaged, parts[tc.parity+1:])
+
+		require.ErrorIs(t, c.Reconstruct(damaged), ErrTooFewParts)
+	}
+}
+
+func firstN(n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i
+	}
+
+	return res
+}
+
+func lastN(total, n int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = total - n + i
+	}
+
+	return res
+}
@@ -0,0 +1,73 @@
+package erasure
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+)
+
+// ContainerAttribute is a container attribute which enables erasure coding
+// of the container objects. Its value has "k.m" format, where k is a number
+// of the data parts and m is a number of the parity parts.
+const ContainerAttribute = "__NEOFS__EC"
+
+// Rule describes erasure coding of the container objects.
+type Rule struct {
+	DataParts, ParityParts int
+}
+
+// Parts returns the total number of the object parts.
+func (r Rule) Parts() int {
+	return r.DataParts + r.ParityParts
+}
+
+// String implements fmt.Stringer.
+func (r Rule) String() string {
+	return strconv.Itoa(r.DataParts) + "." + strconv.Itoa(r.ParityParts)
+}
+
+// ParseRule parses the rule in "k.m" format.
+func ParseRule(s string) (Rule, error) {
+	var r Rule
+
+	ss := strings.Split(s, ".")
+	if len(ss) != 2 {
+		return r, fmt.Errorf("invalid erasure coding rule '%s': k.m format expected", s)
+	}
+
+	ks, ms := ss[0], ss[1]
+
+	var err error
+
+	if r.DataParts, err = strconv.Atoi(ks); err != nil || r.DataParts <= 0 {
+		return r, fmt.Errorf("invalid number of data parts '%s'", ks)
+	}
+
+	if r.ParityParts, err = strconv.Atoi(ms); err != nil || r.ParityParts <= 0 {
+		return r, fmt.Errorf("invalid number of parity parts '%s'", ms)
+	}
+
+	if r.Parts() > MaxParts {
+		return r, fmt.Errorf("total number of parts %d exceeds %d", r.Parts(), MaxParts)
+	}
+
+	return r, nil
+}
+
+// ContainerRule returns the erasure coding rule of the container. Returns
+// false if the container objects are replicated.
+func ContainerRule(cnr container.Container) (Rule, bool, error) {
+	val := cnr.Attribute(ContainerAttribute)
+	if val == "" {
+		return Rule{}, false, nil
+	}
+
+	r, err := ParseRule(val)
+	if err != nil {
+		return Rule{}, false, err
+	}
+
+	return r, true, nil
+}
@@ -41,6 +41,8 @@ type cfg struct {
 
 	flatSuccess *uint32
 
+	uniqueNodes bool
+
 	cnr cid.ID
 
 	obj *oid.ID
@@ -84,6 +86,10 @@ func NewTraverser(opts ...Option) (*Traverser, error) {
 		return nil, fmt.Errorf("could not build placement: %w", err)
 	}
 
+	if cfg.uniqueNodes {
+		ns = uniqueNodes(ns)
+	}
+
 	var rem []int
 	if cfg.flatSuccess != nil {
 		ns = flatNodes(ns)
@@ -122,6 +128,29 @@ func flatNodes(ns [][]netmap.NodeInfo) [][]netmap.NodeInfo {
 	return [][]netmap.NodeInfo{flat}
 }
 
+// uniqueNodes removes the nodes presented in the previous vectors or
+// earlier in the same vector.
+func uniqueNodes(ns [][]netmap.NodeInfo) [][]netmap.NodeInfo {
+	seen := make(map[string]struct{})
+	res := make([][]netmap.NodeInfo, len(ns))
+
+	for i := range ns {
+		res[i] = make([]netmap.NodeInfo, 0, len(ns[i]))
+
+		for j := range ns[i] {
+			key := string(ns[i][j].PublicKey())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			res[i] = append(res[i], ns[i][j])
+		}
+	}
+
+	return res
+}
+
 // Node is a descriptor of storage node with information required for intra-container communication.
 type Node struct {
 	addresses network.AddressGroup
@@ -252,3 +281,11 @@ func WithoutSuccessTracking() Option {
 		c.trackCopies = false
 	}
 }
+
+// UniqueNodes excludes the repeated nodes from the traversal, so every node
+// is returned once. Nodes are identified by their public keys.
+func UniqueNodes() Option {
+	return func(c *cfg) {
+		c.uniqueNodes = true
+	}
+}
@@ -207,4 +207,46 @@ func TestTraverserObjectScenarios(t *testing.T) {
 		// common success
 		require.True(t, tr.Success())
 	})
+	t.Run("unique nodes scenario", func(t *testing.T) {
+		selectors := []int{3, 3}
+		replicas := []int{2, 2}
+
+		nodes, cnr := testPlacement(t, selectors, replicas)
+
+		for i := range nodes {
+			for j := range nodes[i] {
+				nodes[i][j].SetPublicKey([]byte{byte(i), byte(j)})
+			}
+		}
+
+		// the second vector repeats the nodes of the first one
+		nodes[1][0] = nodes[0][2]
+		nodes[1][1] = nodes[0][0]
+
+		tr, err := NewTraverser(
+			ForContainer(cnr),
+			UseBuilder(&testBuilder{vectors: copyVectors(nodes)}),
+			SuccessAfter(3),
+			UniqueNodes(),
+		)
+		require.NoError(t, err)
+
+		addrs := tr.Next()
+		require.Len(t, addrs, 3)
+
+		for i := range addrs {
+			require.Equal(t, nodes[0][i].PublicKey(), addrs[i].PublicKey())
+		}
+
+		tr.SubmitSuccess()
+		tr.SubmitSuccess()
+
+		// spare node instead of the failed one
+		addrs = tr.Next()
+		require.Len(t, addrs, 1)
+		require.Equal(t, nodes[1][2].PublicKey(), addrs[0].PublicKey())
+
+		require.Empty(t, tr.Next())
+		require.False(t, tr.Success())
+	})
 }
@@ -0,0 +1,159 @@
+package transformer
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// SplitState is a state of the split-chain written by the payload size
+// limiter. It allows to continue writing of the object payload after
+// the interruption, e.g. from another process.
+type SplitState struct {
+	// Split ID of the written children.
+	SplitID *object.SplitID
+	// Identifiers of the written children in order.
+	Children []oid.ID
+	// Size of the payload written to the children.
+	Written uint64
+	// Binary state of the SHA-256 hash of the written payload.
+	PayloadHashState []byte
+	// Homomorphic hash of the written payload, empty if homomorphic
+	// hashing is disabled.
+	HomomorphicHash []byte
+}
+
+// NewResumablePayloadSizeLimiter returns ObjectTarget instance like
+// NewPayloadSizeLimiter which reports the state of the split-chain and
+// can continue writing from it.
+//
+// If state is not nil, the payload is continued from the state: the header
+// must be the same as the one written before, only the payload which follows
+// state.Written bytes must be written.
+//
+// After each released child except the last one, stateHandler is called with
+// the state which is enough to continue writing from the current position.
+// The error returned by the handler interrupts writing.
+func NewResumablePayloadSizeLimiter(maxSize uint64, withoutHomomorphicHash bool, targetInit TargetInitializer,
+	state *SplitState, stateHandler func(SplitState) error) (ObjectTarget, error) {
+	s := &payloadSizeLimiter{
+		maxSize:                maxSize,
+		withoutHomomorphicHash: withoutHomomorphicHash,
+		targetInit:             targetInit,
+		splitID:                object.NewSplitID(),
+		stateHandler:           stateHandler,
+	}
+
+	if state != nil {
+		switch {
+		case state.SplitID == nil:
+			return nil, errors.New("missing split ID")
+		case len(state.Children) == 0:
+			return nil, errors.New("missing children")
+		case state.Written != uint64(len(state.Children))*maxSize:
+			return nil, fmt.Errorf("written payload size %d does not correspond to %d children", state.Written, len(state.Children))
+		}
+
+		s.resumeState = state
+		s.splitID = state.SplitID
+		s.previous = append([]oid.ID(nil), state.Children...)
+		s.written = state.Written
+	}
+
+	return s, nil
+}
+
+// resume initializes the parent object from the header and the next child
+// of the split-chain.
+func (s *payloadSizeLimiter) resume() error {
+	s.parent = s.current
+	s.parent.ResetRelations()
+	s.parent.SetSignature(nil)
+
+	var err error
+
+	s.parentHashers, err = resumedPayloadHashers(s.parent, s.withoutHomomorphicHash, s.resumeState)
+	if err != nil {
+		return err
+	}
+
+	s.current = fromObject(s.parent)
+	s.current.SetAttributes()
+	s.current.SetSplitID(s.splitID)
+	s.current.SetPreviousID(s.previous[len(s.previous)-1])
+
+	s.initializeCurrent()
+
+	s.resumed = true
+
+	return nil
+}
+
+func resumedPayloadHashers(obj *object.Object, withoutHomomorphicHash bool, state *SplitState) ([]*payloadChecksumHasher, error) {
+	hashers := payloadHashersForObject(obj, withoutHomomorphicHash)
+
+	if err := hashers[0].hasher.(encoding.BinaryUnmarshaler).UnmarshalBinary(state.PayloadHashState); err != nil {
+		return nil, fmt.Errorf("invalid payload hash state: %w", err)
+	}
+
+	if !withoutHomomorphicHash {
+		if len(state.HomomorphicHash) != tz.Size {
+			return nil, fmt.Errorf("wrong homomorphic hash length: expected %d, has %d", tz.Size, len(state.HomomorphicHash))
+		}
+
+		hashers[1].hasher = &resumedTZHash{
+			Hash:   hashers[1].hasher,
+			prefix: state.HomomorphicHash,
+		}
+	}
+
+	return hashers, nil
+}
+
+func (s *payloadSizeLimiter) handleState() error {
+	state := SplitState{
+		SplitID:  s.splitID,
+		Children: append([]oid.ID(nil), s.previous...),
+		Written:  s.written,
+	}
+
+	var err error
+
+	state.PayloadHashState, err = s.parentHashers[0].hasher.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("could not save payload hash state: %w", err)
+	}
+
+	if !s.withoutHomomorphicHash {
+		state.HomomorphicHash = s.parentHashers[1].hasher.Sum(nil)
+	}
+
+	if err := s.stateHandler(state); err != nil {
+		return fmt.Errorf("could not handle split-chain state: %w", err)
+	}
+
+	return nil
+}
+
+// resumedTZHash calculates homomorphic hash of the payload which continues
+// the payload with the known hash.
+type resumedTZHash struct {
+	hash.Hash
+
+	prefix []byte
+}
+
+func (h *resumedTZHash) Sum(b []byte) []byte {
+	sum, err := tz.Concat([][]byte{h.prefix, h.Hash.Sum(nil)})
+	if err != nil {
+		// both hashes are of the correct length
+		panic(fmt.Sprintf("could not concatenate homomorphic hashes: %v", err))
+	}
+
+	return append(b, sum...)
+}
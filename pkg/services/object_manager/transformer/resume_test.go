@@ -0,0 +1,164 @@
+package transformer
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTarget stores written objects in memory
+// and identifies them randomly.
+type memoryTarget struct {
+	objs *[]*object.Object
+
+	hdr *object.Object
+
+	payload []byte
+}
+
+func (t *memoryTarget) WriteHeader(hdr *object.Object) error {
+	t.hdr = hdr
+	return nil
+}
+
+func (t *memoryTarget) Write(p []byte) (int, error) {
+	t.payload = append(t.payload, p...)
+	return len(p), nil
+}
+
+func (t *memoryTarget) Close() (*AccessIdentifiers, error) {
+	id := oidtest.ID()
+
+	t.hdr.SetID(id)
+	t.hdr.SetPayload(t.payload)
+
+	// header is changed by the limiter after writing
+	data, err := t.hdr.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	obj := object.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	*t.objs = append(*t.objs, obj)
+
+	return new(AccessIdentifiers).WithSelfID(id), nil
+}
+
+func TestResumablePayloadSizeLimiter(t *testing.T) {
+	const maxSize = 64
+
+	hdr := object.New()
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(usertest.ID())
+
+	var a object.Attribute
+	a.SetKey("key")
+	a.SetValue("value")
+	hdr.SetAttributes(a)
+
+	payload := make([]byte, 3*maxSize+maxSize/2)
+	_, _ = rand.Read(payload)
+
+	var objs []*object.Object
+
+	targetInit := func() ObjectTarget {
+		return &memoryTarget{objs: &objs}
+	}
+
+	var states []SplitState
+
+	// write the first part of the payload and interrupt writing
+	w, err := NewResumablePayloadSizeLimiter(maxSize, false, targetInit, nil, func(s SplitState) error {
+		states = append(states, s)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, w.WriteHeader(hdr))
+
+	for _, chunk := range [][]byte{payload[:10], payload[10 : 2*maxSize], payload[2*maxSize : 2*maxSize+1]} {
+		_, err = w.Write(chunk)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, objs, 2)
+	require.Len(t, states, 2)
+
+	state := states[len(states)-1]
+	require.EqualValues(t, 2*maxSize, state.Written)
+
+	// continue from the last state
+	w, err = NewResumablePayloadSizeLimiter(maxSize, false, targetInit, &state, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteHeader(hdr))
+
+	_, err = w.Write(payload[state.Written:])
+	require.NoError(t, err)
+
+	_, err = w.Close()
+	require.NoError(t, err)
+
+	// 4 children and the linking object
+	require.Len(t, objs, 5)
+
+	var (
+		children []oid.ID
+		written  []byte
+	)
+
+	for i, obj := range objs[:4] {
+		require.Equal(t, state.SplitID, obj.SplitID())
+		require.Empty(t, obj.Attributes())
+
+		if i > 0 {
+			prev, ok := obj.PreviousID()
+			require.True(t, ok)
+			require.Equal(t, children[i-1], prev)
+		}
+
+		id, _ := obj.ID()
+		children = append(children, id)
+		written = append(written, obj.Payload()...)
+	}
+
+	require.True(t, bytes.Equal(payload, written))
+
+	par := objs[3].Parent()
+	require.NotNil(t, par)
+	require.EqualValues(t, len(payload), par.PayloadSize())
+	require.Equal(t, []object.Attribute{a}, par.Attributes())
+
+	cs, ok := par.PayloadChecksum()
+	require.True(t, ok)
+	sum := sha256.Sum256(payload)
+	require.Equal(t, sum[:], cs.Value())
+
+	tzh, ok := par.PayloadHomomorphicHash()
+	require.True(t, ok)
+	tzSum := tz.Sum(payload)
+	require.Equal(t, tzSum[:], tzh.Value())
+
+	link := objs[4]
+	require.Equal(t, children, link.Children())
+	require.Equal(t, state.SplitID, link.SplitID())
+
+	t.Run("invalid state", func(t *testing.T) {
+		broken := state
+		broken.Written++
+
+		_, err := NewResumablePayloadSizeLimiter(maxSize, false, targetInit, &broken, nil)
+		require.Error(t, err)
+	})
+}
@@ -32,6 +32,12 @@ type payloadSizeLimiter struct {
 	splitID *object.SplitID
 
 	parAttrs []object.Attribute
+
+	resumeState *SplitState
+
+	resumed bool
+
+	stateHandler func(SplitState) error
 }
 
 type payloadChecksumHasher struct {
@@ -59,6 +65,10 @@ func NewPayloadSizeLimiter(maxSize uint64, withoutHomomorphicHash bool, targetIn
 func (s *payloadSizeLimiter) WriteHeader(hdr *object.Object) error {
 	s.current = fromObject(hdr)
 
+	if s.resumeState != nil {
+		return s.resume()
+	}
+
 	s.initialize()
 
 	return nil
@@ -230,7 +240,8 @@ func (s *payloadSizeLimiter) initializeLinking(parHdr *object.Object) {
 
 func (s *payloadSizeLimiter) writeChunk(chunk []byte) error {
 	// statement is true if the previous write of bytes reached exactly the boundary.
-	if s.written > 0 && s.written%s.maxSize == 0 {
+	// The next child is already initialized on resume.
+	if s.written > 0 && s.written%s.maxSize == 0 && !s.resumed {
 		if s.written == s.maxSize {
 			s.prepareFirstChild()
 		}
@@ -242,6 +253,12 @@ func (s *payloadSizeLimiter) writeChunk(chunk []byte) error {
 
 		// initialize another object
 		s.initialize()
+
+		if s.stateHandler != nil {
+			if err := s.handleState(); err != nil {
+				return err
+			}
+		}
 	}
 
 	var (
@@ -250,6 +267,10 @@ func (s *payloadSizeLimiter) writeChunk(chunk []byte) error {
 		leftToEdge = s.maxSize - s.written%s.maxSize
 	)
 
+	if ln > 0 {
+		s.resumed = false
+	}
+
 	// write bytes no further than the boundary of the current object
 	if ln > leftToEdge {
 		cut = leftToEdge
@@ -45,6 +45,10 @@ func (p *Policer) processObject(ctx context.Context, addr oid.Address) {
 		return
 	}
 
+	if p.processErasureCoded(ctx, addr, cnr.Value) {
+		return
+	}
+
 	policy := cnr.Value.PlacementPolicy()
 	obj := addr.Object()
 
@@ -122,7 +126,7 @@ func (p *Policer) processNodes(ctx *processPlacementContext, addr oid.Address,
 				continue
 			}
 
-			callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+			callCtx, cancel := context.WithTimeout(ctx, p.headTimeout.Load())
 
 			_, err := p.remoteHeader.Head(callCtx, prm.WithNodeInfo(nodes[i]))
 
@@ -154,7 +158,7 @@ func (p *Policer) processNodes(ctx *processPlacementContext, addr oid.Address,
 			zap.Uint32("shortage", shortage),
 		)
 
-		p.replicator.HandleTask(ctx, new(replicator.Task).
+		p.replicate(ctx, new(replicator.Task).
 			WithObjectAddress(addr).
 			WithNodes(nodes).
 			WithCopiesNumber(shortage),
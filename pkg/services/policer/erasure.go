@@ -0,0 +1,469 @@
+package policer
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
+	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
+	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasure"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// erasureHolder describes the container node storing the parts
+// of the erasure-coded object.
+type erasureHolder struct {
+	node netmap.NodeInfo
+
+	local bool
+
+	// indices of the parts stored on the node
+	indices map[int]struct{}
+}
+
+// processErasureCoded processes the object if it is a part of the
+// erasure-coded object. Returns false if the object should be replicated
+// according to the container placement policy.
+func (p *Policer) processErasureCoded(ctx context.Context, addr oid.Address, cnr container.Container) bool {
+	rule, ok, err := erasure.ContainerRule(cnr)
+	if err != nil {
+		p.log.Error("invalid erasure coding rule of the container",
+			zap.Stringer("cid", addr.Container()),
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	} else if !ok {
+		return false
+	}
+
+	hdr, err := engine.Head(p.jobQueue.localStorage, addr)
+	if err != nil {
+		p.log.Error("could not get object header from local storage",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	info, ok, err := erasure.ReadPartInfo(hdr)
+	if err != nil {
+		p.log.Error("invalid erasure-coded part",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	} else if !ok || info.Index >= rule.Parts() {
+		return false
+	}
+
+	p.processErasurePart(ctx, addr, cnr, rule, hdr, info)
+
+	return true
+}
+
+// processErasurePart checks the part of the erasure-coded object stored
+// locally. The part is removed if the object is removed. The part is
+// considered redundant if the node preceding the local one in the object
+// placement stores the part with the same index. The part stored out of the
+// object placement is moved to the container node storing no parts. The node
+// storing the part with the lowest index restores the object and saves the
+// missing parts on the container nodes storing no parts.
+func (p *Policer) processErasurePart(ctx context.Context, addr oid.Address, cnr container.Container,
+	rule erasure.Rule, part *objectSDK.Object, info erasure.PartInfo) {
+	var parAddr oid.Address
+	parAddr.SetContainer(addr.Container())
+	parAddr.SetObject(info.Parent)
+
+	_, err := engine.Head(p.jobQueue.localStorage, parAddr)
+	if client.IsErrObjectAlreadyRemoved(err) {
+		p.log.Info("erasure-coded object is removed, removing its part",
+			zap.Stringer("object", parAddr),
+			zap.Stringer("part", addr),
+		)
+
+		p.cbRedundantCopy(addr)
+
+		return
+	}
+
+	holders, ok := p.erasureHolders(ctx, cnr, parAddr)
+	if !ok {
+		return
+	}
+
+	var (
+		localHolder bool
+		present     = make(map[int]struct{}, rule.Parts())
+		minIndex    = rule.Parts()
+		restorer    bool
+	)
+
+	for i := range holders {
+		if holders[i].local {
+			localHolder = true
+		}
+
+		if _, ok := holders[i].indices[info.Index]; ok && !holders[i].local && !localHolder {
+			p.log.Info("redundant erasure-coded part detected",
+				zap.Stringer("object", parAddr),
+				zap.Stringer("part", addr),
+			)
+
+			p.cbRedundantCopy(addr)
+
+			return
+		}
+
+		for idx := range holders[i].indices {
+			if _, ok := present[idx]; ok {
+				continue
+			}
+
+			present[idx] = struct{}{}
+
+			if idx < minIndex {
+				minIndex = idx
+				restorer = holders[i].local
+			}
+		}
+	}
+
+	var free []netmap.NodeInfo
+
+	for i := range holders {
+		if len(holders[i].indices) == 0 {
+			free = append(free, holders[i].node)
+		}
+	}
+
+	if !localHolder {
+		// local node is out of the object placement, move the part if it is missing elsewhere
+		if _, ok := present[info.Index]; ok {
+			p.log.Info("redundant erasure-coded part detected",
+				zap.Stringer("object", parAddr),
+				zap.Stringer("part", addr),
+			)
+
+			p.cbRedundantCopy(addr)
+
+			return
+		}
+
+		p.log.Debug("moving erasure-coded part to the container node",
+			zap.Stringer("object", parAddr),
+			zap.Stringer("part", addr),
+		)
+
+		accepted := make(nodeCache, 1)
+
+		p.replicate(ctx, new(replicator.Task).
+			WithObjectAddress(addr).
+			WithNodes(free).
+			WithCopiesNumber(1),
+			accepted,
+		)
+
+		if len(accepted) != 0 {
+			p.log.Info("erasure-coded part has been moved to the container node, removing local copy",
+				zap.Stringer("object", parAddr),
+				zap.Stringer("part", addr),
+			)
+
+			p.cbRedundantCopy(addr)
+		}
+
+		return
+	}
+
+	if len(present) == rule.Parts() {
+		return
+	}
+
+	if !restorer {
+		// another holder is responsible for the object restoration
+		return
+	}
+
+	p.log.Debug("shortage of erasure-coded parts detected",
+		zap.Stringer("object", parAddr),
+		zap.Int("missing", rule.Parts()-len(present)),
+	)
+
+	p.restoreErasureParts(ctx, rule, part, parAddr, present, free)
+}
+
+// erasureHolders searches for the parts of the erasure-coded object on the
+// nodes of its placement. Returns the unique placement nodes in order.
+// Returns false if the placement cannot be built.
+func (p *Policer) erasureHolders(ctx context.Context, cnr container.Container, parAddr oid.Address) ([]erasureHolder, bool) {
+	idPar := parAddr.Object()
+
+	nn, err := p.placementBuilder.BuildPlacement(parAddr.Container(), &idPar, cnr.PlacementPolicy())
+	if err != nil {
+		p.log.Error("could not build placement vector for object",
+			zap.String("error", err.Error()),
+		)
+
+		return nil, false
+	}
+
+	var fs objectSDK.SearchFilters
+	fs.AddFilter(erasure.AttributeParent, idPar.EncodeToString(), objectSDK.MatchStringEqual)
+
+	var (
+		holders   []erasureHolder
+		processed = make(map[string]struct{})
+		searchPrm = new(searchsvc.RemoteSearchPrm).
+				WithContainerID(parAddr.Container()).
+				WithSearchFilters(fs)
+	)
+
+	for i := range nn {
+		for j := range nn[i] {
+			key := string(nn[i][j].PublicKey())
+			if _, ok := processed[key]; ok {
+				continue
+			}
+
+			processed[key] = struct{}{}
+
+			select {
+			case <-ctx.Done():
+				return nil, false
+			default:
+			}
+
+			holder := erasureHolder{
+				node:  nn[i][j],
+				local: p.netmapKeys.IsLocalKey(nn[i][j].PublicKey()),
+			}
+
+			var ids []oid.ID
+
+			if holder.local {
+				addrs, err := engine.Select(p.jobQueue.localStorage, parAddr.Container(), fs)
+				if err != nil {
+					p.log.Error("could not select erasure-coded parts locally",
+						zap.Stringer("object", parAddr),
+						zap.String("error", err.Error()),
+					)
+
+					return nil, false
+				}
+
+				ids = make([]oid.ID, len(addrs))
+				for k := range addrs {
+					ids[k] = addrs[k].Object()
+				}
+			} else {
+				callCtx, cancel := context.WithTimeout(ctx, p.headTimeout.Load())
+
+				ids, err = p.remoteSearcher.Search(callCtx, searchPrm.WithNodeInfo(nn[i][j]))
+
+				cancel()
+
+				if err != nil {
+					// state of the node is unknown, it is neither a holder nor a free node
+					p.log.Error("could not search erasure-coded parts on the node",
+						zap.Stringer("object", parAddr),
+						zap.String("error", err.Error()),
+					)
+
+					continue
+				}
+			}
+
+			holder.indices = p.erasurePartIndices(ctx, holder, parAddr, ids)
+
+			holders = append(holders, holder)
+		}
+	}
+
+	return holders, true
+}
+
+// erasurePartIndices returns the indices of the parts stored on the holder.
+func (p *Policer) erasurePartIndices(ctx context.Context, holder erasureHolder, parAddr oid.Address, ids []oid.ID) map[int]struct{} {
+	var (
+		res      = make(map[int]struct{}, len(ids))
+		partAddr oid.Address
+		headPrm  = new(headsvc.RemoteHeadPrm).WithNodeInfo(holder.node)
+	)
+
+	partAddr.SetContainer(parAddr.Container())
+
+	for i := range ids {
+		partAddr.SetObject(ids[i])
+
+		var (
+			hdr *objectSDK.Object
+			err error
+		)
+
+		if holder.local {
+			hdr, err = engine.Head(p.jobQueue.localStorage, partAddr)
+		} else {
+			callCtx, cancel := context.WithTimeout(ctx, p.headTimeout.Load())
+
+			hdr, err = p.remoteHeader.Head(callCtx, headPrm.WithObjectAddress(partAddr))
+
+			cancel()
+		}
+
+		if err != nil {
+			p.log.Debug("could not receive erasure-coded part header",
+				zap.Stringer("part", partAddr),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		info, ok, err := erasure.ReadPartInfo(hdr)
+		if err != nil || !ok || !info.Parent.Equals(parAddr.Object()) {
+			continue
+		}
+
+		res[info.Index] = struct{}{}
+	}
+
+	return res
+}
+
+// restoreErasureParts restores the erasure-coded object, encodes it again
+// and saves the missing parts on the free nodes.
+func (p *Policer) restoreErasureParts(ctx context.Context, rule erasure.Rule, localPart *objectSDK.Object,
+	parAddr oid.Address, present map[int]struct{}, free []netmap.NodeInfo) {
+	if len(free) == 0 {
+		p.log.Debug("no free container nodes for the erasure-coded parts",
+			zap.Stringer("object", parAddr),
+		)
+
+		return
+	}
+
+	coder, err := erasure.NewCoder(rule.DataParts, rule.ParityParts)
+	if err != nil {
+		p.log.Error("could not create erasure coder",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	var w objectWriter
+
+	var getPrm getsvc.Prm
+	getPrm.WithAddress(parAddr)
+	getPrm.SetObjectWriter(&w)
+	getPrm.SetCommonParameters(&util.CommonPrm{})
+
+	err = p.getSvc.Get(ctx, getPrm)
+	if err != nil {
+		p.log.Error("could not restore erasure-coded object",
+			zap.Stringer("object", parAddr),
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	w.obj.SetPayload(w.payload)
+
+	_, withHomomorphicHash := localPart.PayloadHomomorphicHash()
+
+	parts, err := erasure.FormParts(coder, w.obj, !withHomomorphicHash)
+	if err != nil {
+		p.log.Error("could not form erasure-coded parts",
+			zap.Stringer("object", parAddr),
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	key, err := p.keyStorage.GetKey(nil)
+	if err != nil {
+		p.log.Error("could not receive private key of the node",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	for i := range parts {
+		if _, ok := present[i]; ok {
+			continue
+		}
+
+		if len(free) == 0 {
+			p.log.Debug("no more free container nodes for the erasure-coded parts",
+				zap.Stringer("object", parAddr),
+			)
+
+			return
+		}
+
+		err = erasure.SignPart(parts[i], *key, localPart.CreationEpoch())
+		if err != nil {
+			p.log.Error("could not sign erasure-coded part",
+				zap.Stringer("object", parAddr),
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		id, _ := parts[i].ID()
+
+		var partAddr oid.Address
+		partAddr.SetContainer(parAddr.Container())
+		partAddr.SetObject(id)
+
+		accepted := make(nodeCache, 1)
+
+		p.replicate(ctx, new(replicator.Task).
+			WithObjectAddress(partAddr).
+			WithObject(parts[i]).
+			WithNodes(free).
+			WithCopiesNumber(1),
+			accepted,
+		)
+
+		// node which accepted the part is not free anymore
+		for j := 0; j < len(free); j++ {
+			if accepted[free[j].Hash()] {
+				free = append(free[:j], free[j+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// objectWriter collects the object read by the object service.
+type objectWriter struct {
+	obj *objectSDK.Object
+
+	payload []byte
+}
+
+func (w *objectWriter) WriteHeader(obj *objectSDK.Object) error {
+	w.obj = obj
+	return nil
+}
+
+func (w *objectWriter) WriteChunk(p []byte) error {
+	w.payload = append(w.payload, p...)
+	return nil
+}
@@ -8,12 +8,18 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
+	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/panjf2000/ants/v2"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -56,6 +62,15 @@ type Policer struct {
 	cache *lru.Cache
 
 	objsInWork *objectsInWork
+
+	priority priorityQueue
+
+	netmapMtx  sync.Mutex
+	prevNetmap *netmapSDK.NetMap
+
+	ops *rate.Limiter
+
+	stats passStats
 }
 
 // Option is an option for Policer constructor.
@@ -66,7 +81,7 @@ type Option func(*cfg)
 type RedundantCopyCallback func(oid.Address)
 
 type cfg struct {
-	headTimeout time.Duration
+	headTimeout atomic.Duration
 
 	log *logger.Logger
 
@@ -78,6 +93,12 @@ type cfg struct {
 
 	remoteHeader *headsvc.RemoteHeader
 
+	remoteSearcher *searchsvc.RemoteSearcher
+
+	getSvc *getsvc.Service
+
+	keyStorage *util.KeyStorage
+
 	netmapKeys netmap.AnnouncedKeys
 
 	replicator *replicator.Replicator
@@ -88,10 +109,13 @@ type cfg struct {
 
 	loader nodeLoader
 
-	maxCapacity int
+	maxCapacity atomic.Int64
 
 	batchSize, cacheSize uint32
 
+	// zero means no limit
+	maxOpsPerSecond atomic.Uint32
+
 	rebalanceFreq, evictDuration time.Duration
 }
 
@@ -120,19 +144,24 @@ func New(opts ...Option) *Policer {
 		panic(err)
 	}
 
-	return &Policer{
+	p := &Policer{
 		cfg:   c,
 		cache: cache,
 		objsInWork: &objectsInWork{
-			objs: make(map[oid.Address]struct{}, c.maxCapacity),
+			objs: make(map[oid.Address]struct{}, c.maxCapacity.Load()),
 		},
+		ops: rate.NewLimiter(float64(c.maxOpsPerSecond.Load())),
 	}
+
+	p.stats.newPass()
+
+	return p
 }
 
 // WithHeadTimeout returns option to set Head timeout of Policer.
 func WithHeadTimeout(v time.Duration) Option {
 	return func(c *cfg) {
-		c.headTimeout = v
+		c.headTimeout.Store(v)
 	}
 }
 
@@ -171,6 +200,30 @@ func WithRemoteHeader(v *headsvc.RemoteHeader) Option {
 	}
 }
 
+// WithRemoteSearcher returns option to set object searcher
+// used by Policer to find the parts of erasure-coded objects.
+func WithRemoteSearcher(v *searchsvc.RemoteSearcher) Option {
+	return func(c *cfg) {
+		c.remoteSearcher = v
+	}
+}
+
+// WithGetService returns option to set object service
+// used by Policer to restore erasure-coded objects.
+func WithGetService(v *getsvc.Service) Option {
+	return func(c *cfg) {
+		c.getSvc = v
+	}
+}
+
+// WithKeyStorage returns option to set storage of the node key
+// used by Policer to sign the restored parts of erasure-coded objects.
+func WithKeyStorage(v *util.KeyStorage) Option {
+	return func(c *cfg) {
+		c.keyStorage = v
+	}
+}
+
 // WithNetmapKeys returns option to set tool to work with announced public keys.
 func WithNetmapKeys(v netmap.AnnouncedKeys) Option {
 	return func(c *cfg) {
@@ -198,7 +251,7 @@ func WithRedundantCopyCallback(cb RedundantCopyCallback) Option {
 // that can be set to the pool.
 func WithMaxCapacity(cap int) Option {
 	return func(c *cfg) {
-		c.maxCapacity = cap
+		c.maxCapacity.Store(int64(cap))
 	}
 }
 
@@ -216,3 +269,30 @@ func WithNodeLoader(l nodeLoader) Option {
 		c.loader = l
 	}
 }
+
+// WithMaxOpsPerSecond returns option to set maximum number
+// of objects checked by Policer per second. Zero means no limit.
+// The limit is decreased under the load of the node.
+func WithMaxOpsPerSecond(v uint32) Option {
+	return func(c *cfg) {
+		c.maxOpsPerSecond.Store(v)
+	}
+}
+
+// SetHeadTimeout sets Head timeout of Policer at runtime.
+func (p *Policer) SetHeadTimeout(v time.Duration) {
+	p.headTimeout.Store(v)
+}
+
+// SetMaxCapacity sets maximum capacity of the replication pool at
+// runtime. The pool is tuned according to the node load periodically.
+func (p *Policer) SetMaxCapacity(v int) {
+	p.maxCapacity.Store(int64(v))
+}
+
+// SetMaxOpsPerSecond sets maximum number of objects checked by Policer
+// per second at runtime. Zero means no limit.
+func (p *Policer) SetMaxOpsPerSecond(v uint32) {
+	p.maxOpsPerSecond.Store(v)
+	p.ops.SetLimit(float64(v))
+}
@@ -0,0 +1,176 @@
+package policer
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// priorityQueue is a queue of the containers which objects
+// are checked before the other ones.
+type priorityQueue struct {
+	mtx sync.Mutex
+
+	cnrs []cid.ID
+
+	queued map[cid.ID]struct{}
+
+	// cursor of the first container objects selection
+	cursor *engine.SelectCursor
+}
+
+func (q *priorityQueue) push(cnr cid.ID) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if _, ok := q.queued[cnr]; ok {
+		return
+	}
+
+	if q.queued == nil {
+		q.queued = make(map[cid.ID]struct{})
+	}
+
+	q.queued[cnr] = struct{}{}
+	q.cnrs = append(q.cnrs, cnr)
+}
+
+func (q *priorityQueue) len() int {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	return len(q.cnrs)
+}
+
+// selectPrioritized returns the next batch of the prioritized objects.
+// Returns false if there are no prioritized containers.
+func (p *Policer) selectPrioritized() ([]oid.Address, bool) {
+	q := &p.priority
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if len(q.cnrs) == 0 {
+		return nil, false
+	}
+
+	var prm engine.SelectPrm
+	prm.WithContainerID(q.cnrs[0])
+	prm.WithLimit(p.batchSize)
+	prm.WithCursor(q.cursor)
+
+	res, err := p.jobQueue.localStorage.Select(prm)
+	if err != nil {
+		p.log.Warn("failure at prioritized object select for replication",
+			zap.Stringer("cid", q.cnrs[0]),
+			zap.Error(err),
+		)
+	}
+
+	q.cursor = res.Cursor()
+
+	if err != nil || q.cursor == nil {
+		delete(q.queued, q.cnrs[0])
+		q.cnrs = q.cnrs[1:]
+		q.cursor = nil
+	}
+
+	return res.AddressList(), true
+}
+
+// HandleNewNetmap compares the nodes of the local containers in the given
+// network map with the previous one. Objects of the containers which nodes
+// have changed are checked before the other objects.
+func (p *Policer) HandleNewNetmap(nm *netmap.NetMap) {
+	p.netmapMtx.Lock()
+	prev := p.prevNetmap
+	p.prevNetmap = nm
+	p.netmapMtx.Unlock()
+
+	if prev == nil || !netmapNodesChanged(prev, nm) {
+		return
+	}
+
+	res, err := p.jobQueue.localStorage.ListContainers(engine.ListContainersPrm{})
+	if err != nil {
+		p.log.Error("could not list local containers",
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	binCnr := make([]byte, sha256.Size)
+
+	for _, idCnr := range res.Containers() {
+		cnr, err := p.cnrSrc.Get(idCnr)
+		if err != nil {
+			// container errors are handled during the object check
+			continue
+		}
+
+		idCnr.Encode(binCnr)
+
+		prevNodes, err := prev.ContainerNodes(cnr.Value.PlacementPolicy(), binCnr)
+		if err != nil {
+			continue
+		}
+
+		curNodes, err := nm.ContainerNodes(cnr.Value.PlacementPolicy(), binCnr)
+		if err != nil {
+			p.log.Error("could not build container nodes",
+				zap.Stringer("cid", idCnr),
+				zap.Error(err),
+			)
+
+			continue
+		}
+
+		if !sameNodes(prevNodes, curNodes) {
+			p.log.Debug("container nodes changed, prioritizing container objects",
+				zap.Stringer("cid", idCnr),
+			)
+
+			p.priority.push(idCnr)
+		}
+	}
+}
+
+func netmapNodesChanged(prev, cur *netmap.NetMap) bool {
+	return !sameNodes([][]netmap.NodeInfo{prev.Nodes()}, [][]netmap.NodeInfo{cur.Nodes()})
+}
+
+// sameNodes checks if the node lists contain the same node sets.
+func sameNodes(a, b [][]netmap.NodeInfo) bool {
+	keys := make(map[string]int)
+
+	for i := range a {
+		for j := range a[i] {
+			keys[string(a[i][j].PublicKey())]++
+		}
+	}
+
+	for i := range b {
+		for j := range b[i] {
+			key := string(b[i][j].PublicKey())
+			if keys[key] == 0 {
+				return false
+			}
+
+			keys[key]--
+		}
+	}
+
+	for _, n := range keys {
+		if n != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -33,46 +33,80 @@ func (p *Policer) shardPolicyWorker(ctx context.Context) {
 		default:
 		}
 
+		// objects of the containers with changed nodes are checked first
+		if addrs, ok := p.selectPrioritized(); ok {
+			for i := range addrs {
+				if !p.submitObject(ctx, addrs[i], true) {
+					return
+				}
+			}
+
+			continue
+		}
+
 		addrs, cursor, err = p.jobQueue.Select(cursor, p.batchSize)
 		if err != nil {
 			if errors.Is(err, engine.ErrEndOfListing) {
+				p.log.Debug("policer pass finished")
+				p.stats.newPass()
+
 				time.Sleep(time.Second) // finished whole cycle, sleep a bit
 				continue
 			}
 			p.log.Warn("failure at object select for replication", zap.Error(err))
 		}
 
+		p.stats.addListed(len(addrs))
+
 		for i := range addrs {
-			select {
-			case <-ctx.Done():
+			if !p.submitObject(ctx, addrs[i], false) {
 				return
-			default:
-				addr := addrs[i]
-				if p.objsInWork.inWork(addr) {
-					// do not process an object
-					// that is in work
-					continue
-				}
-
-				err = p.taskPool.Submit(func() {
-					v, ok := p.cache.Get(addr)
-					if ok && time.Since(v.(time.Time)) < p.evictDuration {
-						return
-					}
+			}
+		}
+	}
+}
 
-					p.objsInWork.add(addr)
+// submitObject submits the object check to the task pool. Recently checked
+// objects are skipped unless the check is forced. Returns false if the
+// context is done.
+func (p *Policer) submitObject(ctx context.Context, addr oid.Address, force bool) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+	}
 
-					p.processObject(ctx, addr)
+	if p.objsInWork.inWork(addr) {
+		// do not process an object
+		// that is in work
+		return true
+	}
 
-					p.cache.Add(addr, time.Now())
-					p.objsInWork.remove(addr)
-				})
-				if err != nil {
-					p.log.Warn("pool submission", zap.Error(err))
-				}
+	err := p.taskPool.Submit(func() {
+		if !force {
+			v, ok := p.cache.Get(addr)
+			if ok && time.Since(v.(time.Time)) < p.evictDuration {
+				return
 			}
 		}
+
+		if err := p.ops.Wait(ctx, 1); err != nil {
+			return
+		}
+
+		p.objsInWork.add(addr)
+
+		p.processObject(ctx, addr)
+
+		p.cache.Add(addr, time.Now())
+		p.objsInWork.remove(addr)
+		p.stats.addChecked()
+	})
+	if err != nil {
+		p.log.Warn("pool submission", zap.Error(err))
 	}
+
+	return true
 }
 
 func (p *Policer) poolCapacityWorker(ctx context.Context) {
@@ -84,11 +118,17 @@ func (p *Policer) poolCapacityWorker(ctx context.Context) {
 			return
 		case <-ticker.C:
 			neofsSysLoad := p.loader.ObjectServiceLoad()
-			newCapacity := int((1.0 - neofsSysLoad) * float64(p.maxCapacity))
+			newCapacity := int((1.0 - neofsSysLoad) * float64(p.maxCapacity.Load()))
 			if newCapacity == 0 {
 				newCapacity++
 			}
 
+			if maxOps := p.maxOpsPerSecond.Load(); maxOps > 0 {
+				p.ops.SetLimit(loadFactor(neofsSysLoad) * float64(maxOps))
+			}
+
+			p.replicator.TuneBandwidth(neofsSysLoad)
+
 			if p.taskPool.Cap() != newCapacity {
 				p.taskPool.Tune(newCapacity)
 				p.log.Debug("tune replication capacity",
@@ -98,3 +138,17 @@ func (p *Policer) poolCapacityWorker(ctx context.Context) {
 		}
 	}
 }
+
+// minLoadFactor is a minimal part of the maximum operation rate
+// available for Policer under the full load of the node.
+const minLoadFactor = 0.1
+
+// loadFactor returns the part of the maximum operation rate
+// available for Policer under the given load of the node.
+func loadFactor(load float64) float64 {
+	if f := 1 - load; f > minLoadFactor {
+		return f
+	}
+
+	return minLoadFactor
+}
@@ -0,0 +1,122 @@
+package policer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+)
+
+// Status describes the progress of the Policer.
+type Status struct {
+	// Number of the current pass over the local objects, starts from 1.
+	Pass uint64
+	// Start time of the current pass.
+	PassStarted time.Time
+	// Number of the objects checked during the current pass.
+	Checked uint64
+	// Number of the replicas created during the current pass.
+	Replicated uint64
+	// Number of the containers which objects are checked out of turn.
+	Prioritized int
+	// Estimated time to finish the current pass, zero if unknown.
+	ETA time.Duration
+}
+
+// passStats accumulates the statistics of the current pass.
+type passStats struct {
+	mtx sync.Mutex
+
+	pass uint64
+
+	started time.Time
+
+	// number of the objects listed by the pass cursor
+	listed uint64
+
+	checked, replicated uint64
+}
+
+func (s *passStats) newPass() {
+	s.mtx.Lock()
+	s.pass++
+	s.started = time.Now()
+	s.listed, s.checked, s.replicated = 0, 0, 0
+	s.mtx.Unlock()
+}
+
+func (s *passStats) addListed(n int) {
+	s.mtx.Lock()
+	s.listed += uint64(n)
+	s.mtx.Unlock()
+}
+
+func (s *passStats) addChecked() {
+	s.mtx.Lock()
+	s.checked++
+	s.mtx.Unlock()
+}
+
+func (s *passStats) addReplicated() {
+	s.mtx.Lock()
+	s.replicated++
+	s.mtx.Unlock()
+}
+
+// Status returns the current progress of the Policer. ETA is estimated
+// from the number of the objects in the local storage and the speed of
+// the current pass.
+func (p *Policer) Status() Status {
+	p.stats.mtx.Lock()
+
+	res := Status{
+		Pass:        p.stats.pass,
+		PassStarted: p.stats.started,
+		Checked:     p.stats.checked,
+		Replicated:  p.stats.replicated,
+	}
+
+	listed := p.stats.listed
+
+	p.stats.mtx.Unlock()
+
+	res.Prioritized = p.priority.len()
+
+	if listed == 0 {
+		return res
+	}
+
+	var total uint64
+
+	for _, sh := range p.jobQueue.localStorage.DumpInfo().Shards {
+		total += sh.ObjectCounters.Phy
+	}
+
+	if total > listed {
+		elapsed := time.Since(res.PassStarted)
+		res.ETA = time.Duration(float64(elapsed) * float64(total-listed) / float64(listed))
+	}
+
+	return res
+}
+
+// countingResult counts successful replications in the pass statistics.
+type countingResult struct {
+	replicator.TaskResult
+
+	stats *passStats
+}
+
+func (r countingResult) SubmitSuccessfulReplication(id uint64) {
+	r.stats.addReplicated()
+	r.TaskResult.SubmitSuccessfulReplication(id)
+}
+
+// replicate executes the replication task and counts created replicas.
+func (p *Policer) replicate(ctx context.Context, task *replicator.Task, res replicator.TaskResult) {
+	p.replicator.HandleTask(ctx, task, countingResult{
+		TaskResult: res,
+		stats:      &p.stats,
+	})
+}
@@ -0,0 +1,154 @@
+package replicator
+
+import (
+	"context"
+	"sync"
+
+	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// maxBatchSize is a maximum number of objects of the batch
+// sent to the node at once.
+const maxBatchSize = 16
+
+type batchItem struct {
+	ctx context.Context
+
+	obj *objectSDK.Object
+
+	done chan<- error
+}
+
+// nodeBatch accumulates small objects bound for the same node.
+type nodeBatch struct {
+	node netmap.NodeInfo
+
+	items []batchItem
+}
+
+// batches groups small objects by the target nodes. Objects accumulated
+// for the node while the previous batch is being sent are sent together
+// as the next batch by a single routine per node, so the number of
+// concurrent streams to the node does not depend on the number of the
+// replication routines.
+type batches struct {
+	mtx sync.Mutex
+
+	nodes map[string]*nodeBatch
+}
+
+// sendSmall sends the object held in memory to all the given nodes in
+// parallel via per-node batches. Returns the result of the sending for
+// each node.
+func (p *Replicator) sendSmall(ctx context.Context, obj *objectSDK.Object, nodes []netmap.NodeInfo) []error {
+	errs := make([]error, len(nodes))
+
+	if err := p.bandwidth.Wait(ctx, float64(len(obj.Payload())*len(nodes))); err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+
+		return errs
+	}
+
+	dones := make([]chan error, len(nodes))
+
+	for i := range nodes {
+		dones[i] = make(chan error, 1)
+		p.batches.push(p, nodes[i], batchItem{
+			ctx:  ctx,
+			obj:  obj,
+			done: dones[i],
+		})
+	}
+
+	for i := range dones {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+		case errs[i] = <-dones[i]:
+		}
+	}
+
+	return errs
+}
+
+func (b *batches) push(p *Replicator, node netmap.NodeInfo, item batchItem) {
+	key := string(node.PublicKey())
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	nb, ok := b.nodes[key]
+	if !ok {
+		if b.nodes == nil {
+			b.nodes = make(map[string]*nodeBatch)
+		}
+
+		nb = &nodeBatch{node: node}
+		b.nodes[key] = nb
+
+		go p.sendBatches(key, nb)
+	}
+
+	nb.items = append(nb.items, item)
+}
+
+// sendBatches sends the accumulated objects to the node until
+// there are no more objects bound for it.
+func (p *Replicator) sendBatches(key string, nb *nodeBatch) {
+	for {
+		p.batches.mtx.Lock()
+
+		items := nb.items
+		if len(items) > maxBatchSize {
+			items = items[:maxBatchSize]
+		}
+
+		nb.items = nb.items[len(items):]
+
+		if len(items) == 0 {
+			delete(p.batches.nodes, key)
+			p.batches.mtx.Unlock()
+
+			return
+		}
+
+		p.batches.mtx.Unlock()
+
+		p.sendBatch(nb.node, items)
+	}
+}
+
+// sendBatch sends objects of the batch to the node in parallel over the
+// same connection. Every object is sent with its own timeout, so a slow
+// object does not fail the rest of the batch.
+func (p *Replicator) sendBatch(node netmap.NodeInfo, items []batchItem) {
+	var wg sync.WaitGroup
+
+	for i := range items {
+		wg.Add(1)
+
+		go func(item batchItem) {
+			defer wg.Done()
+
+			err := item.ctx.Err()
+			if err == nil {
+				ctx, cancel := context.WithTimeout(item.ctx, p.putTimeout.Load())
+
+				err = p.remoteSender.PutObject(ctx, new(putsvc.RemotePutPrm).
+					WithNodeInfo(node).
+					WithObject(item.obj),
+				)
+
+				cancel()
+			}
+
+			item.done <- err
+		}(items[i])
+	}
+
+	wg.Wait()
+}
@@ -1,11 +1,18 @@
 package replicator
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
 
@@ -17,8 +24,24 @@ type TaskResult interface {
 	SubmitSuccessfulReplication(id uint64)
 }
 
+const (
+	// smallObjectSize is a maximum payload size of the objects
+	// held in memory and sent via per-node batches.
+	smallObjectSize = 256 << 10
+
+	// streamChunkSize is a size of the payload chunk read from the
+	// local storage and sent to all the target nodes at once.
+	streamChunkSize = 64 << 10
+)
+
 // HandleTask executes replication task inside invoking goroutine.
 // Passes all the nodes that accepted the replication to the TaskResult.
+//
+// The object is sent to as many nodes as replicas are missing in parallel,
+// the next nodes are tried only if some of them fail. Payload of the big
+// objects is streamed from the local storage to all the nodes at once
+// without loading the whole object into memory. Small objects are read
+// into memory once and sent via per-node batches.
 func (p *Replicator) HandleTask(ctx context.Context, task *Task, res TaskResult) {
 	defer func() {
 		p.log.Debug("finish work",
@@ -26,7 +49,7 @@ func (p *Replicator) HandleTask(ctx context.Context, task *Task, res TaskResult)
 		)
 	}()
 
-	obj, err := engine.Get(p.localStorage, task.addr)
+	hdr, payload, err := p.openObject(task)
 	if err != nil {
 		p.log.Error("could not get object from local storage",
 			zap.Stringer("object", task.addr),
@@ -35,37 +58,208 @@ func (p *Replicator) HandleTask(ctx context.Context, task *Task, res TaskResult)
 		return
 	}
 
-	prm := new(putsvc.RemotePutPrm).
-		WithObject(obj)
+	var obj *objectSDK.Object
+
+	if hdr.PayloadSize() <= smallObjectSize {
+		obj, err = readObject(hdr, payload)
+		if err != nil {
+			p.log.Error("could not read object payload from local storage",
+				zap.Stringer("object", task.addr),
+				zap.Error(err))
+
+			return
+		}
+
+		payload = nil
+	}
+
+	defer func() {
+		if payload != nil {
+			_ = payload.Close()
+		}
+	}()
 
-	for i := 0; task.quantity > 0 && i < len(task.nodes); i++ {
+	for next := 0; task.quantity > 0 && next < len(task.nodes); {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 		}
 
-		log := p.log.With(
-			zap.String("node", hex.EncodeToString(task.nodes[i].PublicKey())),
-			zap.Stringer("object", task.addr),
-		)
+		n := len(task.nodes) - next
+		if n > int(task.quantity) {
+			n = int(task.quantity)
+		}
 
-		callCtx, cancel := context.WithTimeout(ctx, p.putTimeout)
+		nodes := task.nodes[next : next+n]
+		next += n
 
-		err = p.remoteSender.PutObject(callCtx, prm.WithNodeInfo(task.nodes[i]))
+		var errs []error
 
-		cancel()
+		if obj != nil {
+			errs = p.sendSmall(ctx, obj, nodes)
+		} else {
+			if payload == nil {
+				hdr, payload, err = p.openObject(task)
+				if err != nil {
+					p.log.Error("could not get object from local storage",
+						zap.Stringer("object", task.addr),
+						zap.Error(err))
 
-		if err != nil {
-			log.Error("could not replicate object",
-				zap.String("error", err.Error()),
+					return
+				}
+			}
+
+			errs = p.stream(ctx, hdr, payload, nodes)
+			payload = nil
+		}
+
+		for i := range nodes {
+			nodeKey := hex.EncodeToString(nodes[i].PublicKey())
+
+			log := p.log.With(
+				zap.String("node", nodeKey),
+				zap.Stringer("object", task.addr),
 			)
-		} else {
+
+			if errs[i] != nil {
+				log.Error("could not replicate object",
+					zap.String("error", errs[i].Error()),
+				)
+
+				if p.metrics != nil {
+					p.metrics.AddReplicationFailure(nodeKey)
+				}
+
+				continue
+			}
+
 			log.Debug("object successfully replicated")
 
+			if p.metrics != nil {
+				p.metrics.AddReplicationSuccess(nodeKey)
+			}
+
 			task.quantity--
 
-			res.SubmitSuccessfulReplication(task.nodes[i].Hash())
+			res.SubmitSuccessfulReplication(nodes[i].Hash())
+		}
+	}
+}
+
+// openObject returns the header of the replicated object and
+// the reader of its payload.
+func (p *Replicator) openObject(task *Task) (*objectSDK.Object, io.ReadCloser, error) {
+	if task.obj != nil {
+		return task.obj.CutPayload(), io.NopCloser(bytes.NewReader(task.obj.Payload())), nil
+	}
+
+	var prm engine.GetPrm
+	prm.WithAddress(task.addr)
+
+	res, err := p.localStorage.GetStream(prm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Header(), res.Payload(), nil
+}
+
+// readObject reads the whole payload and closes the reader.
+func readObject(hdr *objectSDK.Object, payload io.ReadCloser) (*objectSDK.Object, error) {
+	defer payload.Close()
+
+	data := make([]byte, hdr.PayloadSize())
+
+	if _, err := io.ReadFull(payload, data); err != nil {
+		return nil, err
+	}
+
+	hdr.SetPayload(data)
+
+	return hdr, nil
+}
+
+// errStreamFinished is returned to the payload stream writer
+// when the object sending has finished before the end of the stream.
+var errStreamFinished = errors.New("object sending finished")
+
+// stream sends the object to all the given nodes in parallel reading its
+// payload once. Returns the result of the sending for each node. Closes
+// the payload reader.
+func (p *Replicator) stream(ctx context.Context, hdr *objectSDK.Object, payload io.ReadCloser, nodes []netmap.NodeInfo) []error {
+	defer payload.Close()
+
+	var (
+		wg      sync.WaitGroup
+		errs    = make([]error, len(nodes))
+		writers = make([]*io.PipeWriter, len(nodes))
+	)
+
+	for i := range nodes {
+		pr, pw := io.Pipe()
+		writers[i] = pw
+
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			callCtx, cancel := context.WithTimeout(ctx, p.putTimeout.Load())
+
+			errs[i] = p.remoteSender.PutObject(callCtx, new(putsvc.RemotePutPrm).
+				WithNodeInfo(nodes[i]).
+				WithObject(hdr).
+				WithPayloadReader(pr),
+			)
+
+			cancel()
+
+			// unblock the writer if the sending has failed
+			_ = pr.CloseWithError(errStreamFinished)
+		}(i)
+	}
+
+	var (
+		errRead error
+		active  = len(writers)
+		buf     = make([]byte, streamChunkSize)
+	)
+
+	for active > 0 {
+		n, err := payload.Read(buf)
+		if n > 0 {
+			if errRead = p.bandwidth.Wait(ctx, float64(n*active)); errRead != nil {
+				break
+			}
+
+			for i := range writers {
+				if writers[i] == nil {
+					continue
+				}
+
+				if _, err := writers[i].Write(buf[:n]); err != nil {
+					writers[i] = nil
+					active--
+				}
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			errRead = fmt.Errorf("read payload from local storage: %w", err)
+			break
+		}
+	}
+
+	for i := range writers {
+		if writers[i] != nil {
+			_ = writers[i].CloseWithError(errRead)
 		}
 	}
+
+	wg.Wait()
+
+	return errs
 }
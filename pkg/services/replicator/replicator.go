@@ -6,6 +6,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
@@ -13,19 +15,34 @@ import (
 // local objects to remote nodes.
 type Replicator struct {
 	*cfg
+
+	bandwidth *rate.Limiter
+
+	batches batches
+}
+
+// MetricRegister collects the results of the replication to the nodes.
+type MetricRegister interface {
+	AddReplicationSuccess(node string)
+	AddReplicationFailure(node string)
 }
 
 // Option is an option for Policer constructor.
 type Option func(*cfg)
 
 type cfg struct {
-	putTimeout time.Duration
+	putTimeout atomic.Duration
 
 	log *logger.Logger
 
 	remoteSender *putsvc.RemoteSender
 
 	localStorage *engine.StorageEngine
+
+	// bytes per second, zero means no limit
+	maxBandwidth atomic.Uint64
+
+	metrics MetricRegister
 }
 
 func defaultCfg() *cfg {
@@ -43,14 +60,48 @@ func New(opts ...Option) *Replicator {
 	c.log = c.log.With(zap.String("component", "Object Replicator"))
 
 	return &Replicator{
-		cfg: c,
+		cfg:       c,
+		bandwidth: rate.NewLimiter(float64(c.maxBandwidth.Load())),
 	}
 }
 
+// minBandwidthFactor is a minimal part of the maximum bandwidth
+// available for replication under the full load of the node.
+const minBandwidthFactor = 0.1
+
+// TuneBandwidth adapts replication bandwidth to the load of the node
+// in [0:1] range: the more the node is loaded, the less bandwidth is used.
+// Does nothing if bandwidth is not limited.
+func (p *Replicator) TuneBandwidth(load float64) {
+	maxBandwidth := p.maxBandwidth.Load()
+	if maxBandwidth == 0 {
+		return
+	}
+
+	factor := 1 - load
+	if factor < minBandwidthFactor {
+		factor = minBandwidthFactor
+	}
+
+	p.bandwidth.SetLimit(factor * float64(maxBandwidth))
+}
+
+// SetPutTimeout sets Put timeout of Replicator at runtime.
+func (p *Replicator) SetPutTimeout(v time.Duration) {
+	p.putTimeout.Store(v)
+}
+
+// SetMaxBandwidth sets maximum number of bytes sent per second at runtime.
+// Zero means no limit.
+func (p *Replicator) SetMaxBandwidth(v uint64) {
+	p.maxBandwidth.Store(v)
+	p.bandwidth.SetLimit(float64(v))
+}
+
 // WithPutTimeout returns option to set Put timeout of Replicator.
 func WithPutTimeout(v time.Duration) Option {
 	return func(c *cfg) {
-		c.putTimeout = v
+		c.putTimeout.Store(v)
 	}
 }
 
@@ -74,3 +125,19 @@ func WithLocalStorage(v *engine.StorageEngine) Option {
 		c.localStorage = v
 	}
 }
+
+// WithBandwidthLimit returns option to set maximum number of bytes
+// per second sent by Replicator. Zero means no limit.
+func WithBandwidthLimit(v uint64) Option {
+	return func(c *cfg) {
+		c.maxBandwidth.Store(v)
+	}
+}
+
+// WithMetrics returns option to set the collector of the per-node
+// replication results.
+func WithMetrics(v MetricRegister) Option {
+	return func(c *cfg) {
+		c.metrics = v
+	}
+}
@@ -2,6 +2,7 @@ package replicator
 
 import (
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
@@ -12,6 +13,8 @@ type Task struct {
 	addr oid.Address
 
 	nodes []netmap.NodeInfo
+
+	obj *objectSDK.Object
 }
 
 // WithCopiesNumber sets number of copies to replicate.
@@ -40,3 +43,13 @@ func (t *Task) WithNodes(v []netmap.NodeInfo) *Task {
 
 	return t
 }
+
+// WithObject sets object to replicate. If set, the object
+// is not read from the local storage.
+func (t *Task) WithObject(obj *objectSDK.Object) *Task {
+	if t != nil {
+		t.obj = obj
+	}
+
+	return t
+}
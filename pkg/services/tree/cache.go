@@ -2,8 +2,8 @@ package tree
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -11,12 +11,15 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 type clientCache struct {
 	sync.Mutex
 	simplelru.LRU
+
+	tls *network.TLSIdentity
 }
 
 type cacheItem struct {
@@ -30,14 +33,19 @@ const (
 	defaultReconnectInterval    = time.Second * 15
 )
 
-func (c *clientCache) init() {
+func (c *clientCache) init(tls *network.TLSIdentity) {
+	c.tls = tls
+
 	l, _ := simplelru.NewLRU(defaultClientCacheSize, func(key, value interface{}) {
 		_ = value.(*grpc.ClientConn).Close()
 	})
 	c.LRU = *l
 }
 
-func (c *clientCache) get(ctx context.Context, netmapAddr string) (TreeServiceClient, error) {
+// get returns client of the tree service listening netmapAddr. pub is
+// a public key of the node from the network map, it is checked against
+// the server certificate for TLS connections.
+func (c *clientCache) get(ctx context.Context, netmapAddr string, pub []byte) (TreeServiceClient, error) {
 	c.Lock()
 	ccInt, ok := c.LRU.Get(netmapAddr)
 	c.Unlock()
@@ -57,7 +65,7 @@ func (c *clientCache) get(ctx context.Context, netmapAddr string) (TreeServiceCl
 		}
 	}
 
-	cc, err := dialTreeService(ctx, netmapAddr)
+	cc, err := c.dialTreeService(ctx, netmapAddr, pub)
 	lastTry := time.Now()
 
 	c.Lock()
@@ -75,22 +83,23 @@ func (c *clientCache) get(ctx context.Context, netmapAddr string) (TreeServiceCl
 	return NewTreeServiceClient(cc), nil
 }
 
-func dialTreeService(ctx context.Context, netmapAddr string) (*grpc.ClientConn, error) {
+func (c *clientCache) dialTreeService(ctx context.Context, netmapAddr string, pub []byte) (*grpc.ClientConn, error) {
 	var netAddr network.Address
 	if err := netAddr.FromString(netmapAddr); err != nil {
 		return nil, err
 	}
 
-	opts := make([]grpc.DialOption, 1, 2)
-	opts[0] = grpc.WithBlock()
-
-	// FIXME(@fyrchik): ugly hack #1322
-	if !strings.HasPrefix(netAddr.URIAddr(), "grpcs:") {
-		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds := insecure.NewCredentials()
+	if netAddr.IsTLSEnabled() {
+		if c.tls != nil {
+			creds = credentials.NewTLS(c.tls.ClientConfig(pub, netAddr))
+		} else {
+			creds = credentials.NewTLS(&tls.Config{})
+		}
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, defaultClientConnectTimeout)
-	cc, err := grpc.DialContext(ctx, netAddr.URIAddr(), opts...)
+	cc, err := grpc.DialContext(ctx, netAddr.HostAddr(), grpc.WithBlock(), grpc.WithTransportCredentials(creds))
 	cancel()
 
 	return cc, err
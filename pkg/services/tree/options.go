@@ -7,6 +7,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"go.uber.org/zap"
 )
 
@@ -17,6 +18,7 @@ type cfg struct {
 	nmSource  netmap.Source
 	cnrSource container.Source
 	forest    pilorama.Forest
+	tls       *network.TLSIdentity
 	// replication-related parameters
 	replicatorChannelCapacity int
 	replicatorWorkerCount     int
@@ -51,6 +53,16 @@ func WithPrivateKey(key *ecdsa.PrivateKey) Option {
 	}
 }
 
+// WithTLSIdentity sets TLS identity of the local node. If set, TLS
+// connections to other nodes are established only if their certificates
+// are bound to the node keys from the network map or trusted by the system
+// certificate authorities (see network.TLSIdentity.ClientConfig).
+func WithTLSIdentity(id *network.TLSIdentity) Option {
+	return func(c *cfg) {
+		c.tls = id
+	}
+}
+
 // WithLogger sets logger for a tree service.
 func WithLogger(log *zap.Logger) Option {
 	return func(c *cfg) {
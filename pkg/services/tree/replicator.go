@@ -22,6 +22,8 @@ type movePair struct {
 type replicationTask struct {
 	n   netmapSDK.NodeInfo
 	req *ApplyRequest
+	// drop is set instead of req to replicate the tree removal.
+	drop *TreeDropRequest
 }
 
 const (
@@ -42,14 +44,18 @@ func (s *Service) replicationWorker() {
 			task.n.IterateNetworkEndpoints(func(addr string) bool {
 				lastAddr = addr
 
-				c, err := s.cache.get(context.Background(), addr)
+				c, err := s.cache.get(context.Background(), addr, task.n.PublicKey())
 				if err != nil {
 					lastErr = fmt.Errorf("can't create client: %w", err)
 					return false
 				}
 
 				ctx, cancel := context.WithTimeout(context.Background(), defaultReplicatorSendTimeout)
-				_, lastErr = c.Apply(ctx, task.req)
+				if task.drop != nil {
+					_, lastErr = c.TreeDrop(ctx, task.drop)
+				} else {
+					_, lastErr = c.Apply(ctx, task.req)
+				}
 				cancel()
 
 				return lastErr == nil
@@ -107,12 +113,51 @@ func (s *Service) replicate(op movePair) error {
 
 	for i := range nodes {
 		if i != localIndex {
-			s.replicationTasks <- replicationTask{nodes[i], req}
+			s.replicationTasks <- replicationTask{n: nodes[i], req: req}
 		}
 	}
 	return nil
 }
 
+// replicateDrop sends the tree removal to the other container nodes.
+func (s *Service) replicateDrop(cid cidSDK.ID, treeID string, nodes []netmapSDK.NodeInfo, localIndex int) {
+	if len(nodes) < 2 {
+		// The local node is the only container node.
+		return
+	}
+
+	rawCID := make([]byte, sha256.Size)
+	cid.Encode(rawCID)
+
+	req := &TreeDropRequest{
+		Body: &TreeDropRequest_Body{
+			ContainerId: rawCID,
+			TreeId:      treeID,
+		},
+	}
+
+	err := signMessage(req, s.key)
+	if err != nil {
+		s.log.Error("can't sign tree removal for replication",
+			zap.String("err", err.Error()),
+			zap.Stringer("cid", cid),
+			zap.String("treeID", treeID))
+		return
+	}
+
+	for i := range nodes {
+		if i == localIndex {
+			continue
+		}
+
+		select {
+		case s.replicationTasks <- replicationTask{n: nodes[i], drop: req}:
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
 func (s *Service) pushToQueue(cid cidSDK.ID, treeID string, op *pilorama.LogMove) {
 	select {
 	case s.replicateCh <- movePair{
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	cidSDK "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -23,6 +24,9 @@ type Service struct {
 	replicationTasks chan replicationTask
 	closeCh          chan struct{}
 	containerCache   containerCache
+
+	syncMtx     sync.Mutex
+	syncHeights map[string]uint64
 }
 
 // MaxGetSubTreeDepth represents maximum allowed traversal depth in GetSubTree RPC.
@@ -45,11 +49,12 @@ func New(opts ...Option) *Service {
 		s.log = zap.NewNop()
 	}
 
-	s.cache.init()
+	s.cache.init(s.tls)
 	s.closeCh = make(chan struct{})
 	s.replicateCh = make(chan movePair, s.replicatorChannelCapacity)
 	s.replicationTasks = make(chan replicationTask, s.replicatorWorkerCount)
 	s.containerCache.init(s.containerCacheSize)
+	s.syncHeights = make(map[string]uint64)
 
 	return &s
 }
@@ -354,6 +359,9 @@ func (s *Service) GetSubTree(req *GetSubTreeRequest, srv TreeService_GetSubTreeS
 	if b.GetDepth() > MaxGetSubTreeDepth {
 		return fmt.Errorf("too big depth: max=%d, got=%d", MaxGetSubTreeDepth, b.GetDepth())
 	}
+	if b.GetOrderBy() == "" && (len(b.GetStartAfter()) != 0 || b.GetStartAfterId() != 0 || b.GetCount() != 0) {
+		return errors.New("pagination requires `order_by` to be set")
+	}
 
 	var cid cidSDK.ID
 	if err := cid.Decode(b.GetContainerId()); err != nil {
@@ -389,6 +397,10 @@ func (s *Service) GetSubTree(req *GetSubTreeRequest, srv TreeService_GetSubTreeS
 		return nil
 	}
 
+	if b.GetOrderBy() != "" {
+		return s.getSortedSubTree(srv, cid, b)
+	}
+
 	queue := []nodeDepthPair{{[]uint64{b.GetRootId()}, 0}}
 
 	for len(queue) != 0 {
@@ -425,6 +437,77 @@ func (s *Service) GetSubTree(req *GetSubTreeRequest, srv TreeService_GetSubTreeS
 	return nil
 }
 
+// getSortedSubTree sends the subtree in the depth-first order with children
+// of every node sorted by the `order_by` attribute. Cursor and count
+// are applied to the children of the root only.
+func (s *Service) getSortedSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, b *GetSubTreeRequest_Body) error {
+	m, p, err := s.forest.TreeGetMeta(cid, b.GetTreeId(), b.GetRootId())
+	if err != nil {
+		return err
+	}
+
+	root := pilorama.NodeInfo{ID: b.GetRootId(), ParentID: p, Meta: m}
+	if err := sendSubTreeNode(srv, root); err != nil {
+		return err
+	}
+	if b.GetDepth() == 0 {
+		return nil
+	}
+
+	var after *pilorama.ChildrenCursor
+	if len(b.GetStartAfter()) != 0 || b.GetStartAfterId() != 0 {
+		after = &pilorama.ChildrenCursor{
+			Value: b.GetStartAfter(),
+			ID:    b.GetStartAfterId(),
+		}
+	}
+
+	children, err := s.forest.TreeGetChildrenSorted(cid, b.GetTreeId(), root.ID, b.GetOrderBy(), after, int(b.GetCount()))
+	if err != nil {
+		return err
+	}
+
+	for i := range children {
+		err := s.sendSortedSubTree(srv, cid, b.GetTreeId(), b.GetOrderBy(), children[i], b.GetDepth()-1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) sendSortedSubTree(srv TreeService_GetSubTreeServer, cid cidSDK.ID, treeID string, attr string, node pilorama.NodeInfo, depth uint32) error {
+	if err := sendSubTreeNode(srv, node); err != nil {
+		return err
+	}
+	if depth == 0 {
+		return nil
+	}
+
+	children, err := s.forest.TreeGetChildrenSorted(cid, treeID, node.ID, attr, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	for i := range children {
+		if err := s.sendSortedSubTree(srv, cid, treeID, attr, children[i], depth-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sendSubTreeNode(srv TreeService_GetSubTreeServer, node pilorama.NodeInfo) error {
+	return srv.Send(&GetSubTreeResponse{
+		Body: &GetSubTreeResponse_Body{
+			NodeId:    node.ID,
+			ParentId:  node.ParentID,
+			Timestamp: node.Meta.Time,
+			Meta:      metaToProto(node.Meta.Items),
+		},
+	})
+}
+
 // Apply locally applies operation from the remote node to the tree.
 func (s *Service) Apply(_ context.Context, req *ApplyRequest) (*ApplyResponse, error) {
 	err := verifyMessage(req)
@@ -519,6 +602,120 @@ func (s *Service) GetOpLog(req *GetOpLogRequest, srv TreeService_GetOpLogServer)
 	}
 }
 
+func (s *Service) TreeList(ctx context.Context, req *TreeListRequest) (*TreeListResponse, error) {
+	var cid cidSDK.ID
+
+	err := cid.Decode(req.GetBody().GetContainerId())
+	if err != nil {
+		return nil, err
+	}
+
+	// just verify the signature, not ACL checks
+	// since tree ID is somehow public
+	err = verifyMessage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return nil, err
+	}
+	if pos < 0 {
+		var resp *TreeListResponse
+		var outErr error
+		err = s.forEachNode(ctx, ns, func(c TreeServiceClient) bool {
+			resp, outErr = c.TreeList(ctx, req)
+			return outErr == nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp, outErr
+	}
+
+	ids, err := s.forest.TreeList(cid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TreeListResponse{
+		Body: &TreeListResponse_Body{
+			Ids: ids,
+		},
+	}, nil
+}
+
+func (s *Service) TreeDrop(ctx context.Context, req *TreeDropRequest) (*TreeDropResponse, error) {
+	b := req.GetBody()
+
+	var cid cidSDK.ID
+	if err := cid.Decode(b.GetContainerId()); err != nil {
+		return nil, err
+	}
+
+	err := verifyMessage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ns, pos, err := s.getContainerNodes(cid)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &TreeDropResponse{
+		Body: &TreeDropResponse_Body{},
+	}
+
+	// The request replicated by the other container node
+	// is only applied to the local storage.
+	for i := range ns {
+		if i != pos && bytes.Equal(ns[i].PublicKey(), req.GetSignature().GetKey()) {
+			if pos < 0 {
+				return nil, errors.New("`TreeDrop` request must be replicated to a container node")
+			}
+
+			err = s.forest.TreeDrop(cid, b.GetTreeId())
+			if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+				return nil, err
+			}
+			return resp, nil
+		}
+	}
+
+	err = s.verifyClient(req, cid, b.GetBearerToken(), eacl.OperationDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	if pos < 0 {
+		var outErr error
+		err = s.forEachNode(ctx, ns, func(c TreeServiceClient) bool {
+			resp, outErr = c.TreeDrop(ctx, req)
+			return outErr == nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp, outErr
+	}
+
+	err = s.forest.TreeDrop(cid, b.GetTreeId())
+	if err != nil {
+		return nil, err
+	}
+
+	s.replicateDrop(cid, b.GetTreeId(), ns, pos)
+	return resp, nil
+}
+
+// DropTrees removes all trees of the container from the local storage.
+// It is called when the container is removed from the network.
+func (s *Service) DropTrees(cid cidSDK.ID) error {
+	return s.forest.TreeDrop(cid, "")
+}
+
 func protoToMeta(arr []*KeyValue) []pilorama.KeyValue {
 	meta := make([]pilorama.KeyValue, len(arr))
 	for i, kv := range arr {
@@ -0,0 +1,240 @@
+package tree
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	containercore "github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type testNetmapSource struct {
+	netmap.Source
+	nm *netmapSDK.NetMap
+}
+
+func (s testNetmapSource) GetNetMap(uint64) (*netmapSDK.NetMap, error) {
+	return s.nm, nil
+}
+
+type testEnv struct {
+	s     *Service
+	owner *keys.PrivateKey
+	nodes []*keys.PrivateKey
+}
+
+// newTestService returns the service of the first node from the nodes
+// of the container stored on nodeCount nodes. Nodes have no network endpoints.
+func newTestService(t *testing.T, cnr cid.ID, nodeCount int) testEnv {
+	var env testEnv
+
+	var err error
+	env.owner, err = keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	infos := make([]netmapSDK.NodeInfo, nodeCount)
+	for i := range infos {
+		k, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+		env.nodes = append(env.nodes, k)
+
+		infos[i].SetPublicKey(k.PublicKey().Bytes())
+	}
+
+	var nm netmapSDK.NetMap
+	nm.SetNodes(infos)
+
+	var r netmapSDK.ReplicaDescriptor
+	r.SetNumberOfObjects(uint32(nodeCount))
+
+	var pp netmapSDK.PlacementPolicy
+	pp.AddReplicas(r)
+
+	var ownerID user.ID
+	user.IDFromKey(&ownerID, (ecdsa.PublicKey)(*env.owner.PublicKey()))
+
+	cnt := testContainer(ownerID)
+	cnt.SetPlacementPolicy(pp)
+
+	env.s = New(
+		WithLogger(zaptest.NewLogger(t)),
+		WithPrivateKey(&env.nodes[0].PrivateKey),
+		WithNetmapSource(testNetmapSource{nm: &nm}),
+		WithContainerSource(dummyContainerSource{
+			cnr.String(): &containercore.Container{Value: cnt},
+		}),
+		WithStorage(pilorama.NewMemoryForest()))
+
+	_, pos, err := env.s.getContainerNodes(cnr)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, pos, 0)
+
+	return env
+}
+
+// signTestMessage signs the message in the same format as signMessage does.
+func signTestMessage(t *testing.T, m message, key *ecdsa.PrivateKey) {
+	data, err := m.ReadSignedData(nil)
+	require.NoError(t, err)
+
+	h := sha512.Sum512(data)
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, h[:])
+	require.NoError(t, err)
+
+	sign := make([]byte, 65)
+	sign[0] = 0x04
+	r.FillBytes(sign[1:33])
+	s.FillBytes(sign[33:])
+
+	m.SetSignature(&Signature{
+		Key:  elliptic.MarshalCompressed(key.Curve, key.X, key.Y),
+		Sign: sign,
+	})
+}
+
+func addTestOps(t *testing.T, f pilorama.Forest, cnr cid.ID, treeID string, count int) {
+	for i := 0; i < count; i++ {
+		_, err := f.TreeMove(pilorama.CIDDescriptor{CID: cnr, Size: 1}, treeID, &pilorama.Move{
+			Parent: pilorama.RootID,
+			Child:  pilorama.RootID,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestService_CompactLog(t *testing.T) {
+	const treeID = "version"
+
+	t.Run("single node", func(t *testing.T) {
+		cnr := cidtest.ID()
+		env := newTestService(t, cnr, 1)
+
+		addTestOps(t, env.s.forest, cnr, treeID, 5)
+
+		require.ErrorIs(t, env.s.CompactLog(cnr, treeID, 0), errNotSynchronized)
+
+		require.NoError(t, env.s.Synchronize(context.Background(), cnr, treeID))
+
+		height, err := env.s.localHeight(cnr, treeID)
+		require.NoError(t, err)
+		require.NotZero(t, height)
+		require.Equal(t, height, env.s.syncHeight(cnr, treeID))
+
+		require.Error(t, env.s.CompactLog(cnr, treeID, height+1))
+		require.NoError(t, env.s.CompactLog(cnr, treeID, height))
+		require.NoError(t, env.s.CompactLog(cnr, treeID, 0))
+
+		// New operations get the timestamps above the synchronized height.
+		addTestOps(t, env.s.forest, cnr, treeID, 1)
+		lm, err := env.s.forest.TreeGetOpLog(cnr, treeID, height)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, lm.Time, height)
+	})
+
+	t.Run("unavailable node", func(t *testing.T) {
+		cnr := cidtest.ID()
+		env := newTestService(t, cnr, 2)
+
+		addTestOps(t, env.s.forest, cnr, treeID, 5)
+
+		// The second node has no endpoints, so the log can't be synchronized.
+		require.NoError(t, env.s.Synchronize(context.Background(), cnr, treeID))
+		require.Zero(t, env.s.syncHeight(cnr, treeID))
+		require.ErrorIs(t, env.s.CompactLog(cnr, treeID, 1), errNotSynchronized)
+	})
+
+	t.Run("synchronized height", func(t *testing.T) {
+		cnr := cidtest.ID()
+		env := newTestService(t, cnr, 2)
+
+		addTestOps(t, env.s.forest, cnr, treeID, 5)
+
+		env.s.setSyncHeight(cnr, treeID, 3)
+		env.s.setSyncHeight(cnr, treeID, 2) // the height is never decreased
+		require.EqualValues(t, 3, env.s.syncHeight(cnr, treeID))
+
+		require.Error(t, env.s.CompactLog(cnr, treeID, 4))
+		require.NoError(t, env.s.CompactLog(cnr, treeID, 0))
+	})
+}
+
+func TestService_TreeDrop(t *testing.T) {
+	const treeID = "version"
+
+	dropReq := func(t *testing.T, cnr cid.ID, key *keys.PrivateKey) *TreeDropRequest {
+		rawCID := make([]byte, sha256.Size)
+		cnr.Encode(rawCID)
+
+		req := &TreeDropRequest{
+			Body: &TreeDropRequest_Body{
+				ContainerId: rawCID,
+				TreeId:      treeID,
+			},
+		}
+		signTestMessage(t, req, &key.PrivateKey)
+		return req
+	}
+
+	treeList := func(t *testing.T, env testEnv, cnr cid.ID) []string {
+		ids, err := env.s.forest.TreeList(cnr)
+		require.NoError(t, err)
+		return ids
+	}
+
+	t.Run("not an owner", func(t *testing.T) {
+		cnr := cidtest.ID()
+		env := newTestService(t, cnr, 2)
+		addTestOps(t, env.s.forest, cnr, treeID, 1)
+
+		k, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		_, err = env.s.TreeDrop(context.Background(), dropReq(t, cnr, k))
+		require.Error(t, err)
+		require.Equal(t, []string{treeID}, treeList(t, env, cnr))
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		cnr := cidtest.ID()
+		env := newTestService(t, cnr, 1)
+		addTestOps(t, env.s.forest, cnr, treeID, 1)
+
+		_, err := env.s.TreeDrop(context.Background(), dropReq(t, cnr, env.owner))
+		require.NoError(t, err)
+		require.Empty(t, treeList(t, env, cnr))
+
+		_, err = env.s.TreeDrop(context.Background(), dropReq(t, cnr, env.owner))
+		require.ErrorIs(t, err, pilorama.ErrTreeNotFound)
+	})
+
+	t.Run("replicated", func(t *testing.T) {
+		cnr := cidtest.ID()
+		env := newTestService(t, cnr, 2)
+		addTestOps(t, env.s.forest, cnr, treeID, 1)
+
+		_, err := env.s.TreeDrop(context.Background(), dropReq(t, cnr, env.nodes[1]))
+		require.NoError(t, err)
+		require.Empty(t, treeList(t, env, cnr))
+
+		// The replicated request is not replicated further.
+		require.Empty(t, env.s.replicationTasks)
+
+		// The tree can be already removed by the container removal.
+		_, err = env.s.TreeDrop(context.Background(), dropReq(t, cnr, env.nodes[1]))
+		require.NoError(t, err)
+	})
+}
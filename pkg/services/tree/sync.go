@@ -8,54 +8,123 @@ import (
 	"io"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
-	"github.com/nspcc-dev/neofs-node/pkg/network"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
-// Synchronize tries to synchronize log starting from the last stored height.
+// errNotSynchronized is returned on the log compaction of the tree
+// which hasn't been synchronized with all the container nodes yet.
+var errNotSynchronized = errors.New("tree is not synchronized with all the container nodes")
+
+// Synchronize tries to synchronize log starting from the last synchronized height.
+//
+// If the log is read from all the other container nodes, the synchronized
+// height is advanced to the lowest height reached by them: every operation
+// below it is stored locally and new operations of the container nodes
+// can't get a lower timestamp, so the log below it can be compacted.
 func (s *Service) Synchronize(ctx context.Context, cid cid.ID, treeID string) error {
-	nodes, _, err := s.getContainerNodes(cid)
+	nodes, localIndex, err := s.getContainerNodes(cid)
 	if err != nil {
 		return fmt.Errorf("can't get container nodes: %w", err)
 	}
 
-	lm, err := s.forest.TreeGetOpLog(cid, treeID, 0)
-	if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
-		return err
-	}
+	start := s.syncHeight(cid, treeID)
+	synced := ^uint64(0)
+	complete := true
+
+	for i, n := range nodes {
+		if i == localIndex {
+			continue
+		}
+
+		var done bool
 
-	height := lm.Time + 1
-	for _, n := range nodes {
 		n.IterateNetworkEndpoints(func(addr string) bool {
-			var a network.Address
-			if err := a.FromString(addr); err != nil {
+			treeClient, err := s.cache.get(ctx, addr, n.PublicKey())
+			if err != nil {
+				// Failed to connect, try the next address.
 				return false
 			}
 
-			cc, err := grpc.DialContext(ctx, a.URIAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
-			if err != nil {
-				// Failed to connect, try the next address.
+			h, err := s.synchronizeSingle(ctx, cid, treeID, start, treeClient)
+			if err != nil && !errors.Is(err, io.EOF) {
+				// Error with the response, try the next address.
 				return false
 			}
 
-			treeClient := NewTreeServiceClient(cc)
-			for {
-				h, err := s.synchronizeSingle(ctx, cid, treeID, height, treeClient)
-				if height < h {
-					height = h
-				}
-				if err != nil || h <= height {
-					// Error with the response, try the next node.
-					return true
-				}
+			if h < synced {
+				synced = h
 			}
+			done = true
+			return true
 		})
+
+		complete = complete && done
+	}
+
+	if complete {
+		if synced == ^uint64(0) {
+			// There are no other container nodes.
+			synced, err = s.localHeight(cid, treeID)
+			if err != nil {
+				return err
+			}
+		}
+		s.setSyncHeight(cid, treeID, synced)
 	}
 	return nil
 }
 
+// CompactLog replaces the operations of the tree log below the height with
+// the snapshot of the tree state. Zero height means the synchronized height
+// of the tree, see Synchronize. Heights above the synchronized one are
+// rejected, because the operations below them may be not received yet.
+func (s *Service) CompactLog(cid cid.ID, treeID string, height uint64) error {
+	synced := s.syncHeight(cid, treeID)
+	if synced == 0 {
+		return errNotSynchronized
+	}
+
+	if height == 0 {
+		height = synced
+	} else if height > synced {
+		return fmt.Errorf("height %d is above the synchronized height %d", height, synced)
+	}
+
+	return s.forest.TreeCompactLog(cid, treeID, height)
+}
+
+// localHeight returns the height following the last operation of the local log.
+func (s *Service) localHeight(cid cid.ID, treeID string) (uint64, error) {
+	var height uint64
+	for {
+		lm, err := s.forest.TreeGetOpLog(cid, treeID, height)
+		if err != nil && !errors.Is(err, pilorama.ErrTreeNotFound) {
+			return 0, err
+		}
+		if err != nil || lm.Time == 0 {
+			return height, nil
+		}
+		height = lm.Time + 1
+	}
+}
+
+func (s *Service) syncHeight(cid cid.ID, treeID string) uint64 {
+	s.syncMtx.Lock()
+	defer s.syncMtx.Unlock()
+
+	return s.syncHeights[cid.EncodeToString()+treeID]
+}
+
+func (s *Service) setSyncHeight(cid cid.ID, treeID string, height uint64) {
+	s.syncMtx.Lock()
+	defer s.syncMtx.Unlock()
+
+	key := cid.EncodeToString() + treeID
+	if s.syncHeights[key] < height {
+		s.syncHeights[key] = height
+	}
+}
+
 func (s *Service) synchronizeSingle(ctx context.Context, cid cid.ID, treeID string, height uint64, treeClient TreeServiceClient) (uint64, error) {
 	rawCID := make([]byte, sha256.Size)
 	cid.Encode(rawCID)
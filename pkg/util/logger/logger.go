@@ -17,6 +17,10 @@ type Logger = zap.Logger
 // Prm groups Logger's parameters.
 type Prm struct {
 	level zapcore.Level
+
+	// level of the Logger created from Prm,
+	// used for runtime reconfiguration
+	atomicLevel *zap.AtomicLevel
 }
 
 // ErrNilLogger is returned by functions that
@@ -31,6 +35,15 @@ func (p *Prm) SetLevelString(s string) error {
 	return p.level.UnmarshalText([]byte(s))
 }
 
+// Reload applies the level set via SetLevelString to the Logger
+// constructed from Prm. Does nothing if the Logger has not been
+// constructed yet.
+func (p *Prm) Reload() {
+	if p.atomicLevel != nil {
+		p.atomicLevel.SetLevel(p.level)
+	}
+}
+
 // NewLogger constructs a new zap logger instance.
 //
 // Logger is built from production logging configuration with:
@@ -39,13 +52,22 @@ func (p *Prm) SetLevelString(s string) error {
 //  * ISO8601 time encoding.
 //
 // Logger records a stack trace for all messages at or above fatal level.
-func NewLogger(prm Prm) (*Logger, error) {
+//
+// Level of the constructed Logger can be changed later via Prm.Reload.
+func NewLogger(prm *Prm) (*Logger, error) {
 	c := zap.NewProductionConfig()
 	c.Level = zap.NewAtomicLevelAt(prm.level)
 	c.Encoding = "console"
 	c.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 
-	return c.Build(
+	l, err := c.Build(
 		zap.AddStacktrace(zap.NewAtomicLevelAt(zap.FatalLevel)),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	prm.atomicLevel = &c.Level
+
+	return l, nil
 }
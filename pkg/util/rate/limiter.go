@@ -0,0 +1,141 @@
+package rate
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter limits the rate of some resource consumption, e.g. the number
+// of operations or bytes per second. Limiter accumulates unused resource
+// for at most one second, so short bursts are allowed. Consumption of the
+// resource exceeding the accumulated amount is not rejected: the consumer
+// waits for the time needed to pay the debt.
+//
+// Limiter is safe for concurrent use. Zero Limiter is unlimited.
+type Limiter struct {
+	mtx sync.Mutex
+
+	// resource amount per second, non-positive means no limit
+	limit float64
+
+	tokens float64
+
+	last time.Time
+}
+
+// NewLimiter creates new Limiter with the given amount of the resource
+// per second. Non-positive limit means no limit.
+func NewLimiter(limit float64) *Limiter {
+	return &Limiter{
+		limit:  limit,
+		tokens: limit,
+		last:   time.Now(),
+	}
+}
+
+// Limit returns current amount of the resource per second.
+func (l *Limiter) Limit() float64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.limit
+}
+
+// SetLimit changes the amount of the resource per second. Non-positive
+// limit means no limit.
+func (l *Limiter) SetLimit(limit float64) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.refill(time.Now())
+	l.limit = limit
+
+	if l.tokens > limit {
+		l.tokens = limit
+	}
+}
+
+// Wait consumes n units of the resource and blocks until the consumption
+// fits the limit or the context is done. In the latter case context error
+// is returned.
+func (l *Limiter) Wait(ctx context.Context, n float64) error {
+	l.mtx.Lock()
+
+	if l.limit <= 0 {
+		l.mtx.Unlock()
+		return nil
+	}
+
+	l.refill(time.Now())
+	l.tokens -= n
+
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.limit * float64(time.Second))
+	}
+
+	l.mtx.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+
+	t := time.NewTimer(wait)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Allow consumes n units of the resource if they are available right
+// now and reports whether they have been consumed. Unlike Wait, Allow
+// never blocks and never makes a debt.
+func (l *Limiter) Allow(n float64) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.refill(time.Now())
+
+	if l.tokens < n {
+		return false
+	}
+
+	l.tokens -= n
+
+	return true
+}
+
+// Available reports whether n units of the resource are available right
+// now. Unlike Allow, Available does not consume them.
+func (l *Limiter) Available(n float64) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.refill(time.Now())
+
+	return l.tokens >= n
+}
+
+func (l *Limiter) refill(now time.Time) {
+	if l.limit > 0 {
+		l.tokens += now.Sub(l.last).Seconds() * l.limit
+		if l.tokens > l.limit {
+			l.tokens = l.limit
+		}
+	}
+
+	l.last = now
+}
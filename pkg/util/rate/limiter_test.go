@@ -0,0 +1,98 @@
+package rate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/rate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unlimited", func(t *testing.T) {
+		var l rate.Limiter
+
+		start := time.Now()
+		for i := 0; i < 1000; i++ {
+			require.NoError(t, l.Wait(ctx, 1<<20))
+		}
+		require.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("burst", func(t *testing.T) {
+		l := rate.NewLimiter(100)
+
+		start := time.Now()
+		require.NoError(t, l.Wait(ctx, 100))
+		require.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("debt", func(t *testing.T) {
+		l := rate.NewLimiter(100)
+
+		require.NoError(t, l.Wait(ctx, 100))
+
+		start := time.Now()
+		require.NoError(t, l.Wait(ctx, 20))
+		require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		l := rate.NewLimiter(1)
+
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, l.Wait(ctx, 100), context.DeadlineExceeded)
+	})
+
+	t.Run("allow", func(t *testing.T) {
+		var unlimited rate.Limiter
+		require.True(t, unlimited.Allow(1<<20))
+
+		l := rate.NewLimiter(10)
+
+		for i := 0; i < 10; i++ {
+			require.True(t, l.Allow(1))
+		}
+
+		require.False(t, l.Allow(1))
+
+		time.Sleep(150 * time.Millisecond)
+		require.True(t, l.Allow(1))
+	})
+
+	t.Run("available", func(t *testing.T) {
+		var unlimited rate.Limiter
+		require.True(t, unlimited.Available(1<<20))
+
+		l := rate.NewLimiter(2)
+
+		require.True(t, l.Available(2))
+		require.True(t, l.Available(2))
+		require.False(t, l.Available(3))
+
+		require.True(t, l.Allow(2))
+		require.False(t, l.Available(1))
+	})
+
+	t.Run("set limit", func(t *testing.T) {
+		l := rate.NewLimiter(1)
+		require.NoError(t, l.Wait(ctx, 1))
+
+		l.SetLimit(0)
+		require.Zero(t, l.Limit())
+
+		start := time.Now()
+		require.NoError(t, l.Wait(ctx, 100))
+		require.Less(t, time.Since(start), 50*time.Millisecond)
+
+		l.SetLimit(1000)
+		start = time.Now()
+		require.NoError(t, l.Wait(ctx, 100))
+		require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+}